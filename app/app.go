@@ -123,7 +123,7 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			farmingclient.ProposalHandler,
+			farmingclient.ProposalHandler, farmingclient.PauseProposalHandler, farmingclient.UnpauseProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
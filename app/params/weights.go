@@ -2,12 +2,19 @@ package params
 
 const (
 	// farming module simulation operation weights for messages
-	DefaultWeightMsgCreateFixedAmountPlan int = 10
-	DefaultWeightMsgCreateRatioPlan       int = 10
-	DefaultWeightMsgStake                 int = 85
-	DefaultWeightMsgUnstake               int = 30
-	DefaultWeightMsgHarvest               int = 30
-	DefaultWeightMsgRemovePlan            int = 10
+	DefaultWeightMsgCreateFixedAmountPlan     int = 10
+	DefaultWeightMsgCreateRatioPlan           int = 10
+	DefaultWeightMsgCreateDecayingAmountPlan  int = 10
+	DefaultWeightMsgStake                     int = 85
+	DefaultWeightMsgStakeFor                  int = 20
+	DefaultWeightMsgUnstake                   int = 30
+	DefaultWeightMsgTransferStaking           int = 10
+	DefaultWeightMsgHarvest                   int = 30
+	DefaultWeightMsgRemovePlan                int = 10
+	DefaultWeightMsgModifyPrivatePlan         int = 10
+	DefaultWeightMsgSetAutoCompound           int = 10
+	DefaultWeightMsgSetRewardsWithdrawAddress int = 10
+	DefaultWeightMsgClaimVested               int = 10
 
 	DefaultWeightAddPublicPlanProposal    int = 5
 	DefaultWeightUpdatePublicPlanProposal int = 5
@@ -15,36 +15,89 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	logger := k.Logger(ctx)
 
+	k.ProcessMaturedLocks(ctx)
+	k.ProcessMaturedUnbondings(ctx)
 	k.PruneTotalStakings(ctx)
 
-	for _, plan := range k.GetPlans(ctx) {
-		if !plan.IsTerminated() && !ctx.BlockTime().Before(plan.GetEndTime()) {
-			if err := k.TerminatePlan(ctx, plan); err != nil {
-				logger.Error("failed to terminate plan", "plan_id", plan.GetId())
-			}
+	advanceEpochs(ctx, k)
+
+	// Plans are terminated only after the epochs that ended before their end
+	// time have been processed, so that a plan which ended while the chain was
+	// halted still allocates rewards for those epochs during catch-up.
+	nextEpochTime, found := k.NextEpochTime(ctx)
+	for _, plan := range k.GetActivePlans(ctx) {
+		if ctx.BlockTime().Before(plan.GetEndTime()) {
+			continue
+		}
+		if found && nextEpochTime.Before(plan.GetEndTime()) {
+			continue
+		}
+		if err := k.TerminatePlan(ctx, plan); err != nil {
+			logger.Error("failed to terminate plan", "plan_id", plan.GetId())
 		}
 	}
+}
 
-	// CurrentEpochDays is initialized with the value of NextEpochDays in genesis, and
-	// it is used here to prevent from affecting the epoch days for farming rewards allocation.
-	// Suppose NextEpochDays is 7 days, and it is proposed to change the value to 1 day through governance proposal.
+// advanceEpochs streams rewards and advances the epochs that have ended.
+func advanceEpochs(ctx sdk.Context, k keeper.Keeper) {
+	// When rewards streaming is enabled, rewards for the time elapsed since the
+	// last block are allocated every block, ahead of the epoch processing below.
+	if k.GetParams(ctx).RewardsStreaming {
+		if err := k.StreamRewards(ctx); err != nil {
+			panic(err)
+		}
+	}
+
+	// CurrentEpochDuration is initialized with the value of NextEpochDuration in genesis, and
+	// it is used here to prevent from affecting the epoch duration for farming rewards allocation.
+	// Suppose NextEpochDuration is 7 days, and it is proposed to change the value to 1 day through governance proposal.
 	// Although the proposal is passed, farming rewards allocation should continue to proceed with 7 days,
 	// and then it gets updated.
-	currentEpochDays := k.GetCurrentEpochDays(ctx)
+	currentEpochDuration := k.GetCurrentEpochDuration(ctx)
 
 	lastEpochTime, found := k.GetLastEpochTime(ctx)
 	if !found {
 		k.SetLastEpochTime(ctx, ctx.BlockTime())
-	} else {
-		y, m, d := lastEpochTime.AddDate(0, 0, int(currentEpochDays)).Date()
-		y2, m2, d2 := ctx.BlockTime().Date()
-		if !time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxCatchUpEpochs == 0 {
+		// Catching up is disabled, so advance only one epoch and start the next
+		// epoch from the block time, even if several epochs have been missed.
+		if !ctx.BlockTime().Before(types.NextEpochTime(lastEpochTime, currentEpochDuration)) {
 			if err := k.AdvanceEpoch(ctx); err != nil {
 				panic(err)
 			}
-			if params := k.GetParams(ctx); params.NextEpochDays != currentEpochDays {
-				k.SetCurrentEpochDays(ctx, params.NextEpochDays)
+			if params.NextEpochDuration != currentEpochDuration {
+				k.SetCurrentEpochDuration(ctx, params.NextEpochDuration)
+			}
+		}
+		return
+	}
+
+	// Advance all the epochs that have ended, up to MaxCatchUpEpochs per block.
+	// Each epoch ends at its scheduled end time rather than the block time, so
+	// that the epochs missed while the chain was halted are caught up over
+	// the following blocks without drifting the epoch schedule.
+	for i := uint32(0); i < params.MaxCatchUpEpochs; i++ {
+		epochEndTime := types.NextEpochTime(lastEpochTime, currentEpochDuration)
+		if ctx.BlockTime().Before(epochEndTime) {
+			break
+		}
+		if err := k.AdvanceEpochTo(ctx, epochEndTime); err != nil {
+			panic(err)
+		}
+		if params.NextEpochDuration != currentEpochDuration {
+			k.SetCurrentEpochDuration(ctx, params.NextEpochDuration)
+			currentEpochDuration = params.NextEpochDuration
+		}
+		// Stream the rewards for the time elapsed in the new epoch.
+		if params.RewardsStreaming {
+			if err := k.StreamRewards(ctx); err != nil {
+				panic(err)
 			}
 		}
+		lastEpochTime = epochEndTime
 	}
 }
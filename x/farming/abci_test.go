@@ -3,20 +3,22 @@ package farming_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
 )
 
-func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
-	epochDaysTest := func(formerEpochDays, targetNextEpochDays uint32) {
+func (suite *ModuleTestSuite) TestEndBlockerEpochDurationTest() {
+	epochDurationTest := func(formerEpochDuration, targetNextEpochDuration time.Duration) {
 		suite.SetupTest()
 
 		params := suite.keeper.GetParams(suite.ctx)
-		params.NextEpochDays = formerEpochDays
+		params.NextEpochDuration = formerEpochDuration
 		suite.keeper.SetParams(suite.ctx, params)
-		suite.keeper.SetCurrentEpochDays(suite.ctx, formerEpochDays)
+		suite.keeper.SetCurrentEpochDuration(suite.ctx, formerEpochDuration)
 
 		t := types.ParseTime("2021-08-01T00:00:00Z")
 		suite.ctx = suite.ctx.WithBlockTime(t)
@@ -29,24 +31,24 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 			suite.ctx = suite.ctx.WithBlockTime(t)
 			farming.EndBlocker(suite.ctx, suite.keeper)
 
-			if i == 10 { // 10 hours passed
+			if i == 1 { // 1 hour passed
 				params := suite.keeper.GetParams(suite.ctx)
-				params.NextEpochDays = targetNextEpochDays
+				params.NextEpochDuration = targetNextEpochDuration
 				suite.keeper.SetParams(suite.ctx, params)
 			}
 
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
 			t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 
-			if uint32(i) == formerEpochDays*24 {
+			if time.Duration(i)*time.Hour == formerEpochDuration {
 				suite.Require().True(t2.After(lastEpochTime))
-				suite.Require().Equal(t2.Sub(lastEpochTime).Hours(), float64(formerEpochDays*24))
-				suite.Require().Equal(targetNextEpochDays, currentEpochDays)
+				suite.Require().Equal(formerEpochDuration, t2.Sub(lastEpochTime))
+				suite.Require().Equal(targetNextEpochDuration, currentEpochDuration)
 			}
 
-			if uint32(i) == formerEpochDays*24+targetNextEpochDays*24 {
-				suite.Require().Equal(t2.Sub(lastEpochTime).Hours(), float64(currentEpochDays*24))
-				suite.Require().Equal(targetNextEpochDays, currentEpochDays)
+			if time.Duration(i)*time.Hour == formerEpochDuration+targetNextEpochDuration {
+				suite.Require().Equal(currentEpochDuration, t2.Sub(lastEpochTime))
+				suite.Require().Equal(targetNextEpochDuration, currentEpochDuration)
 			}
 
 			lastEpochTime = t2
@@ -54,11 +56,49 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 	}
 
 	// increasing case
-	epochDaysTest(1, 7)
+	epochDurationTest(24*time.Hour, 7*24*time.Hour)
 
 	// decreasing case
-	epochDaysTest(7, 1)
+	epochDurationTest(7*24*time.Hour, 24*time.Hour)
 
 	// stay case
-	epochDaysTest(1, 1)
+	epochDurationTest(24*time.Hour, 24*time.Hour)
+
+	// sub-day cases
+	epochDurationTest(6*time.Hour, 2*time.Hour)
+	epochDurationTest(2*time.Hour, 2*time.Hour)
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerRewardsStreaming() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	plan := suite.sampleFixedAmtPlans[1] // 2_000_000denom3 per epoch
+	suite.keeper.SetPlan(suite.ctx, plan)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	t := types.ParseTime("2021-08-04T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	// The queued staking becomes staked at the end of the first epoch.
+	t = t.AddDate(0, 0, 1)
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
+
+	// Rewards increase every block.
+	prevRewards := sdk.NewCoins()
+	for i := 0; i < 24; i++ {
+		t = t.Add(time.Hour)
+		suite.ctx = suite.ctx.WithBlockTime(t)
+		farming.EndBlocker(suite.ctx, suite.keeper)
+
+		rewards := suite.Rewards(suite.addrs[0])
+		suite.Require().True(rewards.IsAllGT(prevRewards))
+		prevRewards = rewards
+	}
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), prevRewards))
 }
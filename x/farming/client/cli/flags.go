@@ -13,6 +13,11 @@ const (
 	FlagStakingCoinDenom = "staking-coin-denom"
 	FlagTerminated       = "terminated"
 	FlagAll              = "all"
+	FlagLockDuration     = "lock-duration"
+	FlagStartEpoch       = "start-epoch"
+	FlagEndEpoch         = "end-epoch"
+	FlagFarmer           = "farmer"
+	FlagStakingAmount    = "staking-amount"
 )
 
 // flagSetPlans returns the FlagSet used for farming plan related opertations.
@@ -37,6 +42,42 @@ func flagSetStakings() *flag.FlagSet {
 	return fs
 }
 
+// flagSetQueuedStakings returns the FlagSet used for farmer's queued stakings.
+func flagSetQueuedStakings() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStakingCoinDenom, "", "The staking coin denom")
+
+	return fs
+}
+
+// flagSetStake returns the FlagSet used for staking coins.
+func flagSetStake() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagLockDuration, 0, "The duration to lock the staking coins for (e.g. 720h); zero means no lock")
+
+	return fs
+}
+
+// flagSetLocks returns the FlagSet used for farmer's locks.
+func flagSetLocks() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStakingCoinDenom, "", "The staking coin denom")
+
+	return fs
+}
+
+// flagSetUnbondings returns the FlagSet used for farmer's unbondings.
+func flagSetUnbondings() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStakingCoinDenom, "", "The staking coin denom")
+
+	return fs
+}
+
 // flagSetRewards returns the FlagSet used for farmer's rewards.
 func flagSetRewards() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
@@ -46,6 +87,35 @@ func flagSetRewards() *flag.FlagSet {
 	return fs
 }
 
+// flagSetHistoricalRewards returns the FlagSet used for historical rewards.
+func flagSetHistoricalRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagStartEpoch, 0, "The first epoch to query, inclusive")
+	fs.Uint64(FlagEndEpoch, 0, "The last epoch to query, inclusive; zero means no upper bound")
+
+	return fs
+}
+
+// flagSetExpectedRewards returns the FlagSet used for expected rewards.
+func flagSetExpectedRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagFarmer, "", "The bech32 address of the farmer whose stakings are counted")
+	fs.String(FlagStakingAmount, "", "The hypothetical amount of the staking coin denom to stake")
+
+	return fs
+}
+
+// flagSetEpochInfo returns the FlagSet used for epoch info.
+func flagSetEpochInfo() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStakingCoinDenom, "", "The staking coin denom")
+
+	return fs
+}
+
 // flagSetHarvest returns the FlagSet used for harvest all staking coin denoms.
 func flagSetHarvest() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
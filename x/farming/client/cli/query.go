@@ -35,9 +35,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPlans(),
 		GetCmdQueryPlan(),
 		GetCmdQueryStakings(),
+		GetCmdQueryStakingsByDenom(),
+		GetCmdQueryQueuedStakings(),
+		GetCmdQueryLocks(),
+		GetCmdQueryUnbondings(),
+		GetCmdQueryVestedRewards(),
 		GetCmdQueryTotalStakings(),
 		GetCmdQueryRewards(),
+		GetCmdQueryHistoricalRewards(),
+		GetCmdQueryOutstandingRewards(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryExpectedRewards(),
+		GetCmdQuerySimulateAllocation(),
 		GetCmdQueryCurrentEpochDays(),
+		GetCmdQueryCurrentEpochDuration(),
+		GetCmdQueryEpochInfo(),
+		GetCmdQueryAutoCompound(),
+		GetCmdQueryRewardsWithdrawAddress(),
 	)
 	return farmingQueryCmd
 }
@@ -229,9 +243,15 @@ $ %s query %s stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-
 
 			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			resp, err := queryClient.Stakings(cmd.Context(), &types.QueryStakingsRequest{
 				Farmer:           farmerAcc.String(),
 				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
 			})
 			if err != nil {
 				return err
@@ -242,6 +262,280 @@ $ %s query %s stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-
 	}
 
 	cmd.Flags().AddFlagSet(flagSetStakings())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stakings")
+
+	return cmd
+}
+
+// GetCmdQueryStakingsByDenom implements the query stakings by a staking coin denom command.
+func GetCmdQueryStakingsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stakings-by-denom [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query stakings of all farmers for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query staked and queued amounts of all farmers staking a staking coin denom.
+
+Example:
+$ %s query %s stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --limit 10
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.StakingsByDenom(cmd.Context(), &types.QueryStakingsByDenomRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stakings by denom")
+
+	return cmd
+}
+
+// GetCmdQueryQueuedStakings implements the query queued stakings by a farmer command.
+func GetCmdQueryQueuedStakings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "queued-stakings [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query queued stakings by a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all queued stakings by a farmer and the time they are expected to become staked.
+
+Queued coins become staked when the current epoch ends.
+Optionally restrict coins for a staking coin denom.
+
+Example:
+$ %s query %s queued-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s queued-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.QueuedStakings(cmd.Context(), &types.QueryQueuedStakingsRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetQueuedStakings())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLocks implements the query locks command.
+func GetCmdQueryLocks() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "locks [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query locks by a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all locks by a farmer, including the queued ones.
+
+Optionally restrict locks for a staking coin denom.
+
+Example:
+$ %s query %s locks %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s locks %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.Locks(cmd.Context(), &types.QueryLocksRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetLocks())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondings implements the query unbondings command.
+func GetCmdQueryUnbondings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbondings [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query unbondings by a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all unbondings by a farmer, which are the unstaked coins waiting to be paid out after the unstaking period.
+
+Optionally restrict unbondings for a staking coin denom.
+
+Example:
+$ %s query %s unbondings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s unbondings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.Unbondings(cmd.Context(), &types.QueryUnbondingsRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetUnbondings())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbondings")
+
+	return cmd
+}
+
+// GetCmdQueryVestedRewards implements the query vested rewards command.
+func GetCmdQueryVestedRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "vested-rewards [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query vesting rewards of a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all reward vestings of a farmer, which are the withdrawn rewards from plans with a vesting duration.
+
+The response also shows the amount of rewards that can be claimed now (vested) and the amount that is still locked (unvested).
+
+Example:
+$ %s query %s vested-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.VestedRewards(cmd.Context(), &types.QueryVestedRewardsRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -325,9 +619,15 @@ $ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-d
 
 			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			resp, err := queryClient.Rewards(cmd.Context(), &types.QueryRewardsRequest{
 				Farmer:           farmerAcc.String(),
 				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
 			})
 			if err != nil {
 				return err
@@ -339,34 +639,452 @@ $ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-d
 
 	cmd.Flags().AddFlagSet(flagSetRewards())
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rewards")
 
 	return cmd
 }
 
-// GetCmdQueryCurrentEpochDays implements the query current epoch days command.
-func GetCmdQueryCurrentEpochDays() *cobra.Command {
+// GetCmdQueryHistoricalRewards implements the query historical rewards for a staking coin denom command.
+func GetCmdQueryHistoricalRewards() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "current-epoch-days",
-		Args:  cobra.NoArgs,
-		Short: "Query the value of current epoch days",
+		Use:   "historical-rewards [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query historical rewards for a staking coin denom",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the value set as current epoch days.
+			fmt.Sprintf(`Query cumulative unit rewards of each epoch for a staking coin denom.
+
+Optionally restrict the epochs with the start and end epoch, both inclusive.
 
 Example:
-$ %s query %s current-epoch-days
+$ %s query %s historical-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s historical-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --start-epoch 10 --end-epoch 20
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-
 			queryClient := types.NewQueryClient(clientCtx)
 
-			resp, err := queryClient.CurrentEpochDays(context.Background(), &types.QueryCurrentEpochDaysRequest{})
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			startEpoch, _ := cmd.Flags().GetUint64(FlagStartEpoch)
+			endEpoch, _ := cmd.Flags().GetUint64(FlagEndEpoch)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.HistoricalRewards(cmd.Context(), &types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				StartEpoch:       startEpoch,
+				EndEpoch:         endEpoch,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetHistoricalRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historical rewards")
+
+	return cmd
+}
+
+// GetCmdQueryOutstandingRewards implements the query outstanding rewards for a staking coin denom command.
+func GetCmdQueryOutstandingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outstanding-rewards [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query outstanding rewards for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query rewards that are allocated for a staking coin denom but not yet withdrawn.
+
+Example:
+$ %s query %s outstanding-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.OutstandingRewards(cmd.Context(), &types.QueryOutstandingRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current epoch for a staking coin denom command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current epoch for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current epoch for a staking coin denom.
+
+Example:
+$ %s query %s current-epoch poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExpectedRewards implements the query expected rewards for a staking coin denom command.
+func GetCmdQueryExpectedRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "expected-rewards [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query rewards expected to be allocated for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query rewards expected to be allocated for a staking coin denom at the end of the current epoch.
+
+Optionally count the stakings of a farmer and a hypothetical staking amount
+in the expected rewards.
+
+Example:
+$ %s query %s expected-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s expected-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --farmer %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s expected-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --staking-amount 1000000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			farmer, _ := cmd.Flags().GetString(FlagFarmer)
+			stakingAmt, _ := cmd.Flags().GetString(FlagStakingAmount)
+
+			resp, err := queryClient.ExpectedRewards(cmd.Context(), &types.QueryExpectedRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				Farmer:           farmer,
+				StakingAmount:    stakingAmt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetExpectedRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateAllocation implements the query simulate allocation command.
+func GetCmdQuerySimulateAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-allocation",
+		Args:  cobra.NoArgs,
+		Short: "Query how rewards would be allocated at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query how rewards would be allocated at the end of the current epoch.
+The farming pools and the plans whose allocations would be skipped are reported with the reasons.
+
+Example:
+$ %s query %s simulate-allocation
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.SimulateAllocation(cmd.Context(), &types.QuerySimulateAllocationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpochDays implements the query current epoch days command.
+// Deprecated: use GetCmdQueryCurrentEpochDuration instead.
+func GetCmdQueryCurrentEpochDays() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:        "current-epoch-days",
+		Args:       cobra.NoArgs,
+		Short:      "Query the value of current epoch duration rounded to days",
+		Deprecated: "use current-epoch-duration instead",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value set as current epoch duration, rounded to days.
+
+Example:
+$ %s query %s current-epoch-days
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.CurrentEpochDays(context.Background(), &types.QueryCurrentEpochDaysRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpochDuration implements the query current epoch duration command.
+func GetCmdQueryCurrentEpochDuration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch-duration",
+		Args:  cobra.NoArgs,
+		Short: "Query the value of current epoch duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value set as current epoch duration.
+
+Example:
+$ %s query %s current-epoch-duration
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.CurrentEpochDuration(context.Background(), &types.QueryCurrentEpochDurationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfo implements the query epoch info command.
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-info",
+		Args:  cobra.NoArgs,
+		Short: "Query information about the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last epoch time, the current epoch duration, the estimated next epoch time
+and the current epoch numbers by staking coin denom.
+
+Optionally restrict the current epoch numbers for a staking coin denom.
+
+Example:
+$ %s query %s epoch-info
+$ %s query %s epoch-info --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.EpochInfo(cmd.Context(), &types.QueryEpochInfoRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetEpochInfo())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAutoCompound implements the query auto-compound setting of a farmer command.
+func GetCmdQueryAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether auto-compounding of rewards is enabled for a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether auto-compounding of rewards is enabled for a farmer.
+
+Example:
+$ %s query %s auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.AutoCompound(cmd.Context(), &types.QueryAutoCompoundRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardsWithdrawAddress implements the query rewards withdraw address of a farmer command.
+func GetCmdQueryRewardsWithdrawAddress() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "rewards-withdraw-address [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the address to which the rewards of a farmer are sent",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address to which the rewards of a farmer are sent.
+The farmer's own address is returned if the farmer has not set a withdraw address.
+
+Example:
+$ %s query %s rewards-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.RewardsWithdrawAddress(cmd.Context(), &types.QueryRewardsWithdrawAddressRequest{
+				Farmer: farmerAcc.String(),
+			})
 			if err != nil {
 				return err
 			}
@@ -35,10 +35,17 @@ func GetTxCmd() *cobra.Command {
 
 	farmingTxCmd.AddCommand(
 		NewCreateFixedAmountPlanCmd(),
+		NewCreateDecayingAmountPlanCmd(),
 		NewStakeCmd(),
+		NewStakeForCmd(),
 		NewUnstakeCmd(),
+		NewTransferStakingCmd(),
 		NewHarvestCmd(),
 		NewRemovePlanCmd(),
+		NewModifyPrivatePlanCmd(),
+		NewSetAutoCompoundCmd(),
+		NewSetRewardsWithdrawAddressCmd(),
+		NewClaimVestedCmd(),
 	)
 	if keeper.EnableRatioPlan {
 		farmingTxCmd.AddCommand(NewCreateRatioPlanCmd())
@@ -90,6 +97,9 @@ Description for the parameters:
 [start_time]: specifies the time for the plan to start 
 [end_time]: specifies the time for the plan to end
 [epoch_amount]: specifies an amount to distribute for every epoch
+[vesting_duration]: optional, specifies the duration over which withdrawn rewards unlock linearly (e.g. 720h)
+[max_stake_per_farmer]: optional, specifies the maximum amount of each farmer's stake counted toward the plan
+[max_total_stake]: optional, specifies the maximum total amount of stakes counted toward the plan
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -105,6 +115,21 @@ Description for the parameters:
 				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
 			}
 
+			vestingDuration, err := parseVestingDuration(plan.VestingDuration)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse vesting duration: %v", err)
+			}
+
+			maxStakePerFarmer, err := parseStakeCap(plan.MaxStakePerFarmer)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max stake per farmer: %v", err)
+			}
+
+			maxTotalStake, err := parseStakeCap(plan.MaxTotalStake)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max total stake: %v", err)
+			}
+
 			msg := types.NewMsgCreateFixedAmountPlan(
 				plan.Name,
 				clientCtx.GetFromAddress(),
@@ -113,6 +138,109 @@ Description for the parameters:
 				plan.EndTime,
 				plan.EpochAmount,
 			)
+			msg.VestingDuration = vestingDuration
+			msg.MaxStakePerFarmer = maxStakePerFarmer
+			msg.MaxTotalStake = maxTotalStake
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateDecayingAmountPlanCmd implements the create a decaying amount plan command handler.
+func NewCreateDecayingAmountPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-decaying-plan [plan-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create private decaying amount farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create private decaying amount farming plan.
+The plan details must be provided through a JSON file. 
+		
+Example:
+$ %s tx %s create-private-decaying-plan <path/to/plan.json> --from mykey 
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-08-06T09:00:00Z",
+  "end_time": "2022-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000000"
+    }
+  ],
+  "decay_factor": "0.500000000000000000",
+  "decay_period": 30
+}
+
+Description for the parameters:
+
+[name]: specifies the name for the plan 
+[staking_coin_weights]: specifies coin weights for the plan
+[start_time]: specifies the time for the plan to start 
+[end_time]: specifies the time for the plan to end
+[epoch_amount]: specifies an initial amount to distribute for every epoch
+[decay_factor]: specifies a factor multiplied to the epoch amount for every decay period, it must be between 0 and 1
+[decay_period]: specifies the number of epochs after which the epoch amount decays
+[vesting_duration]: optional, specifies the duration over which withdrawn rewards unlock linearly (e.g. 720h)
+[max_stake_per_farmer]: optional, specifies the maximum amount of each farmer's stake counted toward the plan
+[max_total_stake]: optional, specifies the maximum total amount of stakes counted toward the plan
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ParsePrivateDecayingPlan(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
+			}
+
+			vestingDuration, err := parseVestingDuration(plan.VestingDuration)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse vesting duration: %v", err)
+			}
+
+			maxStakePerFarmer, err := parseStakeCap(plan.MaxStakePerFarmer)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max stake per farmer: %v", err)
+			}
+
+			maxTotalStake, err := parseStakeCap(plan.MaxTotalStake)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max total stake: %v", err)
+			}
+
+			msg := types.NewMsgCreateDecayingAmountPlan(
+				plan.Name,
+				clientCtx.GetFromAddress(),
+				plan.StakingCoinWeights,
+				plan.StartTime,
+				plan.EndTime,
+				plan.EpochAmount,
+				plan.DecayFactor,
+				plan.DecayPeriod,
+			)
+			msg.VestingDuration = vestingDuration
+			msg.MaxStakePerFarmer = maxStakePerFarmer
+			msg.MaxTotalStake = maxTotalStake
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -158,6 +286,9 @@ Description for the parameters:
 [start_time]: specifies the time for the plan to start 
 [end_time]: specifies the time for the plan to end
 [epoch_ratio]: specifies a ratio to distribute for every epoch. 1.000000000000000000 means to distribute all coins for an epoch
+[vesting_duration]: optional, specifies the duration over which withdrawn rewards unlock linearly (e.g. 720h)
+[max_stake_per_farmer]: optional, specifies the maximum amount of each farmer's stake counted toward the plan
+[max_total_stake]: optional, specifies the maximum total amount of stakes counted toward the plan
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -173,6 +304,21 @@ Description for the parameters:
 				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
 			}
 
+			vestingDuration, err := parseVestingDuration(plan.VestingDuration)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse vesting duration: %v", err)
+			}
+
+			maxStakePerFarmer, err := parseStakeCap(plan.MaxStakePerFarmer)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max stake per farmer: %v", err)
+			}
+
+			maxTotalStake, err := parseStakeCap(plan.MaxTotalStake)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse max total stake: %v", err)
+			}
+
 			msg := types.NewMsgCreateRatioPlan(
 				plan.Name,
 				clientCtx.GetFromAddress(),
@@ -181,6 +327,9 @@ Description for the parameters:
 				plan.EndTime,
 				plan.EpochRatio,
 			)
+			msg.VestingDuration = vestingDuration
+			msg.MaxStakePerFarmer = maxStakePerFarmer
+			msg.MaxTotalStake = maxTotalStake
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -202,12 +351,18 @@ func NewStakeCmd() *cobra.Command {
 			
 To get farming rewards, you must stake coins that are defined in available plans on a network. 
 
+Optionally lock the coins for a duration with --lock-duration flag.
+Locked coins cannot be unstaked until the lock ends, but they earn boosted rewards
+by the multiplier defined in the lock_multipliers parameter.
+
 Example:
 $ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
 $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,500pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5 --from mykey
+$ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --lock-duration 720h --from mykey
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -223,7 +378,57 @@ $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2
 				return err
 			}
 
-			msg := types.NewMsgStake(farmer, stakingCoins)
+			lockDuration, _ := cmd.Flags().GetDuration(FlagLockDuration)
+
+			msg := types.NewMsgStakeWithLock(farmer, stakingCoins, lockDuration)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetStake())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewStakeForCmd implements the stake on behalf of another farmer command handler.
+func NewStakeForCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "stake-for [farmer] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Stake coins on behalf of another farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Stake coins on behalf of another farmer.
+
+The coins are paid by the sender, while the farmer owns the staking and its rewards.
+Only the farmer can unstake the coins.
+
+Example:
+$ %s tx %s stake-for %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStakeFor(clientCtx.GetFromAddress(), farmerAcc, stakingCoins)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -277,6 +482,54 @@ $ %s tx %s unstake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70
 	return cmd
 }
 
+// NewTransferStakingCmd implements the transfer staking command handler.
+func NewTransferStakingCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "transfer-staking [recipient] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer staked and queued coins to another farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer staked and queued coins to another farmer.
+
+The queued coins are transferred first, and then the staked coins.
+Accumulated rewards for the staked coins are withdrawn before the transfer.
+Locked coins cannot be transferred.
+
+Example:
+$ %s tx %s transfer-staking %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipientAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferStaking(clientCtx.GetFromAddress(), recipientAcc, stakingCoins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewHarvestCmd implements the harvest rewards command handler.
 func NewHarvestCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -383,6 +636,202 @@ $ %s tx %s remove-plan 1 --from mykey`,
 	return cmd
 }
 
+// NewModifyPrivatePlanCmd implements the modify private plan command handler.
+func NewModifyPrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-private-plan [plan-id] [modify-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Modify a live private plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Modify a live private plan.
+Only the plan creator can modify the plan, and only the fields provided in the JSON file are changed.
+
+Example:
+$ %s tx %s modify-private-plan 1 <path/to/modify.json> --from mykey
+
+Where modify.json contains:
+
+{
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "end_time": "2023-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "2000000"
+    }
+  ]
+}
+
+Description for the parameters:
+
+[staking_coin_weights]: specifies new coin weights for the plan
+[end_time]: specifies the new time for the plan to end, which must be after the current end time
+[epoch_amount]: specifies a new amount to distribute for every epoch
+[epoch_ratio]: specifies a new ratio of the farming pool balance to distribute for every epoch
+[decay_factor]: specifies a factor multiplied to the epoch amount for every decay period, must be given with epoch_amount
+[decay_period]: specifies the number of epochs after which the epoch amount decays
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse plan id: %w", err)
+			}
+
+			req, err := ParsePrivateModifyPlan(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[1], err)
+			}
+
+			msg := types.NewMsgModifyPrivatePlan(
+				clientCtx.GetFromAddress(),
+				planId,
+				req.StakingCoinWeights,
+				req.EndTime,
+				req.EpochAmount,
+				req.EpochRatio,
+				req.DecayFactor,
+				req.DecayPeriod,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd implements the set auto-compound command handler.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [enabled]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable auto-compounding of farming rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding of farming rewards.
+When enabled, rewards in the denoms that are staking coin denoms of any plan
+are withdrawn and staked again as queued coins at the end of every epoch.
+
+Example:
+$ %s tx %s set-auto-compound true --from mykey
+$ %s tx %s set-auto-compound false --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("parse enabled: %w", err)
+			}
+
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetRewardsWithdrawAddressCmd implements the set rewards withdraw address command handler.
+func NewSetRewardsWithdrawAddressCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-rewards-withdraw-address [withdraw-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the address to which farming rewards are sent",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the address to which farming rewards are sent.
+Rewards withdrawn by harvesting, unstaking or staking more coins are sent to the withdraw address,
+while the staked coins remain owned by the farmer.
+Set the farmer's own address to receive the rewards directly again.
+
+Example:
+$ %s tx %s set-rewards-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRewardsWithdrawAddress(clientCtx.GetFromAddress(), withdrawAcc)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimVestedCmd implements the claim vested rewards command handler.
+func NewClaimVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested",
+		Args:  cobra.NoArgs,
+		Short: "Claim the vested farming rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the vested farming rewards.
+Rewards withdrawn from plans with a vesting duration unlock linearly over the duration.
+This command sends all rewards unlocked so far to the farmer's rewards withdraw address.
+
+Example:
+$ %s tx %s claim-vested --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimVested(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewAdvanceEpochCmd implements the advance epoch by 1 command handler.
 func NewAdvanceEpochCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -515,3 +964,130 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitPauseProposal implements the pause farming functions command handler.
+func GetCmdSubmitPauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "farming-pause [function]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to pause functions of the farming module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause functions of the farming module along with an initial deposit.
+It is an emergency circuit breaker that can be used when a bug is found.
+
+Available functions are: %s.
+Note that unstaking remains available unless it is explicitly paused, so that funds cannot be trapped.
+
+Example:
+$ %s tx gov submit-proposal farming-pause harvest reward_allocation --title="Pause rewards" --description="Bug in reward math" --from=<key_or_address> --deposit=<deposit_amount>
+`,
+				strings.Join(pausableFunctionNames(), ", "),
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, deposit, description, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			functions, err := ParsePausableFunctions(args)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPauseProposal(title, description, functions)
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// GetCmdSubmitUnpauseProposal implements the unpause farming functions command handler.
+func GetCmdSubmitUnpauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "farming-unpause [function]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to unpause functions of the farming module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to unpause functions of the farming module along with an initial deposit.
+
+Available functions are: %s.
+
+Example:
+$ %s tx gov submit-proposal farming-unpause harvest reward_allocation --title="Unpause rewards" --description="Bug fixed" --from=<key_or_address> --deposit=<deposit_amount>
+`,
+				strings.Join(pausableFunctionNames(), ", "),
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, deposit, description, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			functions, err := ParsePausableFunctions(args)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUnpauseProposal(title, description, functions)
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// parseProposalFlags returns the title, deposit and description of a proposal from the flags.
+func parseProposalFlags(cmd *cobra.Command) (title string, deposit sdk.Coins, description string, err error) {
+	title, err = cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return
+	}
+
+	description, err = cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +20,9 @@ type PrivateFixedPlanRequest struct {
 	StartTime          time.Time    `json:"start_time"`
 	EndTime            time.Time    `json:"end_time"`
 	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	VestingDuration    string       `json:"vesting_duration,omitempty"`
+	MaxStakePerFarmer  string       `json:"max_stake_per_farmer,omitempty"`
+	MaxTotalStake      string       `json:"max_total_stake,omitempty"`
 }
 
 // PrivateRatioPlanRequest defines CLI request for a private ratio plan.
@@ -27,6 +32,33 @@ type PrivateRatioPlanRequest struct {
 	StartTime          time.Time    `json:"start_time"`
 	EndTime            time.Time    `json:"end_time"`
 	EpochRatio         sdk.Dec      `json:"epoch_ratio"`
+	VestingDuration    string       `json:"vesting_duration,omitempty"`
+	MaxStakePerFarmer  string       `json:"max_stake_per_farmer,omitempty"`
+	MaxTotalStake      string       `json:"max_total_stake,omitempty"`
+}
+
+// PrivateDecayingPlanRequest defines CLI request for a private decaying amount plan.
+type PrivateDecayingPlanRequest struct {
+	Name               string       `json:"name"`
+	StakingCoinWeights sdk.DecCoins `json:"staking_coin_weights"`
+	StartTime          time.Time    `json:"start_time"`
+	EndTime            time.Time    `json:"end_time"`
+	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	DecayFactor        sdk.Dec      `json:"decay_factor"`
+	DecayPeriod        uint32       `json:"decay_period"`
+	VestingDuration    string       `json:"vesting_duration,omitempty"`
+	MaxStakePerFarmer  string       `json:"max_stake_per_farmer,omitempty"`
+	MaxTotalStake      string       `json:"max_total_stake,omitempty"`
+}
+
+// PrivateModifyPlanRequest defines CLI request for modifying a private plan.
+type PrivateModifyPlanRequest struct {
+	StakingCoinWeights sdk.DecCoins `json:"staking_coin_weights"`
+	EndTime            *time.Time   `json:"end_time"`
+	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	EpochRatio         sdk.Dec      `json:"epoch_ratio"`
+	DecayFactor        sdk.Dec      `json:"decay_factor"`
+	DecayPeriod        uint32       `json:"decay_period"`
 }
 
 // ParsePrivateFixedPlan reads and parses a PrivateFixedPlanRequest from a file.
@@ -61,6 +93,60 @@ func ParsePrivateRatioPlan(file string) (PrivateRatioPlanRequest, error) {
 	return plan, nil
 }
 
+// ParsePrivateDecayingPlan reads and parses a PrivateDecayingPlanRequest from a file.
+func ParsePrivateDecayingPlan(file string) (PrivateDecayingPlanRequest, error) {
+	plan := PrivateDecayingPlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// ParsePrivateModifyPlan reads and parses a PrivateModifyPlanRequest from a file.
+func ParsePrivateModifyPlan(file string) (PrivateModifyPlanRequest, error) {
+	req := PrivateModifyPlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return req, err
+	}
+
+	if err = json.Unmarshal(contents, &req); err != nil {
+		return req, err
+	}
+
+	return req, nil
+}
+
+// parseVestingDuration parses the vesting duration of a plan request, such
+// as 720h. An empty string means no vesting.
+func parseVestingDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// parseStakeCap parses a stake cap of a plan request, such as 1000000.
+// An empty string means no limit.
+func parseStakeCap(s string) (sdk.Int, error) {
+	if s == "" {
+		return sdk.ZeroInt(), nil
+	}
+	amt, ok := sdk.NewIntFromString(s)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid amount: %s", s)
+	}
+	return amt, nil
+}
+
 // ParsePublicPlanProposal reads and parses a PublicPlanProposal from a file.
 func ParsePublicPlanProposal(cdc codec.JSONCodec, proposalFile string) (types.PublicPlanProposal, error) {
 	proposal := types.PublicPlanProposal{}
@@ -94,3 +180,52 @@ func (req PrivateRatioPlanRequest) String() string {
 	}
 	return string(result)
 }
+
+// String returns a human readable string representation of the request.
+func (req PrivateDecayingPlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+// String returns a human readable string representation of the request.
+func (req PrivateModifyPlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+// pausableFunctionPrefix is the common prefix of the names of pausable functions.
+const pausableFunctionPrefix = "PAUSABLE_FUNCTION_"
+
+// ParsePausableFunctions parses pausable functions from their names.
+// A name can be given either in full, like PAUSABLE_FUNCTION_STAKE, or
+// without the prefix in lower case, like stake.
+func ParsePausableFunctions(names []string) ([]types.PausableFunction, error) {
+	functions := []types.PausableFunction{}
+	for _, name := range names {
+		name = strings.ToUpper(name)
+		if !strings.HasPrefix(name, pausableFunctionPrefix) {
+			name = pausableFunctionPrefix + name
+		}
+		function, ok := types.PausableFunction_value[name]
+		if !ok || types.ValidatePausableFunction(types.PausableFunction(function)) != nil {
+			return nil, fmt.Errorf("invalid function: %s", name)
+		}
+		functions = append(functions, types.PausableFunction(function))
+	}
+	return functions, nil
+}
+
+// pausableFunctionNames returns the short names of all pausable functions.
+func pausableFunctionNames() []string {
+	var names []string
+	for function := types.PausableFunctionStake; types.ValidatePausableFunction(function) == nil; function++ {
+		names = append(names, strings.ToLower(strings.TrimPrefix(function.String(), pausableFunctionPrefix)))
+	}
+	return names
+}
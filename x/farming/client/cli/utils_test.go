@@ -10,6 +10,7 @@ import (
 
 	"github.com/tendermint/farming/app/params"
 	"github.com/tendermint/farming/x/farming/client/cli"
+	"github.com/tendermint/farming/x/farming/types"
 )
 
 func TestParsePrivateFixedPlan(t *testing.T) {
@@ -29,7 +30,10 @@ func TestParsePrivateFixedPlan(t *testing.T) {
       "denom": "uatom",
       "amount": "1"
     }
-  ]
+  ],
+  "vesting_duration": "720h",
+  "max_stake_per_farmer": "1000000",
+  "max_total_stake": "10000000"
 }
 `)
 
@@ -42,6 +46,9 @@ func TestParsePrivateFixedPlan(t *testing.T) {
 	require.Equal(t, "2021-07-15T08:41:21Z", plan.StartTime.Format(time.RFC3339))
 	require.Equal(t, "2022-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
 	require.Equal(t, "1uatom", plan.EpochAmount.String())
+	require.Equal(t, "720h", plan.VestingDuration)
+	require.Equal(t, "1000000", plan.MaxStakePerFarmer)
+	require.Equal(t, "10000000", plan.MaxTotalStake)
 }
 
 func TestParsePrivateRatioPlan(t *testing.T) {
@@ -71,6 +78,42 @@ func TestParsePrivateRatioPlan(t *testing.T) {
 	require.Equal(t, "1.000000000000000000", plan.EpochRatio.String())
 }
 
+func TestParsePrivateDecayingPlan(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-07-15T08:41:21Z",
+  "end_time": "2022-07-16T08:41:21Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000000"
+    }
+  ],
+  "decay_factor": "0.500000000000000000",
+  "decay_period": 30
+}
+`)
+
+	plan, err := cli.ParsePrivateDecayingPlan(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide incentives for Cosmonauts!", plan.Name)
+	require.Equal(t, "1.000000000000000000PoolCoinDenom", plan.StakingCoinWeights.String())
+	require.Equal(t, "2021-07-15T08:41:21Z", plan.StartTime.Format(time.RFC3339))
+	require.Equal(t, "2022-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
+	require.Equal(t, "1000000uatom", plan.EpochAmount.String())
+	require.Equal(t, "0.500000000000000000", plan.DecayFactor.String())
+	require.EqualValues(t, 30, plan.DecayPeriod)
+}
+
 func TestParsePublicPlanProposal(t *testing.T) {
 	encodingConfig := params.MakeTestEncodingConfig()
 
@@ -108,3 +151,19 @@ func TestParsePublicPlanProposal(t *testing.T) {
 	require.Equal(t, "Public Farming Plan", proposal.Title)
 	require.Equal(t, "Are you ready to farm?", proposal.Description)
 }
+
+func TestParsePausableFunctions(t *testing.T) {
+	functions, err := cli.ParsePausableFunctions([]string{"stake", "PAUSABLE_FUNCTION_HARVEST", "Reward_Allocation"})
+	require.NoError(t, err)
+	require.Equal(t, []types.PausableFunction{
+		types.PausableFunctionStake,
+		types.PausableFunctionHarvest,
+		types.PausableFunctionRewardAllocation,
+	}, functions)
+
+	_, err = cli.ParsePausableFunctions([]string{"unspecified"})
+	require.Error(t, err)
+
+	_, err = cli.ParsePausableFunctions([]string{"withdraw"})
+	require.Error(t, err)
+}
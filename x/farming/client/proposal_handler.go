@@ -8,7 +8,10 @@ import (
 )
 
 // ProposalHandler is the public plan command handler.
-// Note that rest.ProposalRESTHandler will be deprecated in the future.
+// PauseProposalHandler and UnpauseProposalHandler are the pause and unpause command handlers.
+// Note that the REST handlers will be deprecated in the future.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitPublicPlanProposal, rest.ProposalRESTHandler)
+	ProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitPublicPlanProposal, rest.ProposalRESTHandler)
+	PauseProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitPauseProposal, rest.PauseProposalRESTHandler)
+	UnpauseProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUnpauseProposal, rest.UnpauseProposalRESTHandler)
 )
@@ -4,7 +4,12 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tendermint/farming/x/farming/types"
 )
 
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the farming plan proposal (add/update/delete) REST handler with a given sub-route.
@@ -17,5 +22,74 @@ func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 
 func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		var req PublicPlanProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewPublicPlanProposal(
+			req.Title, req.Description, req.AddPlanRequests, req.ModifyPlanRequests, req.DeletePlanRequests)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// PauseProposalRESTHandler returns a ProposalRESTHandler that exposes the farming pause proposal REST handler with a given sub-route.
+func PauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "farming_pause",
+		Handler:  postPauseProposalHandlerFn(clientCtx, false),
+	}
+}
+
+// UnpauseProposalRESTHandler returns a ProposalRESTHandler that exposes the farming unpause proposal REST handler with a given sub-route.
+func UnpauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "farming_unpause",
+		Handler:  postPauseProposalHandlerFn(clientCtx, true),
+	}
+}
+
+func postPauseProposalHandlerFn(clientCtx client.Context, unpause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PauseProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var content govtypes.Content
+		if unpause {
+			content = types.NewUnpauseProposal(req.Title, req.Description, req.Functions)
+		} else {
+			content = types.NewPauseProposal(req.Title, req.Description, req.Functions)
+		}
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
@@ -0,0 +1,34 @@
+package rest
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+type (
+	// PublicPlanProposalReq defines a public plan proposal request body.
+	PublicPlanProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title              string                    `json:"title" yaml:"title"`
+		Description        string                    `json:"description" yaml:"description"`
+		AddPlanRequests    []types.AddPlanRequest    `json:"add_plan_requests" yaml:"add_plan_requests"`
+		ModifyPlanRequests []types.ModifyPlanRequest `json:"modify_plan_requests" yaml:"modify_plan_requests"`
+		DeletePlanRequests []types.DeletePlanRequest `json:"delete_plan_requests" yaml:"delete_plan_requests"`
+		Proposer           sdk.AccAddress            `json:"proposer" yaml:"proposer"`
+		Deposit            sdk.Coins                 `json:"deposit" yaml:"deposit"`
+	}
+
+	// PauseProposalReq defines a pause or unpause proposal request body.
+	PauseProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                   `json:"title" yaml:"title"`
+		Description string                   `json:"description" yaml:"description"`
+		Functions   []types.PausableFunction `json:"functions" yaml:"functions"`
+		Proposer    sdk.AccAddress           `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins                `json:"deposit" yaml:"deposit"`
+	}
+)
@@ -10,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
-	"github.com/cosmos/cosmos-sdk/simapp/params"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -19,15 +18,20 @@ import (
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 
 	farmingapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/app/params"
 	farmingcli "github.com/tendermint/farming/x/farming/client/cli"
 )
 
 // NewConfig returns config that defines the necessary testing requirements
 // used to bootstrap and start an in-process local testing network.
 func NewConfig(dbm *dbm.MemDB) network.Config {
-	encCfg := simapp.MakeTestEncodingConfig()
+	encCfg := farmingapp.MakeEncodingConfig()
 
 	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
 	cfg.AppConstructor = NewAppConstructor(encCfg, dbm)                  // the ABCI application constructor
 	cfg.GenesisState = farmingapp.ModuleBasics.DefaultGenesis(cfg.Codec) // farming genesis state to provide
 	return cfg
@@ -38,7 +42,7 @@ func NewAppConstructor(encodingCfg params.EncodingConfig, db *dbm.MemDB) network
 	return func(val network.Validator) servertypes.Application {
 		return farmingapp.NewFarmingApp(
 			val.Ctx.Logger, db, nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
-			farmingapp.MakeEncodingConfig(),
+			encodingCfg,
 			simapp.EmptyAppOptions{},
 			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
 			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
@@ -3,9 +3,12 @@ package testutil
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	tmdb "github.com/tendermint/tm-db"
 
@@ -14,9 +17,15 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/tendermint/farming/x/farming/client/cli"
 	farmingcli "github.com/tendermint/farming/x/farming/client/cli"
+	farmingrest "github.com/tendermint/farming/x/farming/client/rest"
 	farmingkeeper "github.com/tendermint/farming/x/farming/keeper"
 	"github.com/tendermint/farming/x/farming/types"
 	farmingtypes "github.com/tendermint/farming/x/farming/types"
@@ -426,6 +435,18 @@ func (s *IntegrationTestSuite) TestNewStakeCmd() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"valid transaction case with lock",
+			[]string{
+				sdk.NewInt64Coin("stake", 100000).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockDuration, "720h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 		{
 			"invalid staking coin case #1",
 			[]string{
@@ -461,6 +482,78 @@ func (s *IntegrationTestSuite) TestNewStakeCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewStakeForCmd() {
+	val := s.network.Validators[0]
+
+	farmer := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction case",
+			[]string{
+				farmer.String(),
+				sdk.NewInt64Coin("stake", 100000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid farmer address case",
+			[]string{
+				"invalidaddr",
+				sdk.NewInt64Coin("stake", 100000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid staking coin case",
+			[]string{
+				farmer.String(),
+				sdk.NewInt64Coin("stake", 0).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewStakeForCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewUnstakeCmd() {
 	val := s.network.Validators[0]
 
@@ -538,6 +631,84 @@ func (s *IntegrationTestSuite) TestNewUnstakeCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTransferStakingCmd() {
+	val := s.network.Validators[0]
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("recipient")))
+
+	_, err := MsgStakeExec(
+		val.ClientCtx,
+		val.Address.String(),
+		sdk.NewInt64Coin("stake", 10_000_000).String(),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction case",
+			[]string{
+				recipient.String(),
+				sdk.NewInt64Coin("stake", 100000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid recipient address case",
+			[]string{
+				"invalidaddr",
+				sdk.NewInt64Coin("stake", 100000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid staking coin case",
+			[]string{
+				recipient.String(),
+				sdk.NewInt64Coin("stake", 0).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewTransferStakingCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewHarvestCmd() {
 	val := s.network.Validators[0]
 
@@ -620,116 +791,1231 @@ func (s *IntegrationTestSuite) TestNewHarvestCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 			},
-			false, &sdk.TxResponse{}, 0,
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid staking coin denoms case #1",
+			[]string{
+				"invaliddenom!",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 18,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewHarvestCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewModifyPrivatePlanCmd() {
+	val := s.network.Validators[0]
+
+	req := cli.PrivateFixedPlanRequest{
+		Name:               "test",
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9998-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	// create a fixed amount plan
+	_, err := MsgCreateFixedAmountPlanExec(
+		val.ClientCtx,
+		val.Address.String(),
+		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
+	)
+	s.Require().NoError(err)
+
+	endTime := types.ParseTime("9999-01-01T00:00:00Z")
+	modifyReq := cli.PrivateModifyPlanRequest{
+		EndTime:     &endTime,
+		EpochAmount: sdk.NewCoins(sdk.NewInt64Coin("node0token", 200_000_000)),
+	}
+	modifyFile := testutil.WriteToNewTempFile(s.T(), modifyReq.String()).Name()
+	emptyFile := testutil.WriteToNewTempFile(s.T(), cli.PrivateModifyPlanRequest{}.String()).Name()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction",
+			[]string{
+				"1",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"plan not found",
+			[]string{
+				"10",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, sdkerrors.ErrNotFound.ABCICode(),
+		},
+		{
+			"invalid plan id",
+			[]string{
+				"a",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"nothing to modify",
+			[]string{
+				"1",
+				emptyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewModifyPrivatePlanCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetAutoCompoundCmd() {
+	val := s.network.Validators[0]
+
+	_, err := MsgStakeExec(
+		val.ClientCtx,
+		val.Address.String(),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000_000)).String(),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"enable",
+			[]string{
+				"true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"disable",
+			[]string{
+				"false",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid enabled",
+			[]string{
+				"maybe",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetAutoCompoundCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetRewardsWithdrawAddressCmd() {
+	val := s.network.Validators[0]
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdraw")))
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction",
+			[]string{
+				withdrawAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"blocked withdraw address",
+			[]string{
+				authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"invalid withdraw address",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetRewardsWithdrawAddressCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewClaimVestedCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"no vested rewards",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, types.ErrNoVestedRewards.ABCICode(),
+		},
+		{
+			"unexpected argument",
+			[]string{
+				"extra",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewClaimVestedCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestPostProposalRESTHandler() {
+	val := s.network.Validators[0]
+
+	account, err := val.ClientCtx.AccountRetriever.GetAccount(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+
+	baseReq := rest.NewBaseReq(
+		val.Address.String(), "", s.cfg.ChainID, "", "", account.GetAccountNumber(), account.GetSequence(),
+		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)), nil, false,
+	)
+
+	addReq := types.AddPlanRequest{
+		Name:               "test",
+		FarmingPoolAddress: val.Address.String(),
+		TerminationAddress: val.Address.String(),
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	testCases := []struct {
+		name      string
+		req       farmingrest.PublicPlanProposalReq
+		expectErr bool
+	}{
+		{
+			"valid request",
+			farmingrest.PublicPlanProposalReq{
+				BaseReq:         baseReq,
+				Title:           "title",
+				Description:     "description",
+				AddPlanRequests: []types.AddPlanRequest{addReq},
+				Proposer:        val.Address,
+				Deposit:         sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10_000_000)),
+			},
+			false,
+		},
+		{
+			"invalid base request",
+			farmingrest.PublicPlanProposalReq{
+				BaseReq:         rest.BaseReq{},
+				Title:           "title",
+				Description:     "description",
+				AddPlanRequests: []types.AddPlanRequest{addReq},
+				Proposer:        val.Address,
+				Deposit:         sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10_000_000)),
+			},
+			true,
+		},
+		{
+			"empty proposal requests",
+			farmingrest.PublicPlanProposalReq{
+				BaseReq:     baseReq,
+				Title:       "title",
+				Description: "description",
+				Proposer:    val.Address,
+				Deposit:     sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10_000_000)),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			bz, err := val.ClientCtx.LegacyAmino.MarshalJSON(tc.req)
+			s.Require().NoError(err)
+
+			url := fmt.Sprintf("%s/gov/proposals/farming_plan", val.APIAddress)
+			resp, err := rest.PostRequest(url, "application/json", bz)
+			s.Require().NoError(err)
+
+			var stdTx legacytx.StdTx
+			err = val.ClientCtx.LegacyAmino.UnmarshalJSON(resp, &stdTx)
+			if tc.expectErr {
+				s.Require().Error(err, string(resp))
+				return
+			}
+			s.Require().NoError(err, string(resp))
+
+			msgs := stdTx.GetMsgs()
+			s.Require().Len(msgs, 1)
+			msg, ok := msgs[0].(*govtypes.MsgSubmitProposal)
+			s.Require().True(ok)
+			s.Require().Equal(val.Address.String(), msg.Proposer)
+
+			content, ok := msg.GetContent().(*types.PublicPlanProposal)
+			s.Require().True(ok)
+			s.Require().Equal("title", content.Title)
+			s.Require().Len(content.AddPlanRequests, 1)
+			s.Require().Equal(addReq.Name, content.AddPlanRequests[0].Name)
+		})
+	}
+}
+
+type QueryCmdTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *QueryCmdTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	farmingkeeper.EnableAdvanceEpoch = true
+
+	db := tmdb.NewMemDB()
+	cfg := NewConfig(db)
+	cfg.NumValidators = 1
+
+	var genesisState farmingtypes.GenesisState
+	err := cfg.Codec.UnmarshalJSON(cfg.GenesisState[farmingtypes.ModuleName], &genesisState)
+	s.Require().NoError(err)
+
+	genesisState.Params = farmingtypes.DefaultParams()
+	cfg.GenesisState[farmingtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&genesisState)
+	cfg.AccountTokens = sdk.NewInt(100_000_000_000) // node0token denom
+	cfg.StakingTokens = sdk.NewInt(100_000_000_000) // stake denom
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+
+	req := cli.PrivateFixedPlanRequest{
+		Name:               "test",
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	// create a fixed amount plan
+	_, err = MsgCreateFixedAmountPlanExec(
+		val.ClientCtx,
+		val.Address.String(),
+		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
+	)
+	s.Require().NoError(err)
+
+	// query the farming pool address that is assigned to the pool and
+	// transfer some amount of coins to the address
+	s.fundFarmingPool(1, sdk.NewCoins(sdk.NewInt64Coin("node0token", 1_000_000_000)))
+
+	_, err = MsgStakeExec(
+		val.ClientCtx,
+		val.Address.String(),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)).String(),
+	)
+	s.Require().NoError(err)
+
+	_, err = MsgAdvanceEpochExec(val.ClientCtx, val.Address.String())
+	s.Require().NoError(err)
+
+	_, err = MsgAdvanceEpochExec(val.ClientCtx, val.Address.String())
+	s.Require().NoError(err)
+}
+
+func (s *QueryCmdTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryParams() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"happy case",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+		{
+			"with specific height",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryParams()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().NotEqual("internal", err.Error())
+			} else {
+				s.Require().NoError(err)
+
+				var params farmingtypes.Params
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &params))
+				s.Require().NotEmpty(params.FarmingFeeCollector)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryPlans() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	types.RegisterInterfaces(clientCtx.InterfaceRegistry)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryPlansResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryPlansResponse) {
+				plans, err := types.UnpackPlans(resp.Plans)
+				s.Require().NoError(err)
+				s.Require().Len(plans, 1)
+				s.Require().Equal(uint64(1), plans[0].GetId())
+			},
+		},
+		{
+			"invalid plan type",
+			[]string{
+				fmt.Sprintf("--%s=%s", farmingcli.FlagPlanType, "invalid"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid farming pool addr",
+			[]string{
+				fmt.Sprintf("--%s=%s", farmingcli.FlagFarmingPoolAddr, "invalid"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid termination addr",
+			[]string{
+				fmt.Sprintf("--%s=%s", farmingcli.FlagTerminationAddr, "invalid"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				fmt.Sprintf("--%s=%s", farmingcli.FlagStakingCoinDenom, "!"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPlans()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryPlansResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryPlan() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	types.RegisterInterfaces(clientCtx.InterfaceRegistry)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryPlanResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				strconv.Itoa(1),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryPlanResponse) {
+				plan, err := types.UnpackPlan(resp.Plan)
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(1), plan.GetId())
+			},
+		},
+		{
+			"id not found",
+			[]string{
+				strconv.Itoa(10),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPlan()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryPlanResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryStakingsResponse) {
+				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)), resp.StakedCoins))
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryStakings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryStakingsByDenom() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryStakingsByDenomResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryStakingsByDenomResponse) {
+				s.Require().Len(resp.Stakings, 1)
+				s.Require().Equal(val.Address.String(), resp.Stakings[0].Farmer)
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.Stakings[0].StakedAmount))
+				s.Require().True(intEq(sdk.ZeroInt(), resp.Stakings[0].QueuedAmount))
+			},
+		},
+		{
+			"with pagination",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s", flags.FlagCountTotal),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryStakingsByDenomResponse) {
+				s.Require().Len(resp.Stakings, 1)
+				s.Require().EqualValues(1, resp.Pagination.Total)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryStakingsByDenom()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryStakingsByDenomResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryQueuedStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryQueuedStakingsResponse) {
+				s.Require().True(resp.QueuedCoins.IsZero())
+				s.Require().NotNil(resp.NextEpochTime)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryQueuedStakings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryQueuedStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryLocks() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryLocksResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryLocksResponse) {
+				s.Require().Empty(resp.Locks)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLocks()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryLocksResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryUnbondings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryUnbondingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryUnbondingsResponse) {
+				s.Require().Empty(resp.Unbondings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUnbondings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryUnbondingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryVestedRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryVestedRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *types.QueryVestedRewardsResponse) {
+				s.Require().Empty(resp.Vestings)
+				s.Require().True(resp.Vested.IsZero())
+				s.Require().True(resp.Unvested.IsZero())
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryVestedRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryVestedRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryTotalStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryTotalStakingsResponse) {
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.Amount))
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryTotalStakings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryTotalStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryRewardsResponse) {
+				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.Rewards))
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryHistoricalRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryHistoricalRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryHistoricalRewardsResponse) {
+				s.Require().Len(resp.HistoricalRewards, 2)
+				s.Require().Equal(uint64(0), resp.HistoricalRewards[0].Epoch)
+				s.Require().True(resp.HistoricalRewards[0].CumulativeUnitRewards.IsZero())
+				s.Require().Equal(uint64(1), resp.HistoricalRewards[1].Epoch)
+				s.Require().True(decCoinsEq(
+					sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 100)),
+					resp.HistoricalRewards[1].CumulativeUnitRewards))
+			},
+		},
+		{
+			"with epoch range",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=1", cli.FlagStartEpoch),
+				fmt.Sprintf("--%s=1", cli.FlagEndEpoch),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryHistoricalRewardsResponse) {
+				s.Require().Len(resp.HistoricalRewards, 1)
+				s.Require().Equal(uint64(1), resp.HistoricalRewards[0].Epoch)
+			},
+		},
+		{
+			"with pagination",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryHistoricalRewardsResponse) {
+				s.Require().Len(resp.HistoricalRewards, 1)
+				s.Require().Equal(uint64(0), resp.HistoricalRewards[0].Epoch)
+				s.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryHistoricalRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryHistoricalRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryOutstandingRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryOutstandingRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryOutstandingRewardsResponse) {
+				s.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 100_000_000)), resp.Rewards))
+			},
 		},
 		{
-			"invalid staking coin denoms case #1",
+			"invalid staking coin denom",
 			[]string{
-				"invaliddenom!",
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			true, &sdk.TxResponse{}, 18,
+			true,
+			nil,
 		},
 	}
 
 	for _, tc := range testCases {
-		tc := tc
-
 		s.Run(tc.name, func() {
-			cmd := cli.NewHarvestCmd()
-			clientCtx := val.ClientCtx
+			cmd := cli.GetCmdQueryOutstandingRewards()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
-
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
-				s.Require().NoError(err, out.String())
-				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
-
-				txResp := tc.respType.(*sdk.TxResponse)
-				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+				s.Require().NoError(err)
+				var resp types.QueryOutstandingRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
 			}
 		})
 	}
 }
 
-type QueryCmdTestSuite struct {
-	suite.Suite
-
-	cfg     network.Config
-	network *network.Network
-}
-
-func (s *QueryCmdTestSuite) SetupSuite() {
-	s.T().Log("setting up integration test suite")
-
-	farmingkeeper.EnableAdvanceEpoch = true
-
-	db := tmdb.NewMemDB()
-	cfg := NewConfig(db)
-	cfg.NumValidators = 1
-
-	var genesisState farmingtypes.GenesisState
-	err := cfg.Codec.UnmarshalJSON(cfg.GenesisState[farmingtypes.ModuleName], &genesisState)
-	s.Require().NoError(err)
-
-	genesisState.Params = farmingtypes.DefaultParams()
-	cfg.GenesisState[farmingtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&genesisState)
-	cfg.AccountTokens = sdk.NewInt(100_000_000_000) // node0token denom
-	cfg.StakingTokens = sdk.NewInt(100_000_000_000) // stake denom
-
-	s.cfg = cfg
-	s.network = network.New(s.T(), cfg)
-
-	_, err = s.network.WaitForHeight(1)
-	s.Require().NoError(err)
-
-	val := s.network.Validators[0]
-
-	req := cli.PrivateFixedPlanRequest{
-		Name:               "test",
-		StakingCoinWeights: sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)),
-		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
-		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
-		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
-	}
-
-	// create a fixed amount plan
-	_, err = MsgCreateFixedAmountPlanExec(
-		val.ClientCtx,
-		val.Address.String(),
-		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
-	)
-	s.Require().NoError(err)
-
-	// query the farming pool address that is assigned to the pool and
-	// transfer some amount of coins to the address
-	s.fundFarmingPool(1, sdk.NewCoins(sdk.NewInt64Coin("node0token", 1_000_000_000)))
-
-	_, err = MsgStakeExec(
-		val.ClientCtx,
-		val.Address.String(),
-		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)).String(),
-	)
-	s.Require().NoError(err)
-
-	_, err = MsgAdvanceEpochExec(val.ClientCtx, val.Address.String())
-	s.Require().NoError(err)
-
-	_, err = MsgAdvanceEpochExec(val.ClientCtx, val.Address.String())
-	s.Require().NoError(err)
-}
-
-func (s *QueryCmdTestSuite) TearDownSuite() {
-	s.T().Log("tearing down integration test suite")
-	s.network.Cleanup()
-}
-
-func (s *QueryCmdTestSuite) TestCmdQueryParams() {
+func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpoch() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -737,93 +2023,90 @@ func (s *QueryCmdTestSuite) TestCmdQueryParams() {
 		name      string
 		args      []string
 		expectErr bool
+		postRun   func(*types.QueryCurrentEpochResponse)
 	}{
 		{
 			"happy case",
-			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
 			false,
+			func(resp *farmingtypes.QueryCurrentEpochResponse) {
+				s.Require().Equal(uint64(2), resp.CurrentEpoch)
+			},
 		},
 		{
-			"with specific height",
-			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			false,
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryParams()
+			cmd := cli.GetCmdQueryCurrentEpoch()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
-				s.Require().NotEqual("internal", err.Error())
 			} else {
 				s.Require().NoError(err)
-
-				var params farmingtypes.Params
-				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &params))
-				s.Require().NotEmpty(params.FarmingFeeCollector)
+				var resp types.QueryCurrentEpochResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
 			}
 		})
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryPlans() {
+func (s *QueryCmdTestSuite) TestCmdQueryExpectedRewards() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
-	types.RegisterInterfaces(clientCtx.InterfaceRegistry)
 
 	testCases := []struct {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryPlansResponse)
+		postRun   func(*types.QueryExpectedRewardsResponse)
 	}{
 		{
 			"happy case",
 			[]string{
+				sdk.DefaultBondDenom,
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryPlansResponse) {
-				plans, err := types.UnpackPlans(resp.Plans)
-				s.Require().NoError(err)
-				s.Require().Len(plans, 1)
-				s.Require().Equal(uint64(1), plans[0].GetId())
-			},
-		},
-		{
-			"invalid plan type",
-			[]string{
-				fmt.Sprintf("--%s=%s", farmingcli.FlagPlanType, "invalid"),
-				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			func(resp *farmingtypes.QueryExpectedRewardsResponse) {
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.TotalStakings))
+				s.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 100)), resp.UnitRewards))
+				s.Require().Len(resp.PlanRewards, 1)
+				s.Require().True(resp.PlanRewards[0].SufficientBalance)
 			},
-			true,
-			nil,
 		},
 		{
-			"invalid farming pool addr",
+			"with farmer and staking amount",
 			[]string{
-				fmt.Sprintf("--%s=%s", farmingcli.FlagFarmingPoolAddr, "invalid"),
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=%s", cli.FlagFarmer, val.Address.String()),
+				fmt.Sprintf("--%s=1000000", cli.FlagStakingAmount),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			true,
-			nil,
-		},
-		{
-			"invalid termination addr",
-			[]string{
-				fmt.Sprintf("--%s=%s", farmingcli.FlagTerminationAddr, "invalid"),
-				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			false,
+			func(resp *farmingtypes.QueryExpectedRewardsResponse) {
+				s.Require().True(intEq(sdk.NewInt(2000000), resp.TotalStakings))
+				s.Require().True(intEq(sdk.NewInt(2000000), resp.StakedAmount))
+				s.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 100_000_000)), resp.Rewards))
 			},
-			true,
-			nil,
 		},
 		{
 			"invalid staking coin denom",
 			[]string{
-				fmt.Sprintf("--%s=%s", farmingcli.FlagStakingCoinDenom, "!"),
+				"!",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			true,
@@ -833,14 +2116,14 @@ func (s *QueryCmdTestSuite) TestCmdQueryPlans() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryPlans()
+			cmd := cli.GetCmdQueryExpectedRewards()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryPlansResponse
+				var resp types.QueryExpectedRewardsResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -848,51 +2131,44 @@ func (s *QueryCmdTestSuite) TestCmdQueryPlans() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryPlan() {
+func (s *QueryCmdTestSuite) TestCmdQuerySimulateAllocation() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
-	types.RegisterInterfaces(clientCtx.InterfaceRegistry)
 
 	testCases := []struct {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryPlanResponse)
+		postRun   func(*types.QuerySimulateAllocationResponse)
 	}{
 		{
 			"happy case",
 			[]string{
-				strconv.Itoa(1),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryPlanResponse) {
-				plan, err := types.UnpackPlan(resp.Plan)
-				s.Require().NoError(err)
-				s.Require().Equal(uint64(1), plan.GetId())
+			func(resp *farmingtypes.QuerySimulateAllocationResponse) {
+				s.Require().Len(resp.FarmingPoolAllocations, 1)
+				s.Require().False(resp.FarmingPoolAllocations[0].Skipped)
+				s.Require().Len(resp.PlanAllocations, 1)
+				s.Require().Equal(uint64(1), resp.PlanAllocations[0].PlanId)
+				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.PlanAllocations[0].AllocatedAmount))
+				s.Require().Len(resp.UnitRewards, 1)
+				s.Require().Equal(sdk.DefaultBondDenom, resp.UnitRewards[0].StakingCoinDenom)
 			},
 		},
-		{
-			"id not found",
-			[]string{
-				strconv.Itoa(10),
-				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
-			},
-			true,
-			nil,
-		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryPlan()
+			cmd := cli.GetCmdQuerySimulateAllocation()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryPlanResponse
+				var resp types.QuerySimulateAllocationResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -900,7 +2176,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryPlan() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryStakings() {
+func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -908,40 +2184,73 @@ func (s *QueryCmdTestSuite) TestCmdQueryStakings() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryStakingsResponse)
+		postRun   func(*types.QueryCurrentEpochDaysResponse)
 	}{
 		{
 			"happy case",
 			[]string{
-				val.Address.String(),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryStakingsResponse) {
-				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)), resp.StakedCoins))
+			func(resp *farmingtypes.QueryCurrentEpochDaysResponse) {
+				s.Require().Equal(uint32(1), resp.CurrentEpochDays)
 			},
 		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryCurrentEpochDays()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				// The deprecation notice is printed before the response.
+				lines := strings.SplitN(out.String(), "\n", 2)
+				s.Require().Len(lines, 2)
+				s.Require().Contains(lines[0], "deprecated")
+				var resp types.QueryCurrentEpochDaysResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON([]byte(lines[1]), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDuration() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochDurationResponse)
+	}{
 		{
-			"invalid farmer addr",
+			"happy case",
 			[]string{
-				"invalid",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			true,
-			nil,
+			false,
+			func(resp *farmingtypes.QueryCurrentEpochDurationResponse) {
+				s.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+			},
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryStakings()
+			cmd := cli.GetCmdQueryCurrentEpochDuration()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryStakingsResponse
+				var resp types.QueryCurrentEpochDurationResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -949,7 +2258,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryStakings() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
+func (s *QueryCmdTestSuite) TestCmdQueryEpochInfo() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -957,23 +2266,26 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryTotalStakingsResponse)
+		postRun   func(*types.QueryEpochInfoResponse)
 	}{
 		{
 			"happy case",
 			[]string{
-				sdk.DefaultBondDenom,
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryTotalStakingsResponse) {
-				s.Require().True(intEq(sdk.NewInt(1000000), resp.Amount))
+			func(resp *farmingtypes.QueryEpochInfoResponse) {
+				s.Require().NotNil(resp.LastEpochTime)
+				s.Require().NotNil(resp.NextEpochTime)
+				s.Require().Equal(farmingtypes.DefaultNextEpochDuration, resp.CurrentEpochDuration)
+				s.Require().Len(resp.CurrentEpochs, 1)
+				s.Require().Equal(sdk.DefaultBondDenom, resp.CurrentEpochs[0].StakingCoinDenom)
 			},
 		},
 		{
 			"invalid staking coin denom",
 			[]string{
-				"!",
+				fmt.Sprintf("--%s=%s", cli.FlagStakingCoinDenom, "!"),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			true,
@@ -983,14 +2295,14 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryTotalStakings()
+			cmd := cli.GetCmdQueryEpochInfo()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryTotalStakingsResponse
+				var resp types.QueryEpochInfoResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -998,7 +2310,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
+func (s *QueryCmdTestSuite) TestCmdQueryAutoCompound() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -1006,7 +2318,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryRewardsResponse)
+		postRun   func(*types.QueryAutoCompoundResponse)
 	}{
 		{
 			"happy case",
@@ -1015,8 +2327,8 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryRewardsResponse) {
-				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.Rewards))
+			func(resp *types.QueryAutoCompoundResponse) {
+				s.Require().False(resp.Enabled)
 			},
 		},
 		{
@@ -1032,14 +2344,14 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryRewards()
+			cmd := cli.GetCmdQueryAutoCompound()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryRewardsResponse
+				var resp types.QueryAutoCompoundResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -1047,7 +2359,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
+func (s *QueryCmdTestSuite) TestCmdQueryRewardsWithdrawAddress() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -1055,30 +2367,40 @@ func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryCurrentEpochDaysResponse)
+		postRun   func(*types.QueryRewardsWithdrawAddressResponse)
 	}{
 		{
 			"happy case",
 			[]string{
+				val.Address.String(),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryCurrentEpochDaysResponse) {
-				s.Require().Equal(uint32(1), resp.CurrentEpochDays)
+			func(resp *types.QueryRewardsWithdrawAddressResponse) {
+				s.Require().Equal(val.Address.String(), resp.WithdrawAddress)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
+			true,
+			nil,
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryCurrentEpochDays()
+			cmd := cli.GetCmdQueryRewardsWithdrawAddress()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryCurrentEpochDaysResponse
+				var resp types.QueryRewardsWithdrawAddressResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -1121,3 +2443,7 @@ func intEq(exp, got sdk.Int) (bool, string, string, string) {
 func coinsEq(exp, got sdk.Coins) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
+
+func decCoinsEq(exp, got sdk.DecCoins) (bool, string, string, string) {
+	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
+}
@@ -24,14 +24,26 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CreateRatioPlan(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateDecayingAmountPlan:
+			res, err := msgServer.CreateDecayingAmountPlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgStake:
 			res, err := msgServer.Stake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgStakeFor:
+			res, err := msgServer.StakeFor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgUnstake:
 			res, err := msgServer.Unstake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgTransferStaking:
+			res, err := msgServer.TransferStaking(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgHarvest:
 			res, err := msgServer.Harvest(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -40,6 +52,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.RemovePlan(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgModifyPrivatePlan:
+			res, err := msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetAutoCompound:
+			res, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetRewardsWithdrawAddress:
+			res, err := msgServer.SetRewardsWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClaimVested:
+			res, err := msgServer.ClaimVested(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgAdvanceEpoch:
 			res, err := msgServer.AdvanceEpoch(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -51,13 +79,20 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 // NewPublicPlanProposalHandler creates a governance handler to manage new proposal types.
-// It enables PublicPlanProposal to propose a plan creation / modification / deletion.
+// It enables PublicPlanProposal to propose a plan creation / modification / deletion,
+// and PauseProposal and UnpauseProposal to pause and unpause functions of the module.
 func NewPublicPlanProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.PublicPlanProposal:
 			return keeper.HandlePublicPlanProposal(ctx, k, c)
 
+		case *types.PauseProposal:
+			return keeper.HandlePauseProposal(ctx, k, c)
+
+		case *types.UnpauseProposal:
+			return keeper.HandleUnpauseProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized farming proposal content type: %T", c)
 		}
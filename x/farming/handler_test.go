@@ -121,7 +121,7 @@ func (suite *ModuleTestSuite) TestMsgHarvest() {
 	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
-	err := suite.keeper.AllocateRewards(suite.ctx)
+	err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 	suite.Require().NoError(err)
 
 	rewards := suite.Rewards(suite.addrs[0])
@@ -155,8 +155,17 @@ func (suite *ModuleTestSuite) TestMsgRemovePlan() {
 	_, err = handler(suite.ctx, types.NewMsgRemovePlan(suite.addrs[4], 1))
 	suite.Require().EqualError(err, "plan 1 is not terminated yet: invalid request")
 
+	// The plan is terminated once the epochs missed until its end time are
+	// caught up.
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2023-01-01T00:00:00Z"))
-	farming.EndBlocker(suite.ctx, suite.keeper) // The plan is terminated now.
+	for {
+		farming.EndBlocker(suite.ctx, suite.keeper)
+		if nextEpochTime, _ := suite.keeper.NextEpochTime(suite.ctx); nextEpochTime.After(suite.ctx.BlockTime()) {
+			break
+		}
+	}
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(plan.IsTerminated())
 
 	// Wrong creator.
 	_, err = handler(suite.ctx, types.NewMsgRemovePlan(suite.addrs[0], 1))
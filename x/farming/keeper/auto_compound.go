@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetAutoCompound returns whether auto-compounding of rewards is enabled
+// for the farmer.
+func (k Keeper) GetAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(farmerAcc))
+}
+
+// SetAutoCompound enables or disables auto-compounding of rewards for the farmer.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoCompoundKey(farmerAcc), []byte{})
+	} else {
+		store.Delete(types.GetAutoCompoundKey(farmerAcc))
+	}
+}
+
+// IterateAutoCompoundFarmers iterates through all farmers who enabled
+// auto-compounding and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAutoCompoundFarmers(ctx sdk.Context, cb func(farmerAcc sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc := types.ParseAutoCompoundKey(iter.Key())
+		if cb(farmerAcc) {
+			break
+		}
+	}
+}
+
+// hasStakingPositions returns true if a farmer has any staking, queued
+// staking or lock.
+func (k Keeper) hasStakingPositions(ctx sdk.Context, farmerAcc sdk.AccAddress) (found bool) {
+	k.IterateStakingsByFarmer(ctx, farmerAcc, func(string, types.Staking) (stop bool) {
+		found = true
+		return true
+	})
+	if found {
+		return
+	}
+	k.IterateQueuedStakingsByFarmer(ctx, farmerAcc, func(string, types.QueuedStaking) (stop bool) {
+		found = true
+		return true
+	})
+	if found {
+		return
+	}
+	k.IterateLocksByFarmer(ctx, farmerAcc, func(types.Lock) (stop bool) {
+		found = true
+		return true
+	})
+	return
+}
+
+// ProcessAutoCompounds withdraws rewards of the farmers who enabled
+// auto-compounding, and stakes the rewards in the denoms that are staking
+// coin denoms of any plan again as queued coins.
+// Each farmer's auto-compounding is executed with its own gas meter limited
+// by types.AutoCompoundGasLimit, and the farmer pays a fee for the gas
+// consumed at the AutoCompoundGasPrice param, since the gas is not charged
+// to any transaction.
+// Auto-compounding is disabled for the farmers who no longer have any
+// staking position, and for the farmers whose auto-compounding fails, so
+// that they do not cost the chain every epoch. The state changes for a
+// failing farmer are discarded and the rewards remain withdrawable.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) {
+	stakeableDenoms := map[string]bool{}
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
+		for _, weight := range plan.GetStakingCoinWeights() {
+			stakeableDenoms[weight.Denom] = true
+		}
+		return false
+	})
+	if len(stakeableDenoms) == 0 {
+		return
+	}
+
+	// Collect the farmers first, since the store is modified while
+	// auto-compounding.
+	var farmerAccs []sdk.AccAddress
+	k.IterateAutoCompoundFarmers(ctx, func(farmerAcc sdk.AccAddress) (stop bool) {
+		farmerAccs = append(farmerAccs, farmerAcc)
+		return false
+	})
+
+	params := k.GetParams(ctx)
+	for _, farmerAcc := range farmerAccs {
+		if !k.hasStakingPositions(ctx, farmerAcc) {
+			k.disableAutoCompound(ctx, farmerAcc)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		gasMeter := sdk.NewGasMeter(types.AutoCompoundGasLimit)
+		cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+		compounded, fee, err := k.autoCompound(cacheCtx, farmerAcc, stakeableDenoms, params)
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoCompoundFailed,
+					sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			k.disableAutoCompound(ctx, farmerAcc)
+			continue
+		}
+		if compounded.IsZero() {
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyStakingCoins, compounded.String()),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasMeter.GasConsumed(), 10)),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			),
+		)
+	}
+}
+
+// disableAutoCompound disables auto-compounding of rewards for the farmer
+// and emits an event for it.
+func (k Keeper) disableAutoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	k.SetAutoCompound(ctx, farmerAcc, false)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(false)),
+		),
+	)
+}
+
+// autoCompound withdraws all rewards of the farmer and stakes the withdrawn
+// rewards in the stakeable denoms, charging the fee for the gas consumed
+// until then to the farmer.
+// Rewards in the other denoms are sent to the farmer's rewards withdraw
+// address.
+// The fee for types.AutoCompoundGasLimit is kept in the farmer's balance
+// before staking, so if the balance does not cover it besides the withdrawn
+// rewards, the rewards staked again are reduced by the shortfall.
+// It returns the coins staked again and the fee paid.
+func (k Keeper) autoCompound(ctx sdk.Context, farmerAcc sdk.AccAddress, stakeableDenoms map[string]bool, params types.Params) (compounded, fee sdk.Coins, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+
+	rewards, planRewards, err := k.settleAllRewards(ctx, farmerAcc)
+	if err != nil {
+		return nil, nil, err
+	}
+	remaining := sdk.NewCoins()
+	for _, coin := range rewards {
+		if stakeableDenoms[coin.Denom] {
+			compounded = compounded.Add(coin)
+		} else {
+			remaining = remaining.Add(coin)
+		}
+	}
+	if compounded.IsZero() {
+		return nil, nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, farmerAcc, compounded); err != nil {
+		return nil, nil, err
+	}
+	if !remaining.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, k.GetRewardsWithdrawAddress(ctx, farmerAcc), remaining); err != nil {
+			return nil, nil, err
+		}
+	}
+	emitPlanRewardsWithdrawnEvents(ctx, farmerAcc, planRewards)
+	k.AfterRewardsWithdrawn(ctx, farmerAcc, rewards)
+
+	maxFee := params.GetAutoCompoundFee(types.AutoCompoundGasLimit)
+	if !maxFee.IsZero() {
+		spendable := k.bankKeeper.SpendableCoins(ctx, farmerAcc)
+		compounded = sdk.NewCoins()
+		for _, coin := range rewards {
+			if !stakeableDenoms[coin.Denom] {
+				continue
+			}
+			available := spendable.AmountOf(coin.Denom).Sub(maxFee.AmountOf(coin.Denom))
+			if available.IsPositive() {
+				compounded = compounded.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, available)))
+			}
+		}
+		if compounded.IsZero() {
+			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "nothing left to compound after reserving auto-compound fee")
+		}
+	}
+
+	if err := k.Stake(ctx, farmerAcc, compounded); err != nil {
+		return nil, nil, err
+	}
+
+	fee = params.GetAutoCompoundFee(ctx.GasMeter().GasConsumed())
+	if !fee.IsZero() {
+		feeCollectorAcc, _ := sdk.AccAddressFromBech32(params.FarmingFeeCollector) // Already validated
+		if err := k.bankKeeper.SendCoins(ctx, farmerAcc, feeCollectorAcc, fee); err != nil {
+			return nil, nil, sdkerrors.Wrap(err, "failed to pay auto-compound fee")
+		}
+	}
+
+	return compounded, fee, nil
+}
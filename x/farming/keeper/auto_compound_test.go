@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+// autoCompoundEvent returns the attributes of the auto-compounding event of
+// the farmer emitted in the current context.
+func (suite *KeeperTestSuite) autoCompoundEvent(farmerAcc sdk.AccAddress) (attrs map[string]string, found bool) {
+	for _, e := range suite.ctx.EventManager().ABCIEvents() {
+		if e.Type != types.EventTypeAutoCompound {
+			continue
+		}
+		attrs = map[string]string{}
+		for _, attr := range e.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if attrs[types.AttributeKeyFarmer] == farmerAcc.String() {
+			return attrs, true
+		}
+	}
+	return nil, false
+}
+
+// autoCompoundFee returns the fee paid by the farmer for auto-compounding
+// in the current context, checking it against the gas consumed.
+func (suite *KeeperTestSuite) autoCompoundFee(farmerAcc sdk.AccAddress) sdk.Coins {
+	attrs, found := suite.autoCompoundEvent(farmerAcc)
+	suite.Require().True(found)
+	gasUsed, err := strconv.ParseUint(attrs[types.AttributeKeyGasUsed], 10, 64)
+	suite.Require().NoError(err)
+	suite.Require().Positive(gasUsed)
+	fee, err := sdk.ParseCoinsNormalized(attrs[types.AttributeKeyFee])
+	suite.Require().NoError(err)
+	// The gas consumed by paying the fee itself is not charged.
+	suite.Require().True(suite.keeper.GetParams(suite.ctx).GetAutoCompoundFee(gasUsed).IsAllGTE(fee))
+	return fee
+}
+
+func (suite *KeeperTestSuite) TestSetAutoCompound() {
+	suite.Require().False(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], true)
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[1], true)
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[1]))
+
+	var farmerAccs []sdk.AccAddress
+	suite.keeper.IterateAutoCompoundFarmers(suite.ctx, func(farmerAcc sdk.AccAddress) (stop bool) {
+		farmerAccs = append(farmerAccs, farmerAcc)
+		return false
+	})
+	suite.Require().Len(farmerAccs, 2)
+
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], false)
+	suite.Require().False(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompound() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1_000_000, denom3: 500_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	_, err := suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().NoError(err)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.AdvanceEpoch()
+
+	// Rewards in the stakeable denom are staked again, and rewards in the
+	// other denoms are withdrawn to the farmer, who pays the fee for the gas
+	// consumed.
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_500_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	fee := suite.autoCompoundFee(suite.addrs[0])
+	suite.Require().False(fee.IsZero())
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 250_000)).Sub(fee),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	attrs, _ := suite.autoCompoundEvent(suite.addrs[0])
+	suite.Require().Equal("500000denom1", attrs[types.AttributeKeyStakingCoins])
+	_, found := suite.autoCompoundEvent(suite.addrs[1])
+	suite.Require().False(found)
+
+	// Farmers who did not enable auto-compounding are not affected.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000), sdk.NewInt64Coin(denom3, 250_000)),
+		suite.AllRewards(suite.addrs[1])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+
+	// Disable auto-compounding.
+	_, err = suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], false))
+	suite.Require().NoError(err)
+	suite.AdvanceEpoch()
+	suite.Require().False(suite.AllRewards(suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_500_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundNoStakeableRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], true)
+	suite.AdvanceEpoch()
+
+	// Nothing can be compounded, so the rewards are left untouched.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundWithRewardsWithdrawAddress() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1_000_000, denom3: 500_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], true)
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+
+	farmerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.AdvanceEpoch()
+
+	// Rewards in the stakeable denom are staked again by the farmer, and
+	// rewards in the other denoms are sent to the withdraw address.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		farmerBalances.Sub(suite.autoCompoundFee(suite.addrs[0])),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		withdrawBalances.Add(sdk.NewInt64Coin(denom3, 500_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+}
+
+func (suite *KeeperTestSuite) TestSetAutoCompoundWithoutStakingPosition() {
+	_, err := suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().ErrorIs(err, types.ErrStakingNotExists)
+	suite.Require().False(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+
+	// Disabling is always allowed.
+	_, err = suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], false))
+	suite.Require().NoError(err)
+
+	// Queued coins are a staking position.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	_, err = suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundDisabledWithoutStakingPosition() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	_, err := suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().NoError(err)
+
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Require().False(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundFee() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1_000_000})
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AutoCompoundGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.MustNewDecFromStr("0.1")))
+	suite.keeper.SetParams(suite.ctx, params)
+	feeCollectorAcc, _ := sdk.AccAddressFromBech32(params.FarmingFeeCollector)
+
+	// The farmer has no denom1 left, so the fee for the gas limit is kept out
+	// of the rewards and the rest of it is left to the farmer.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, initialBalances.AmountOf(denom1).Int64())))
+	suite.AdvanceEpoch()
+	_, err := suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetAutoCompound(suite.addrs[0], true))
+	suite.Require().NoError(err)
+
+	feesBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAcc, denom1)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.AdvanceEpoch()
+	fee := suite.autoCompoundFee(suite.addrs[0])
+	suite.Require().True(fee.AmountOf(denom1).IsPositive())
+	suite.Require().True(intEq(
+		initialBalances.AmountOf(denom1).AddRaw(900_000),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).AmountOf(denom1)))
+	suite.Require().True(intEq(
+		feesBefore.Amount.Add(fee.AmountOf(denom1)),
+		suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAcc, denom1).Amount))
+	suite.Require().True(intEq(
+		sdk.NewInt(100_000).Sub(fee.AmountOf(denom1)),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom1).Amount))
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+
+	// Auto-compounding fails and is disabled if the rewards do not cover the
+	// fee for the gas limit.
+	params.AutoCompoundGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.NewDec(2)))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.AdvanceEpoch()
+	suite.Require().False(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 999_999)), // Truncated
+		suite.AllRewards(suite.addrs[0])))
+}
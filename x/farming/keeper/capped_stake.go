@@ -0,0 +1,457 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetCappedTotalStakings returns the total amount of stakes counted toward
+// a plan with stake caps for a given staking coin denom.
+func (k Keeper) GetCappedTotalStakings(ctx sdk.Context, stakingCoinDenom string, planId uint64) (totalStakings types.TotalStakings, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCappedTotalStakingsKey(stakingCoinDenom, planId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &totalStakings)
+	found = true
+	return
+}
+
+// SetCappedTotalStakings sets the total amount of stakes counted toward
+// a plan with stake caps for a given staking coin denom.
+func (k Keeper) SetCappedTotalStakings(ctx sdk.Context, stakingCoinDenom string, planId uint64, totalStakings types.TotalStakings) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&totalStakings)
+	store.Set(types.GetCappedTotalStakingsKey(stakingCoinDenom, planId), bz)
+}
+
+// IterateCappedTotalStakings iterates through all capped total stakings
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateCappedTotalStakings(ctx sdk.Context, cb func(stakingCoinDenom string, planId uint64, totalStakings types.TotalStakings) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CappedTotalStakingsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var totalStakings types.TotalStakings
+		k.cdc.MustUnmarshal(iter.Value(), &totalStakings)
+		stakingCoinDenom, planId := types.ParseCappedTotalStakingsKey(iter.Key())
+		if cb(stakingCoinDenom, planId, totalStakings) {
+			break
+		}
+	}
+}
+
+// IterateCappedTotalStakingsByDenom iterates through all capped total
+// stakings for a staking coin denom and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateCappedTotalStakingsByDenom(ctx sdk.Context, stakingCoinDenom string, cb func(planId uint64, totalStakings types.TotalStakings) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCappedTotalStakingsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var totalStakings types.TotalStakings
+		k.cdc.MustUnmarshal(iter.Value(), &totalStakings)
+		_, planId := types.ParseCappedTotalStakingsKey(iter.Key())
+		if cb(planId, totalStakings) {
+			break
+		}
+	}
+}
+
+// DeleteCappedTotalStakings deletes the capped total stakings of a plan for
+// a given staking coin denom.
+func (k Keeper) DeleteCappedTotalStakings(ctx sdk.Context, stakingCoinDenom string, planId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCappedTotalStakingsKey(stakingCoinDenom, planId))
+}
+
+// GetCappedStake returns the portion of a farmer's stake counted toward
+// a plan with stake caps for a given staking coin denom.
+func (k Keeper) GetCappedStake(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64) (cappedStake types.CappedStake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCappedStakeKey(stakingCoinDenom, farmerAcc, planId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &cappedStake)
+	found = true
+	return
+}
+
+// SetCappedStake sets the portion of a farmer's stake counted toward
+// a plan with stake caps for a given staking coin denom.
+func (k Keeper) SetCappedStake(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64, cappedStake types.CappedStake) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&cappedStake)
+	store.Set(types.GetCappedStakeKey(stakingCoinDenom, farmerAcc, planId), bz)
+}
+
+// DeleteCappedStake deletes a capped stake.
+func (k Keeper) DeleteCappedStake(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCappedStakeKey(stakingCoinDenom, farmerAcc, planId))
+}
+
+// IterateCappedStakes iterates through all capped stakes stored in the store
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateCappedStakes(ctx sdk.Context, cb func(stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64, cappedStake types.CappedStake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CappedStakeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var cappedStake types.CappedStake
+		k.cdc.MustUnmarshal(iter.Value(), &cappedStake)
+		stakingCoinDenom, farmerAcc, planId := types.ParseCappedStakeKey(iter.Key())
+		if cb(stakingCoinDenom, farmerAcc, planId, cappedStake) {
+			break
+		}
+	}
+}
+
+// IterateCappedStakesByFarmer iterates through all capped stakes of a farmer
+// for a staking coin denom and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateCappedStakesByFarmer(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, cb func(planId uint64, cappedStake types.CappedStake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCappedStakesByFarmerPrefix(stakingCoinDenom, farmerAcc))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var cappedStake types.CappedStake
+		k.cdc.MustUnmarshal(iter.Value(), &cappedStake)
+		_, _, planId := types.ParseCappedStakeKey(iter.Key())
+		if cb(planId, cappedStake) {
+			break
+		}
+	}
+}
+
+// IterateCappedStakesByDenom iterates through all capped stakes for a staking
+// coin denom stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateCappedStakesByDenom(ctx sdk.Context, stakingCoinDenom string, cb func(farmerAcc sdk.AccAddress, planId uint64, cappedStake types.CappedStake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCappedStakesByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var cappedStake types.CappedStake
+		k.cdc.MustUnmarshal(iter.Value(), &cappedStake)
+		_, farmerAcc, planId := types.ParseCappedStakeKey(iter.Key())
+		if cb(farmerAcc, planId, cappedStake) {
+			break
+		}
+	}
+}
+
+// cappedStakeRewards returns rewards accumulated until endingEpoch with
+// a capped stake for a plan.
+// The rewards are counted from the plan's cumulative unit rewards recorded
+// when the amount started being counted, so that the rewards streamed before
+// it within the same epoch are not counted.
+func (k Keeper) cappedStakeRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, cappedStake types.CappedStake, endingEpoch uint64) sdk.DecCoins {
+	rewards := sdk.NewDecCoins(cappedStake.PendingRewards...)
+	if cappedStake.Amount.IsPositive() {
+		ending := k.PlanCumulativeUnitRewards(ctx, stakingCoinDenom, planId, endingEpoch)
+		diff := ending.Sub(cappedStake.StartingCumulativeUnitRewards)
+		rewards = rewards.Add(diff.MulDecTruncate(cappedStake.Amount.ToDec())...)
+	}
+	return rewards
+}
+
+// cappedStakeAmount returns the portion of a farmer's reward weight counted
+// toward a plan with stake caps, given the currently counted amount and the
+// plan's capped total stakings.
+// The total cap is filled on a first-come basis: an increase is counted only
+// as long as there is room left under the cap, while a decrease is always
+// applied.
+// The room freed by a decrease is not redistributed to the farmers whose
+// stakes are only partially counted; it is taken by the next farmer whose
+// reward weight for the denom changes.
+func cappedStakeAmount(plan types.PlanI, weight, counted, total sdk.Int) sdk.Int {
+	desired := weight
+	if maxPerFarmer := plan.GetMaxStakePerFarmer(); maxPerFarmer.IsPositive() {
+		desired = sdk.MinInt(desired, maxPerFarmer)
+	}
+	if desired.LTE(counted) {
+		return desired
+	}
+	increase := desired.Sub(counted)
+	if maxTotal := plan.GetMaxTotalStake(); maxTotal.IsPositive() {
+		room := maxTotal.Sub(total)
+		if !room.IsPositive() {
+			return counted
+		}
+		increase = sdk.MinInt(increase, room)
+	}
+	return counted.Add(increase)
+}
+
+// setCappedStakeAmount updates the amount of a farmer's capped stake for
+// a plan along with the plan's capped total stakings.
+// The rewards accumulated with the previous amount are kept as pending
+// rewards, and the new amount is counted from now on.
+func (k Keeper) setCappedStakeAmount(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64, amount sdk.Int, total types.TotalStakings) {
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	cappedStake, found := k.GetCappedStake(ctx, stakingCoinDenom, farmerAcc, planId)
+	if !found {
+		cappedStake = types.CappedStake{
+			Amount:                        sdk.ZeroInt(),
+			StartingCumulativeUnitRewards: sdk.DecCoins{},
+			PendingRewards:                sdk.DecCoins{},
+		}
+	}
+	if amount.Equal(cappedStake.Amount) {
+		return
+	}
+
+	total.Amount = total.Amount.Add(amount).Sub(cappedStake.Amount)
+	k.SetCappedTotalStakings(ctx, stakingCoinDenom, planId, total)
+
+	cappedStake.PendingRewards = k.cappedStakeRewards(ctx, stakingCoinDenom, planId, cappedStake, currentEpoch)
+	cappedStake.Amount = amount
+	cappedStake.StartingCumulativeUnitRewards = k.PlanCumulativeUnitRewards(ctx, stakingCoinDenom, planId, currentEpoch)
+	if cappedStake.Amount.IsZero() && cappedStake.PendingRewards.IsZero() {
+		k.DeleteCappedStake(ctx, stakingCoinDenom, farmerAcc, planId)
+	} else {
+		k.SetCappedStake(ctx, stakingCoinDenom, farmerAcc, planId, cappedStake)
+	}
+}
+
+// updateCappedStakes updates the capped stakes of a farmer for a given
+// staking coin denom after the farmer's reward weight has changed.
+// Stakes are no longer counted toward plans that have been terminated.
+func (k Keeper) updateCappedStakes(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) {
+	type cappedPlan struct {
+		plan  types.PlanI
+		id    uint64
+		total types.TotalStakings
+	}
+	var plans []cappedPlan
+	k.IterateCappedTotalStakingsByDenom(ctx, stakingCoinDenom, func(planId uint64, total types.TotalStakings) (stop bool) {
+		plan, _ := k.GetPlan(ctx, planId)
+		plans = append(plans, cappedPlan{plan, planId, total})
+		return false
+	})
+	if len(plans) == 0 {
+		return
+	}
+
+	weight := k.RewardWeight(ctx, farmerAcc, stakingCoinDenom)
+	for _, p := range plans {
+		cappedStake, found := k.GetCappedStake(ctx, stakingCoinDenom, farmerAcc, p.id)
+		if !found {
+			cappedStake.Amount = sdk.ZeroInt()
+		}
+		amount := sdk.ZeroInt()
+		if p.plan != nil && !p.plan.IsTerminated() {
+			amount = cappedStakeAmount(p.plan, weight, cappedStake.Amount, p.total.Amount)
+		}
+		k.setCappedStakeAmount(ctx, stakingCoinDenom, farmerAcc, p.id, amount, p.total)
+	}
+}
+
+// initCappedStakes starts counting the existing stakes toward a plan with
+// stake caps, for the staking coin denoms of the plan which are not counted
+// yet.
+// It is called when a plan with stake caps is created or modified, and
+// created tells which one is the case.
+func (k Keeper) initCappedStakes(ctx sdk.Context, plan types.PlanI, created bool) {
+	if !types.HasStakeCaps(plan) {
+		return
+	}
+
+	for _, weight := range plan.GetStakingCoinWeights() {
+		if _, found := k.GetCappedTotalStakings(ctx, weight.Denom, plan.GetId()); found {
+			continue
+		}
+		// Sum up the reward weight of each farmer from the stakings and the
+		// active locks, so that each farmer's positions are read only once.
+		weights := map[string]sdk.Int{}
+		farmerAccs := map[string]sdk.AccAddress{}
+		addWeight := func(farmerAcc sdk.AccAddress, amt sdk.Int) {
+			farmer := farmerAcc.String()
+			if w, ok := weights[farmer]; ok {
+				weights[farmer] = w.Add(amt)
+			} else {
+				weights[farmer] = amt
+				farmerAccs[farmer] = farmerAcc
+			}
+		}
+		k.IterateStakingsByDenom(ctx, weight.Denom, func(farmerAcc sdk.AccAddress, staking types.Staking) (stop bool) {
+			addWeight(farmerAcc, staking.Amount)
+			return false
+		})
+		k.IterateLocksByDenom(ctx, weight.Denom, func(lock types.Lock) (stop bool) {
+			if !lock.IsQueued() {
+				addWeight(lock.GetFarmer(), lock.Weight)
+			}
+			return false
+		})
+
+		// Sort map keys for deterministic execution.
+		var farmers []string
+		for farmer := range weights {
+			farmers = append(farmers, farmer)
+		}
+		sort.Strings(farmers)
+
+		// Keep the rewards settled when the denom was removed from the plan
+		// before, if any. A newly created plan has no capped stakes yet.
+		settledRewards := map[string]sdk.DecCoins{}
+		if !created {
+			k.IterateCappedStakesByDenom(ctx, weight.Denom, func(farmerAcc sdk.AccAddress, planId uint64, cappedStake types.CappedStake) (stop bool) {
+				if planId == plan.GetId() {
+					settledRewards[farmerAcc.String()] = cappedStake.PendingRewards
+				}
+				return false
+			})
+		}
+
+		cumulative := k.PlanCumulativeUnitRewards(ctx, weight.Denom, plan.GetId(), k.GetCurrentEpoch(ctx, weight.Denom))
+		total := sdk.ZeroInt()
+		for _, farmer := range farmers {
+			amount := cappedStakeAmount(plan, weights[farmer], sdk.ZeroInt(), total)
+			if !amount.IsPositive() {
+				continue
+			}
+			pendingRewards, ok := settledRewards[farmer]
+			if !ok {
+				pendingRewards = sdk.DecCoins{}
+			}
+			k.SetCappedStake(ctx, weight.Denom, farmerAccs[farmer], plan.GetId(), types.CappedStake{
+				Amount:                        amount,
+				StartingCumulativeUnitRewards: cumulative,
+				PendingRewards:                pendingRewards,
+			})
+			total = total.Add(amount)
+		}
+		k.SetCappedTotalStakings(ctx, weight.Denom, plan.GetId(), types.TotalStakings{Amount: total})
+	}
+}
+
+// removeCappedStakes stops counting stakes toward a plan with stake caps for
+// a given staking coin denom, after the plan is terminated or the denom is
+// removed from the plan's staking coin weights.
+// The rewards accumulated with each capped stake are kept as its pending
+// rewards until they are withdrawn, and the capped total stakings and the
+// plan's historical rewards for the denom are deleted, since no more rewards
+// are allocated by the plan for the denom.
+func (k Keeper) removeCappedStakes(ctx sdk.Context, stakingCoinDenom string, planId uint64) {
+	if _, found := k.GetCappedTotalStakings(ctx, stakingCoinDenom, planId); !found {
+		return
+	}
+
+	type cappedStakeEntry struct {
+		farmerAcc   sdk.AccAddress
+		cappedStake types.CappedStake
+	}
+	var entries []cappedStakeEntry
+	k.IterateCappedStakesByDenom(ctx, stakingCoinDenom, func(farmerAcc sdk.AccAddress, id uint64, cappedStake types.CappedStake) (stop bool) {
+		if id == planId {
+			entries = append(entries, cappedStakeEntry{farmerAcc, cappedStake})
+		}
+		return false
+	})
+
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	for _, entry := range entries {
+		pendingRewards := k.cappedStakeRewards(ctx, stakingCoinDenom, planId, entry.cappedStake, currentEpoch)
+		if pendingRewards.IsZero() {
+			k.DeleteCappedStake(ctx, stakingCoinDenom, entry.farmerAcc, planId)
+			continue
+		}
+		k.SetCappedStake(ctx, stakingCoinDenom, entry.farmerAcc, planId, types.CappedStake{
+			Amount:                        sdk.ZeroInt(),
+			StartingCumulativeUnitRewards: sdk.DecCoins{},
+			PendingRewards:                pendingRewards,
+		})
+	}
+
+	k.DeleteCappedTotalStakings(ctx, stakingCoinDenom, planId)
+	k.DeletePlanHistoricalRewards(ctx, stakingCoinDenom, planId)
+}
+
+// removeCappedStakesOfRemovedDenoms removes the capped stakes of a modified
+// plan for the staking coin denoms which are no longer in the plan's staking
+// coin weights.
+func (k Keeper) removeCappedStakesOfRemovedDenoms(ctx sdk.Context, plan types.PlanI, oldStakingCoinWeights sdk.DecCoins) {
+	for _, weight := range oldStakingCoinWeights {
+		if plan.GetStakingCoinWeights().AmountOf(weight.Denom).IsZero() {
+			k.removeCappedStakes(ctx, weight.Denom, plan.GetId())
+		}
+	}
+}
+
+// resetCappedStakes clears the pending rewards of the capped stakes of
+// a farmer for a given staking coin denom and counts their amounts from now
+// on, after the rewards have been withdrawn.
+func (k Keeper) resetCappedStakes(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, currentEpoch uint64) {
+	type cappedStakeEntry struct {
+		planId      uint64
+		cappedStake types.CappedStake
+	}
+	var entries []cappedStakeEntry
+	k.IterateCappedStakesByFarmer(ctx, stakingCoinDenom, farmerAcc, func(planId uint64, cappedStake types.CappedStake) (stop bool) {
+		entries = append(entries, cappedStakeEntry{planId, cappedStake})
+		return false
+	})
+	for _, entry := range entries {
+		if entry.cappedStake.Amount.IsZero() {
+			k.DeleteCappedStake(ctx, stakingCoinDenom, farmerAcc, entry.planId)
+			continue
+		}
+		entry.cappedStake.PendingRewards = sdk.DecCoins{}
+		entry.cappedStake.StartingCumulativeUnitRewards = k.PlanCumulativeUnitRewards(ctx, stakingCoinDenom, entry.planId, currentEpoch)
+		k.SetCappedStake(ctx, stakingCoinDenom, farmerAcc, entry.planId, entry.cappedStake)
+	}
+}
+
+// ValidateCappedTotalStakings checks that the capped total stakings of each
+// plan equal the sum of the capped stakes counted toward the plan.
+func (k Keeper) ValidateCappedTotalStakings(ctx sdk.Context) error {
+	type denomPlan struct {
+		denom  string
+		planId uint64
+	}
+	sums := map[denomPlan]sdk.Int{}
+	k.IterateCappedStakes(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, planId uint64, cappedStake types.CappedStake) (stop bool) {
+		// A capped stake left only with its pending rewards is not counted.
+		if cappedStake.Amount.IsZero() {
+			return false
+		}
+		key := denomPlan{stakingCoinDenom, planId}
+		sum, ok := sums[key]
+		if !ok {
+			sum = sdk.ZeroInt()
+		}
+		sums[key] = sum.Add(cappedStake.Amount)
+		return false
+	})
+
+	var err error
+	k.IterateCappedTotalStakings(ctx, func(stakingCoinDenom string, planId uint64, totalStakings types.TotalStakings) (stop bool) {
+		key := denomPlan{stakingCoinDenom, planId}
+		sum, ok := sums[key]
+		if !ok {
+			sum = sdk.ZeroInt()
+		}
+		if !totalStakings.Amount.Equal(sum) {
+			err = types.ErrInvalidCappedTotalStakings
+			return true
+		}
+		delete(sums, key)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if len(sums) > 0 {
+		return types.ErrInvalidCappedTotalStakings
+	}
+	return nil
+}
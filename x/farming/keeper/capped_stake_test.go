@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (suite *KeeperTestSuite) createCappedPlan(farmingPoolAcc sdk.AccAddress, maxStakePerFarmer, maxTotalStake int64) types.PlanI {
+	msg := types.NewMsgCreateFixedAmountPlan(
+		"capped-plan",
+		farmingPoolAcc,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	)
+	msg.MaxStakePerFarmer = sdk.NewInt(maxStakePerFarmer)
+	msg.MaxTotalStake = sdk.NewInt(maxTotalStake)
+	plan, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, msg, farmingPoolAcc, farmingPoolAcc, types.PlanTypePublic)
+	suite.Require().NoError(err)
+	return plan
+}
+
+func (suite *KeeperTestSuite) cappedStakeAmount(farmerAcc sdk.AccAddress, planId uint64) sdk.Int {
+	cappedStake, found := suite.keeper.GetCappedStake(suite.ctx, denom1, farmerAcc, planId)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return cappedStake.Amount
+}
+
+func (suite *KeeperTestSuite) cappedTotalStakings(planId uint64) sdk.Int {
+	totalStakings, found := suite.keeper.GetCappedTotalStakings(suite.ctx, denom1, planId)
+	suite.Require().True(found)
+	return totalStakings.Amount
+}
+
+func (suite *KeeperTestSuite) TestStakeCapPerFarmer() {
+	plan := suite.createCappedPlan(suite.addrs[4], 1_000_000, 0)
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 9_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[0], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[1], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), suite.cappedTotalStakings(plan.GetId())))
+
+	suite.AdvanceEpoch()
+
+	// The capped plan's rewards are split evenly, while the other plan's
+	// rewards are split by the whole stakes.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_400_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), suite.AllRewards(suite.addrs[1])))
+
+	planRewards := suite.keeper.AllRewardsByPlan(suite.ctx, suite.addrs[0])
+	suite.Require().Len(planRewards, 2)
+	suite.Require().Equal(plan.GetId(), planRewards[0].PlanId)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500_000)), planRewards[0].Rewards))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 900_000)), planRewards[1].Rewards))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 1_400_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+
+	// The capped stake is still counted after harvesting.
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[0], plan.GetId())))
+}
+
+func (suite *KeeperTestSuite) TestStakeCapTotal() {
+	plan := suite.createCappedPlan(suite.addrs[4], 0, 1_500_000)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	// The total cap is filled on a first-come basis.
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[0], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(500_000), suite.cappedStakeAmount(suite.addrs[1], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), suite.cappedTotalStakings(plan.GetId())))
+
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_666_666)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333_333)), suite.AllRewards(suite.addrs[1])))
+
+	// Unstaking decreases the capped stake and the capped total stakings.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	_, found := suite.keeper.GetCappedStake(suite.ctx, denom1, suite.addrs[0], plan.GetId())
+	suite.Require().False(found)
+	suite.Require().True(intEq(sdk.NewInt(500_000), suite.cappedTotalStakings(plan.GetId())))
+
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_333_333)), suite.AllRewards(suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestStakeCapsWithExistingStakings() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 5_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	// The existing stakings are counted from the plan's creation.
+	plan := suite.createCappedPlan(suite.addrs[4], 2_000_000, 0)
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), suite.cappedStakeAmount(suite.addrs[0], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[1], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(3_000_000), suite.cappedTotalStakings(plan.GetId())))
+
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333_333)), suite.AllRewards(suite.addrs[1])))
+
+	// Rewards accumulated with the previous amount are kept when the amount
+	// changes without withdrawing rewards, like when a lock is activated.
+	suite.StakeWithLock(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 800_000)), 30*24*time.Hour)
+	suite.AdvanceEpoch()
+
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), suite.cappedStakeAmount(suite.addrs[1], plan.GetId())))
+	suite.Require().True(intEq(sdk.NewInt(4_000_000), suite.cappedTotalStakings(plan.GetId())))
+
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_833_333)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_166_666)), suite.AllRewards(suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestStakeCapsTerminatedPlan() {
+	plan := suite.createCappedPlan(suite.addrs[4], 1_000_000, 0)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+
+	// Stakes are no longer counted toward the terminated plan, and only the
+	// rewards accumulated so far are kept.
+	_, found := suite.keeper.GetCappedTotalStakings(suite.ctx, denom1, plan.GetId())
+	suite.Require().False(found)
+	cappedStake, found := suite.keeper.GetCappedStake(suite.ctx, denom1, suite.addrs[0], plan.GetId())
+	suite.Require().True(found)
+	suite.Require().True(cappedStake.Amount.IsZero())
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1_000_000)), cappedStake.PendingRewards))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The rewards from it can still be withdrawn, after which the capped
+	// stake is deleted.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	_, found = suite.keeper.GetCappedStake(suite.ctx, denom1, suite.addrs[0], plan.GetId())
+	suite.Require().False(found)
+	suite.Require().NoError(suite.keeper.ValidateCappedTotalStakings(suite.ctx))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3).Amount.Equal(
+		initialBalances.AmountOf(denom3).AddRaw(1_000_000)))
+}
+
+func (suite *KeeperTestSuite) TestStakeCapsRemovedDenom() {
+	plan := suite.createCappedPlan(suite.addrs[4], 1_000_000, 0)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom2, 2_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	modifyWeights := func(weightsStr string) {
+		req := testModifyPlanRequest(plan.GetId(), "", "", "", weightsStr, "", "", "", "")
+		suite.handleProposal(types.NewPublicPlanProposal("title", "description", nil, []types.ModifyPlanRequest{req}, nil))
+	}
+
+	// Stakes of the removed denom are no longer counted, and only the rewards
+	// accumulated so far are kept.
+	modifyWeights("1denom2")
+	_, found := suite.keeper.GetCappedTotalStakings(suite.ctx, denom1, plan.GetId())
+	suite.Require().False(found)
+	suite.Require().True(suite.cappedStakeAmount(suite.addrs[0], plan.GetId()).IsZero())
+	suite.Require().NoError(suite.keeper.ValidateCappedTotalStakings(suite.ctx))
+
+	totalStakings, found := suite.keeper.GetCappedTotalStakings(suite.ctx, denom2, plan.GetId())
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), totalStakings.Amount))
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[1])))
+
+	// Adding the denom back starts counting the stakes again, keeping the
+	// rewards accumulated before.
+	modifyWeights("1denom1")
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), suite.cappedStakeAmount(suite.addrs[0], plan.GetId())))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), suite.AllRewards(suite.addrs[0])))
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), balanceAfter.Amount.Sub(balanceBefore.Amount)))
+	suite.Require().NoError(suite.keeper.ValidateCappedTotalStakings(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCappedStakesGenesis() {
+	suite.createCappedPlan(suite.addrs[4], 1_000_000, 1_500_000)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.CappedStakeRecords, 2)
+	suite.Require().Len(genState.CappedTotalStakingsRecords, 1)
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), genState.CappedTotalStakingsRecords[0].Amount))
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
@@ -39,39 +39,108 @@ func (k Keeper) SetLastEpochTime(ctx sdk.Context, t time.Time) {
 	store.Set(types.LastEpochTimeKey, bz)
 }
 
+// GetLastStreamingTime returns the last time rewards were streamed.
+func (k Keeper) GetLastStreamingTime(ctx sdk.Context) (t time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastStreamingTimeKey)
+	if bz == nil {
+		return
+	}
+	var ts gogotypes.Timestamp
+	k.cdc.MustUnmarshal(bz, &ts)
+	var err error
+	t, err = gogotypes.TimestampFromProto(&ts)
+	if err != nil {
+		panic(err)
+	}
+	found = true
+	return
+}
+
+// SetLastStreamingTime sets the last time rewards were streamed.
+func (k Keeper) SetLastStreamingTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	ts, err := gogotypes.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	bz := k.cdc.MustMarshal(ts)
+	store.Set(types.LastStreamingTimeKey, bz)
+}
+
 // AdvanceEpoch ends the current epoch. When an epoch ends, rewards
-// are distributed and queued staking coins become staked.
+// are distributed, rewards of the farmers who enabled auto-compounding are
+// staked again and queued staking coins become staked.
 func (k Keeper) AdvanceEpoch(ctx sdk.Context) error {
-	if err := k.AllocateRewards(ctx); err != nil {
-		return err
+	return k.AdvanceEpochTo(ctx, ctx.BlockTime())
+}
+
+// AdvanceEpochTo ends the current epoch like AdvanceEpoch, but records
+// the epoch end time as the last epoch time instead of the block time.
+// It is used to keep the epochs on schedule while catching up with the
+// epochs missed while the chain was halted.
+func (k Keeper) AdvanceEpochTo(ctx sdk.Context, epochEndTime time.Time) error {
+	// While rewards allocation is paused, the epochs still advance without
+	// allocating rewards for them.
+	if !k.IsFunctionPaused(ctx, types.PausableFunctionRewardAllocation) {
+		if err := k.AllocateRewards(ctx, epochEndTime); err != nil {
+			return err
+		}
+	} else {
+		// Rewards may have been streamed before the pause.
+		k.advanceCurrentEpochs(ctx)
+	}
+	// The decay schedules advance every epoch, even while rewards allocation
+	// is paused.
+	k.advanceDecayingPlans(ctx, epochEndTime)
+	// Auto-compounding harvests rewards and stakes them again.
+	if !k.IsFunctionPaused(ctx, types.PausableFunctionHarvest) && !k.IsFunctionPaused(ctx, types.PausableFunctionStake) {
+		k.ProcessAutoCompounds(ctx)
 	}
 	k.ProcessQueuedCoins(ctx)
-	k.SetLastEpochTime(ctx, ctx.BlockTime())
+	k.ProcessQueuedLocks(ctx)
+	k.SetLastEpochTime(ctx, epochEndTime)
+
+	// Rewards streamed after the epoch end time have been streamed for
+	// the ended epoch, so the new epoch starts with nothing streamed.
+	if lastStreamingTime, found := k.GetLastStreamingTime(ctx); found && lastStreamingTime.After(epochEndTime) {
+		k.SetLastStreamingTime(ctx, epochEndTime)
+	}
 
 	return nil
 }
 
-// GetCurrentEpochDays returns the current epoch days(period).
-func (k Keeper) GetCurrentEpochDays(ctx sdk.Context) uint32 {
-	var epochDays uint32
+// GetCurrentEpochDuration returns the current epoch duration(period).
+func (k Keeper) GetCurrentEpochDuration(ctx sdk.Context) time.Duration {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.CurrentEpochDaysKey)
+	bz := store.Get(types.CurrentEpochDurationKey)
 	if bz == nil {
-		// initialize with next epoch days
-		epochDays = k.GetParams(ctx).NextEpochDays
-	} else {
-		val := gogotypes.UInt32Value{}
-		if err := k.cdc.Unmarshal(bz, &val); err != nil {
-			panic(err)
-		}
-		epochDays = val.GetValue()
+		// initialize with next epoch duration
+		return k.GetParams(ctx).NextEpochDuration
+	}
+	var val gogotypes.Duration
+	k.cdc.MustUnmarshal(bz, &val)
+	d, err := gogotypes.DurationFromProto(&val)
+	if err != nil {
+		panic(err)
 	}
-	return epochDays
+	return d
 }
 
-// SetCurrentEpochDays sets the current epoch days(period).
-func (k Keeper) SetCurrentEpochDays(ctx sdk.Context, epochDays uint32) {
+// SetCurrentEpochDuration sets the current epoch duration(period).
+func (k Keeper) SetCurrentEpochDuration(ctx sdk.Context, epochDuration time.Duration) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: epochDays})
-	store.Set(types.CurrentEpochDaysKey, bz)
+	bz := k.cdc.MustMarshal(gogotypes.DurationProto(epochDuration))
+	store.Set(types.CurrentEpochDurationKey, bz)
+}
+
+// NextEpochTime returns the scheduled end time of the current epoch.
+// The epoch actually ends in the first block whose time is not before it.
+// It returns false if the first epoch has not started yet.
+func (k Keeper) NextEpochTime(ctx sdk.Context) (t time.Time, found bool) {
+	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	if !found {
+		return
+	}
+	return types.NextEpochTime(lastEpochTime, k.GetCurrentEpochDuration(ctx)), true
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
 
@@ -22,13 +24,25 @@ func (suite *KeeperTestSuite) TestLastEpochTime() {
 	suite.Require().Equal(t, t2)
 }
 
+func (suite *KeeperTestSuite) TestLastStreamingTime() {
+	_, found := suite.keeper.GetLastStreamingTime(suite.ctx)
+	suite.Require().False(found)
+
+	t := types.ParseTime("2021-07-23T05:01:02Z")
+	suite.keeper.SetLastStreamingTime(suite.ctx, t)
+
+	t2, found := suite.keeper.GetLastStreamingTime(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(t, t2)
+}
+
 func (suite *KeeperTestSuite) TestFirstEpoch() {
 	// The first epoch may run very quickly depending on when
 	// the farming module was activated,
 	// meaning that (block time) - (last epoch time) may be smaller
-	// than the current epoch_days for the first epoch.
+	// than the current epoch duration for the first epoch.
 
-	suite.Require().Equal(uint32(1), suite.keeper.GetCurrentEpochDays(suite.ctx))
+	suite.Require().Equal(24*time.Hour, suite.keeper.GetCurrentEpochDuration(suite.ctx))
 
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-11T23:59:59Z"))
 	farming.EndBlocker(suite.ctx, suite.keeper)
@@ -41,13 +55,15 @@ func (suite *KeeperTestSuite) TestFirstEpoch() {
 	suite.Require().True(t.After(lastEpochTime)) // Indicating that the epoch ended.
 }
 
-func (suite *KeeperTestSuite) TestEpochDays() {
-	for _, nextEpochDays := range []uint32{1, 2, 3} {
-		suite.Run(fmt.Sprintf("next epoch days = %d", nextEpochDays), func() {
+func (suite *KeeperTestSuite) TestEpochDuration() {
+	for _, nextEpochDuration := range []time.Duration{
+		24 * time.Hour, 2 * 24 * time.Hour, 3 * 24 * time.Hour, time.Hour, 90 * time.Minute,
+	} {
+		suite.Run(fmt.Sprintf("next epoch duration = %s", nextEpochDuration), func() {
 			suite.SetupTest()
 
 			params := suite.keeper.GetParams(suite.ctx)
-			params.NextEpochDays = nextEpochDays
+			params.NextEpochDuration = nextEpochDuration
 			suite.keeper.SetParams(suite.ctx, params)
 
 			t := types.ParseTime("2021-08-11T00:00:00Z")
@@ -55,7 +71,7 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 			farming.EndBlocker(suite.ctx, suite.keeper)
 
 			lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
 
 			for i := 0; i < 10000; i++ {
 				t = t.Add(5 * time.Minute)
@@ -64,8 +80,9 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 
 				t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 				if t2.After(lastEpochTime) {
-					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime).Hours(), float64(currentEpochDays*24))
+					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime), currentEpochDuration)
 					lastEpochTime = t2
+					currentEpochDuration = suite.keeper.GetCurrentEpochDuration(suite.ctx)
 				}
 			}
 		})
@@ -80,18 +97,140 @@ func (suite *KeeperTestSuite) TestDelayedBlockTime() {
 	suite.ctx = suite.ctx.WithBlockTime(t)
 	farming.EndBlocker(suite.ctx, suite.keeper)
 
+	// All the missed epochs have been caught up, and the last epoch time
+	// is anchored to the epoch schedule.
+	lastEpochTime, found := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ParseTime("2021-10-03T00:00:00Z"), lastEpochTime)
+}
+
+func (suite *KeeperTestSuite) TestDelayedBlockTime_CatchUpDisabled() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxCatchUpEpochs = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2021-09-23T00:00:05Z"))
+
+	t := types.ParseTime("2021-10-03T00:00:04Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	// Only one epoch has been advanced, and the next epoch starts from
+	// the block time.
 	lastEpochTime, found := suite.keeper.GetLastEpochTime(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().Equal(t, lastEpochTime)
 }
 
-func (suite *KeeperTestSuite) TestCurrentEpochDays() {
-	currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(1), currentEpochDays)
+func (suite *KeeperTestSuite) TestCatchUpEpochs() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxCatchUpEpochs = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The chain halts for 7 epochs, and at most 3 epochs are caught up per block.
+	t := types.ParseTime("2021-08-08T01:00:00Z")
+	for _, tc := range []struct {
+		lastEpochTime time.Time
+		rewards       int64
+	}{
+		{types.ParseTime("2021-08-04T00:00:00Z"), 4_000_000},
+		{types.ParseTime("2021-08-07T00:00:00Z"), 7_000_000},
+		{types.ParseTime("2021-08-08T00:00:00Z"), 8_000_000},
+		{types.ParseTime("2021-08-08T00:00:00Z"), 8_000_000},
+	} {
+		t = t.Add(5 * time.Second)
+		suite.ctx = suite.ctx.WithBlockTime(t)
+		farming.EndBlocker(suite.ctx, suite.keeper)
+
+		t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+		suite.Require().Equal(tc.lastEpochTime, t2)
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, tc.rewards)), suite.AllRewards(suite.addrs[0])))
+	}
+}
+
+func (suite *KeeperTestSuite) TestCatchUpEpochs_PlanEndedWhileHalted() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxCatchUpEpochs = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	msg := types.NewMsgCreateFixedAmountPlan(
+		"plan1", suite.addrs[4], sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("2021-08-05T12:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	)
+	_, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, msg, suite.addrs[4], suite.addrs[4], types.PlanTypePublic)
+	suite.Require().NoError(err)
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The chain halts for 7 epochs, during which the plan ends.
+	// The plan still allocates rewards for the epochs that ended before
+	// its end time, and it is terminated only after they are caught up.
+	t := types.ParseTime("2021-08-08T01:00:00Z")
+	for _, tc := range []struct {
+		lastEpochTime time.Time
+		rewards       int64
+		found         bool
+	}{
+		{types.ParseTime("2021-08-04T00:00:00Z"), 4_000_000, true},
+		{types.ParseTime("2021-08-07T00:00:00Z"), 5_000_000, false},
+		{types.ParseTime("2021-08-08T00:00:00Z"), 5_000_000, false},
+	} {
+		t = t.Add(5 * time.Second)
+		suite.ctx = suite.ctx.WithBlockTime(t)
+		farming.EndBlocker(suite.ctx, suite.keeper)
+
+		t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+		suite.Require().Equal(tc.lastEpochTime, t2)
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, tc.rewards)), suite.AllRewards(suite.addrs[0])))
+		// The public plan is deleted when terminated.
+		_, found := suite.keeper.GetPlan(suite.ctx, 1)
+		suite.Require().Equal(tc.found, found)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCurrentEpochDuration() {
+	currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(24*time.Hour, currentEpochDuration)
+
+	nextEpochDuration := 3 * time.Hour
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, nextEpochDuration)
+
+	currentEpochDuration = suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(3*time.Hour, currentEpochDuration)
+}
+
+func (suite *KeeperTestSuite) TestCatchUpEpochs_RewardsStreaming() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
 
-	nextEpochDays := uint32(3)
-	suite.keeper.SetCurrentEpochDays(suite.ctx, nextEpochDays)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The chain halts for 7 epochs, and all of them are caught up in a block.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T01:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
 
-	currentEpochDays = suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(3), currentEpochDays)
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-08T00:00:00Z"), lastEpochTime)
+	// The rewards for the missed epochs and an hour of the current epoch.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 8_041_666)), suite.AllRewards(suite.addrs[0])))
 }
@@ -18,7 +18,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	ctx, writeCache := ctx.CacheContext()
 
 	k.SetParams(ctx, genState.Params)
-	k.SetCurrentEpochDays(ctx, genState.CurrentEpochDays)
+	k.SetCurrentEpochDuration(ctx, genState.CurrentEpochDuration)
 	if addr := k.accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
@@ -47,6 +47,52 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		totalStakings[record.StakingCoinDenom] = amt
 	}
 
+	k.SetGlobalLockId(ctx, genState.GlobalLockId)
+
+	for _, lock := range genState.Locks {
+		k.SetLock(ctx, lock)
+
+		// Only the weights of active locks are counted in total stakings.
+		if lock.IsQueued() {
+			continue
+		}
+		amt, ok := totalStakings[lock.StakingCoinDenom]
+		if !ok {
+			amt = sdk.ZeroInt()
+		}
+		amt = amt.Add(lock.Weight)
+		totalStakings[lock.StakingCoinDenom] = amt
+	}
+
+	k.SetGlobalUnbondingId(ctx, genState.GlobalUnbondingId)
+
+	for _, unbonding := range genState.Unbondings {
+		k.SetUnbonding(ctx, unbonding)
+	}
+
+	k.SetGlobalRewardVestingId(ctx, genState.GlobalRewardVestingId)
+
+	for _, vesting := range genState.RewardVestings {
+		k.SetRewardVesting(ctx, vesting)
+	}
+
+	for _, farmer := range genState.AutoCompoundFarmers {
+		farmerAcc, _ := sdk.AccAddressFromBech32(farmer) // Already validated
+		k.SetAutoCompound(ctx, farmerAcc, true)
+	}
+
+	for _, function := range genState.PausedFunctions {
+		k.SetFunctionPaused(ctx, function, true)
+	}
+
+	for _, record := range genState.RewardsWithdrawAddressRecords {
+		farmerAcc, _ := sdk.AccAddressFromBech32(record.Farmer)            // Already validated
+		withdrawAcc, _ := sdk.AccAddressFromBech32(record.WithdrawAddress) // Already validated
+		if err := k.SetRewardsWithdrawAddress(ctx, farmerAcc, withdrawAcc); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, record := range genState.TotalStakingsRecords {
 		if !record.Amount.Equal(totalStakings[record.StakingCoinDenom]) {
 			panic(fmt.Sprintf("TotalStaking for %s differs from the actual value; have %s, want %s",
@@ -84,14 +130,49 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetOutstandingRewards(ctx, record.StakingCoinDenom, record.OutstandingRewards)
 	}
 
+	for _, record := range genState.PlanHistoricalRewardsRecords {
+		k.SetPlanHistoricalRewards(ctx, record.StakingCoinDenom, record.PlanId, record.Epoch, record.HistoricalRewards)
+	}
+
+	for _, record := range genState.PlanOutstandingRewardsRecords {
+		k.SetPlanOutstandingRewards(ctx, record.StakingCoinDenom, record.PlanId, record.OutstandingRewards)
+	}
+
 	for _, record := range genState.CurrentEpochRecords {
 		k.SetCurrentEpoch(ctx, record.StakingCoinDenom, record.CurrentEpoch)
 	}
 
+	for _, record := range genState.CappedTotalStakingsRecords {
+		k.SetCappedTotalStakings(ctx, record.StakingCoinDenom, record.PlanId, types.TotalStakings{Amount: record.Amount})
+	}
+
+	for _, record := range genState.CappedStakeRecords {
+		farmerAcc, _ := sdk.AccAddressFromBech32(record.Farmer) // Already validated
+		k.SetCappedStake(ctx, record.StakingCoinDenom, farmerAcc, record.PlanId, record.CappedStake)
+	}
+
+	for _, record := range genState.StartingRewardsRecords {
+		farmerAcc, _ := sdk.AccAddressFromBech32(record.Farmer) // Already validated
+		k.SetStartingRewards(ctx, record.StakingCoinDenom, farmerAcc, record.StartingRewards)
+	}
+
+	for _, record := range genState.PlanVestingDurationRecords {
+		k.SetPlanVestingDuration(ctx, record.PlanId, record.VestingDuration)
+	}
+
+	for _, record := range genState.PendingRewardsRecords {
+		farmerAcc, _ := sdk.AccAddressFromBech32(record.Farmer) // Already validated
+		k.SetPendingRewards(ctx, farmerAcc, record.PendingRewards)
+	}
+
 	if genState.LastEpochTime != nil {
 		k.SetLastEpochTime(ctx, *genState.LastEpochTime)
 	}
 
+	if genState.LastStreamingTime != nil {
+		k.SetLastStreamingTime(ctx, *genState.LastStreamingTime)
+	}
+
 	err := k.ValidateRemainingRewardsAmount(ctx)
 	if err != nil {
 		panic(err)
@@ -111,6 +192,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.ValidateVestingReservedAmount(ctx); err != nil {
+		panic(err)
+	}
+
+	if err := k.ValidateCappedTotalStakings(ctx); err != nil {
+		panic(err)
+	}
+
 	writeCache()
 }
 
@@ -179,6 +268,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	planHistoricalRewards := []types.PlanHistoricalRewardsRecord{}
+	k.IteratePlanHistoricalRewards(ctx, func(stakingCoinDenom string, planId uint64, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+		planHistoricalRewards = append(planHistoricalRewards, types.PlanHistoricalRewardsRecord{
+			PlanId:            planId,
+			StakingCoinDenom:  stakingCoinDenom,
+			Epoch:             epoch,
+			HistoricalRewards: rewards,
+		})
+		return false
+	})
+
+	planOutstandingRewards := []types.PlanOutstandingRewardsRecord{}
+	k.IteratePlanOutstandingRewards(ctx, func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool) {
+		planOutstandingRewards = append(planOutstandingRewards, types.PlanOutstandingRewardsRecord{
+			PlanId:             planId,
+			StakingCoinDenom:   stakingCoinDenom,
+			OutstandingRewards: rewards,
+		})
+		return false
+	})
+
 	currentEpochs := []types.CurrentEpochRecord{}
 	k.IterateCurrentEpochs(ctx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
 		currentEpochs = append(currentEpochs, types.CurrentEpochRecord{
@@ -188,12 +298,100 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	locks := []types.Lock{}
+	k.IterateLocks(ctx, func(lock types.Lock) (stop bool) {
+		locks = append(locks, lock)
+		return false
+	})
+
+	unbondings := []types.Unbonding{}
+	k.IterateUnbondings(ctx, func(unbonding types.Unbonding) (stop bool) {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+
+	rewardVestings := []types.RewardVesting{}
+	k.IterateRewardVestings(ctx, func(vesting types.RewardVesting) (stop bool) {
+		rewardVestings = append(rewardVestings, vesting)
+		return false
+	})
+
+	cappedStakes := []types.CappedStakeRecord{}
+	k.IterateCappedStakes(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64, cappedStake types.CappedStake) (stop bool) {
+		cappedStakes = append(cappedStakes, types.CappedStakeRecord{
+			PlanId:           planId,
+			StakingCoinDenom: stakingCoinDenom,
+			Farmer:           farmerAcc.String(),
+			CappedStake:      cappedStake,
+		})
+		return false
+	})
+
+	cappedTotalStakings := []types.CappedTotalStakingsRecord{}
+	k.IterateCappedTotalStakings(ctx, func(stakingCoinDenom string, planId uint64, totalStakings types.TotalStakings) (stop bool) {
+		cappedTotalStakings = append(cappedTotalStakings, types.CappedTotalStakingsRecord{
+			PlanId:           planId,
+			StakingCoinDenom: stakingCoinDenom,
+			Amount:           totalStakings.Amount,
+		})
+		return false
+	})
+
+	startingRewards := []types.StartingRewardsRecord{}
+	k.IterateStartingRewards(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, rewards types.StartingRewards) (stop bool) {
+		startingRewards = append(startingRewards, types.StartingRewardsRecord{
+			StakingCoinDenom: stakingCoinDenom,
+			Farmer:           farmerAcc.String(),
+			StartingRewards:  rewards,
+		})
+		return false
+	})
+
+	pendingRewards := []types.PendingRewardsRecord{}
+	k.IteratePendingRewards(ctx, func(farmerAcc sdk.AccAddress, rewards types.PendingRewards) (stop bool) {
+		pendingRewards = append(pendingRewards, types.PendingRewardsRecord{
+			Farmer:         farmerAcc.String(),
+			PendingRewards: rewards,
+		})
+		return false
+	})
+
+	planVestingDurations := []types.PlanVestingDurationRecord{}
+	k.IteratePlanVestingDurations(ctx, func(planId uint64, vestingDuration time.Duration) (stop bool) {
+		planVestingDurations = append(planVestingDurations, types.PlanVestingDurationRecord{
+			PlanId:          planId,
+			VestingDuration: vestingDuration,
+		})
+		return false
+	})
+
+	autoCompoundFarmers := []string{}
+	k.IterateAutoCompoundFarmers(ctx, func(farmerAcc sdk.AccAddress) (stop bool) {
+		autoCompoundFarmers = append(autoCompoundFarmers, farmerAcc.String())
+		return false
+	})
+
+	rewardsWithdrawAddresses := []types.RewardsWithdrawAddressRecord{}
+	k.IterateRewardsWithdrawAddresses(ctx, func(farmerAcc, withdrawAcc sdk.AccAddress) (stop bool) {
+		rewardsWithdrawAddresses = append(rewardsWithdrawAddresses, types.RewardsWithdrawAddressRecord{
+			Farmer:          farmerAcc.String(),
+			WithdrawAddress: withdrawAcc.String(),
+		})
+		return false
+	})
+
 	var epochTime *time.Time
 	tempEpochTime, found := k.GetLastEpochTime(ctx)
 	if found {
 		epochTime = &tempEpochTime
 	}
 
+	var streamingTime *time.Time
+	tempStreamingTime, found := k.GetLastStreamingTime(ctx)
+	if found {
+		streamingTime = &tempStreamingTime
+	}
+
 	return types.NewGenesisState(
 		params,
 		k.GetGlobalPlanId(ctx),
@@ -203,9 +401,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		totalStakings,
 		historicalRewards,
 		outstandingRewards,
+		planHistoricalRewards,
+		planOutstandingRewards,
 		currentEpochs,
 		k.bankKeeper.GetAllBalances(ctx, types.RewardsReserveAcc),
 		epochTime,
-		k.GetCurrentEpochDays(ctx),
+		k.GetCurrentEpochDuration(ctx),
+		k.GetGlobalLockId(ctx),
+		locks,
+		autoCompoundFarmers,
+		rewardsWithdrawAddresses,
+		streamingTime,
+		k.GetPausedFunctions(ctx),
+		k.GetGlobalUnbondingId(ctx),
+		unbondings,
+		k.GetGlobalRewardVestingId(ctx),
+		rewardVestings,
+		cappedStakes,
+		cappedTotalStakings,
+		startingRewards,
+		pendingRewards,
+		planVestingDurations,
 	)
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -102,6 +103,154 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(1, suite.keeper.GetNumActivePrivatePlans(suite.ctx))
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisWithLocks() {
+	suite.setLockMultipliers()
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.StakeWithLock(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 30*24*time.Hour)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.StakeWithLock(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 7*24*time.Hour)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Equal(uint64(2), genState.GlobalLockId)
+	suite.Require().Len(genState.Locks, 2)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	// The weight of an active lock must be counted in total stakings.
+	genState.TotalStakingsRecords[0].Amount = sdk.NewInt(1_000_000)
+	suite.Require().Panics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithAutoCompound() {
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], true)
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[1], true)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.AutoCompoundFarmers, 2)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], false)
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[1], false)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[0]))
+	suite.Require().True(suite.keeper.GetAutoCompound(suite.ctx, suite.addrs[1]))
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithRewardsWithdrawAddresses() {
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.RewardsWithdrawAddressRecords, 1)
+
+	err = types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[0])
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(suite.addrs[5], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithUnbondings() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnstakingPeriod = 7 * 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400_000)))
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.Unbondings, 1)
+	suite.Require().Equal(uint64(1), genState.GlobalUnbondingId)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithPausedFunctions() {
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionStake, true)
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionRewardAllocation, true)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.PausedFunctions, 2)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionStake, false)
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionRewardAllocation, false)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().True(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionStake))
+	suite.Require().True(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionRewardAllocation))
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisWithPendingRewards() {
+	suite.pauseHarvest(suite.addrs[0])
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.PendingRewardsRecords, 1)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
 
@@ -173,9 +322,9 @@ func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 			true,
 		},
 		{
-			"invalid current epoch days",
+			"invalid current epoch duration",
 			func(genState *types.GenesisState) {
-				genState.CurrentEpochDays = 0
+				genState.CurrentEpochDuration = 0
 			},
 			true,
 		},
@@ -366,6 +515,31 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 				}
 			},
 		},
+		{
+			"PlanHistoricalRewards",
+			func() {
+				// The first plan has allocated rewards for both denom1 and denom2,
+				// and the second plan has allocated rewards only for denom1.
+				suite.Require().Len(genState.PlanHistoricalRewardsRecords, 3)
+				for _, record := range genState.PlanHistoricalRewardsRecords {
+					suite.Require().Contains([]string{denom1, denom2}, record.StakingCoinDenom)
+					suite.Require().Equal(uint64(1), record.Epoch)
+					suite.Require().False(record.HistoricalRewards.CumulativeUnitRewards.IsZero())
+				}
+			},
+		},
+		{
+			"PlanOutstandingRewards",
+			func() {
+				suite.Require().Len(genState.PlanOutstandingRewardsRecords, 3)
+				totalRewards := map[string]sdk.DecCoins{} // (staking coin denom) => (rewards)
+				for _, record := range genState.PlanOutstandingRewardsRecords {
+					totalRewards[record.StakingCoinDenom] = totalRewards[record.StakingCoinDenom].Add(record.OutstandingRewards.Rewards...)
+				}
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 2300000)), totalRewards[denom1]))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 700000)), totalRewards[denom2]))
+			},
+		},
 		{
 			"CurrentEpochRecords",
 			func() {
@@ -391,9 +565,16 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 			},
 		},
 		{
-			"CurrentEpochDays",
+			"CurrentEpochDuration",
+			func() {
+				suite.Require().Equal(24*time.Hour, genState.CurrentEpochDuration)
+			},
+		},
+		{
+			"LastStreamingTime",
 			func() {
-				suite.Require().Equal(uint32(1), genState.CurrentEpochDays)
+				// Rewards streaming is disabled by default.
+				suite.Require().Nil(genState.LastStreamingTime)
 			},
 		},
 	} {
@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -40,8 +44,11 @@ func (k Querier) Plans(c context.Context, req *types.QueryPlansRequest) (*types.
 		return nil, status.Errorf(codes.InvalidArgument, "invalid plan type %s", req.Type)
 	}
 
+	var farmingPoolAcc sdk.AccAddress
 	if req.FarmingPoolAddress != "" {
-		if _, err := sdk.AccAddressFromBech32(req.FarmingPoolAddress); err != nil {
+		var err error
+		farmingPoolAcc, err = sdk.AccAddressFromBech32(req.FarmingPoolAddress)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -69,13 +76,40 @@ func (k Querier) Plans(c context.Context, req *types.QueryPlansRequest) (*types.
 
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
-	planStore := prefix.NewStore(store, types.PlanKeyPrefix)
+
+	// Use a plan index if possible, so that only the plans matching the
+	// filter are visited.
+	var planStore prefix.Store
+	indexed := true
+	switch {
+	case req.FarmingPoolAddress != "":
+		planStore = prefix.NewStore(store, types.GetPlansByFarmingPoolPrefix(farmingPoolAcc))
+	case req.StakingCoinDenom != "":
+		planStore = prefix.NewStore(store, types.GetPlansByStakingCoinDenomPrefix(req.StakingCoinDenom))
+	case req.Terminated != "" && terminated:
+		planStore = prefix.NewStore(store, types.TerminatedPlanIndexKeyPrefix)
+	case req.Terminated != "":
+		planStore = prefix.NewStore(store, types.ActivePlanIndexKeyPrefix)
+	default:
+		planStore = prefix.NewStore(store, types.PlanKeyPrefix)
+		indexed = false
+	}
 
 	var plans []*codectypes.Any
 	pageRes, err := query.FilteredPaginate(planStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
-		plan, err := k.Keeper.UnmarshalPlan(value)
-		if err != nil {
-			return false, err
+		var plan types.PlanI
+		if indexed {
+			var found bool
+			plan, found = k.Keeper.GetPlan(ctx, sdk.BigEndianToUint64(key))
+			if !found {
+				return false, fmt.Errorf("plan %d not found", sdk.BigEndianToUint64(key))
+			}
+		} else {
+			var err error
+			plan, err = k.Keeper.UnmarshalPlan(value)
+			if err != nil {
+				return false, err
+			}
 		}
 		planAny, err := types.PackPlan(plan)
 		if err != nil {
@@ -170,8 +204,24 @@ func (k Querier) Stakings(c context.Context, req *types.QueryStakingsRequest) (*
 		QueuedCoins: sdk.NewCoins(),
 	}
 	if req.StakingCoinDenom == "" {
-		resp.StakedCoins = k.Keeper.GetAllStakedCoinsByFarmer(ctx, farmerAcc)
-		resp.QueuedCoins = k.Keeper.GetAllQueuedCoinsByFarmer(ctx, farmerAcc)
+		store := ctx.KVStore(k.storeKey)
+		stakingStore := prefix.NewStore(store, types.GetStakingsByFarmerPrefix(farmerAcc))
+		queuedStakingStore := prefix.NewStore(store, types.GetQueuedStakingByFarmerPrefix(farmerAcc))
+
+		pageRes, err := paginateKeys(req.Pagination, mergedStoresIterator(stakingStore, queuedStakingStore), func(key []byte) error {
+			stakingCoinDenom := string(key)
+			if staking, found := k.Keeper.GetStaking(ctx, stakingCoinDenom, farmerAcc); found {
+				resp.StakedCoins = resp.StakedCoins.Add(sdk.NewCoin(stakingCoinDenom, staking.Amount))
+			}
+			if queuedStaking, found := k.Keeper.GetQueuedStaking(ctx, stakingCoinDenom, farmerAcc); found {
+				resp.QueuedCoins = resp.QueuedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Pagination = pageRes
 	} else {
 		staking, found := k.Keeper.GetStaking(ctx, req.StakingCoinDenom, farmerAcc)
 		if found {
@@ -186,6 +236,219 @@ func (k Querier) Stakings(c context.Context, req *types.QueryStakingsRequest) (*
 	return resp, nil
 }
 
+// StakingsByDenom queries the staked and queued amounts of all farmers
+// staking a staking coin denom.
+func (k Querier) StakingsByDenom(c context.Context, req *types.QueryStakingsByDenomRequest) (*types.QueryStakingsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	stakingStore := prefix.NewStore(store, types.GetStakingsByStakingCoinDenomPrefix(req.StakingCoinDenom))
+	queuedStakingStore := prefix.NewStore(store, types.GetQueuedStakingsByStakingCoinDenomPrefix(req.StakingCoinDenom))
+
+	stakings := []types.FarmerStaking{}
+	pageRes, err := paginateKeys(req.Pagination, mergedStoresIterator(stakingStore, queuedStakingStore), func(key []byte) error {
+		farmerAcc := sdk.AccAddress(key)
+		farmerStaking := types.FarmerStaking{
+			Farmer:       farmerAcc.String(),
+			StakedAmount: sdk.ZeroInt(),
+			QueuedAmount: sdk.ZeroInt(),
+		}
+		if staking, found := k.Keeper.GetStaking(ctx, req.StakingCoinDenom, farmerAcc); found {
+			farmerStaking.StakedAmount = staking.Amount
+		}
+		if queuedStaking, found := k.Keeper.GetQueuedStaking(ctx, req.StakingCoinDenom, farmerAcc); found {
+			farmerStaking.QueuedAmount = queuedStaking.Amount
+		}
+		stakings = append(stakings, farmerStaking)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStakingsByDenomResponse{Stakings: stakings, Pagination: pageRes}, nil
+}
+
+// QueuedStakings queries queued stakings of a farmer and the time they are
+// expected to become staked.
+func (k Querier) QueuedStakings(c context.Context, req *types.QueryQueuedStakingsRequest) (*types.QueryQueuedStakingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryQueuedStakingsResponse{
+		QueuedCoins: sdk.NewCoins(),
+	}
+	if req.StakingCoinDenom == "" {
+		resp.QueuedCoins = k.Keeper.GetAllQueuedCoinsByFarmer(ctx, farmerAcc)
+	} else {
+		queuedStaking, found := k.Keeper.GetQueuedStaking(ctx, req.StakingCoinDenom, farmerAcc)
+		if found {
+			resp.QueuedCoins = resp.QueuedCoins.Add(sdk.NewCoin(req.StakingCoinDenom, queuedStaking.Amount))
+		}
+	}
+	if nextEpochTime, found := k.Keeper.NextEpochTime(ctx); found {
+		resp.NextEpochTime = &nextEpochTime
+	}
+
+	return resp, nil
+}
+
+// Locks queries all locks of a farmer.
+func (k Querier) Locks(c context.Context, req *types.QueryLocksRequest) (*types.QueryLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var lockStore prefix.Store
+	if req.StakingCoinDenom == "" {
+		lockStore = prefix.NewStore(store, types.GetLocksByFarmerPrefix(farmerAcc))
+	} else {
+		lockStore = prefix.NewStore(store, types.GetLocksByFarmerAndDenomPrefix(farmerAcc, req.StakingCoinDenom))
+	}
+
+	locks := []types.Lock{}
+	pageRes, err := query.Paginate(lockStore, req.Pagination, func(key, _ []byte) error {
+		lockId := sdk.BigEndianToUint64(key[len(key)-8:])
+		lock, _ := k.Keeper.GetLock(ctx, lockId)
+		locks = append(locks, lock)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// Unbondings queries all unbondings by a farmer.
+func (k Querier) Unbondings(c context.Context, req *types.QueryUnbondingsRequest) (*types.QueryUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var unbondingStore prefix.Store
+	if req.StakingCoinDenom == "" {
+		unbondingStore = prefix.NewStore(store, types.GetUnbondingsByFarmerPrefix(farmerAcc))
+	} else {
+		unbondingStore = prefix.NewStore(store, types.GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, req.StakingCoinDenom))
+	}
+
+	unbondings := []types.Unbonding{}
+	pageRes, err := query.Paginate(unbondingStore, req.Pagination, func(key, _ []byte) error {
+		unbondingId := sdk.BigEndianToUint64(key[len(key)-8:])
+		unbonding, _ := k.Keeper.GetUnbonding(ctx, unbondingId)
+		unbondings = append(unbondings, unbonding)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingsResponse{Unbondings: unbondings, Pagination: pageRes}, nil
+}
+
+// VestedRewards queries the reward vestings of a farmer along with the
+// vested and unvested amounts.
+func (k Querier) VestedRewards(c context.Context, req *types.QueryVestedRewardsRequest) (*types.QueryVestedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vestings, vested, unvested := k.Keeper.VestedRewards(ctx, farmerAcc)
+
+	return &types.QueryVestedRewardsResponse{Vestings: vestings, Vested: vested, Unvested: unvested}, nil
+}
+
+// AutoCompound queries the auto-compounding setting of a farmer.
+func (k Querier) AutoCompound(c context.Context, req *types.QueryAutoCompoundRequest) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAutoCompoundResponse{Enabled: k.Keeper.GetAutoCompound(ctx, farmerAcc)}, nil
+}
+
+// RewardsWithdrawAddress queries the rewards withdraw address of a farmer.
+func (k Querier) RewardsWithdrawAddress(c context.Context, req *types.QueryRewardsWithdrawAddressRequest) (*types.QueryRewardsWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRewardsWithdrawAddressResponse{
+		WithdrawAddress: k.Keeper.GetRewardsWithdrawAddress(ctx, farmerAcc).String(),
+	}, nil
+}
+
 // TotalStakings queries total staking coin amount for a specific staking coin denom.
 func (k Querier) TotalStakings(c context.Context, req *types.QueryTotalStakingsRequest) (*types.QueryTotalStakingsResponse, error) {
 	if req == nil {
@@ -231,25 +494,247 @@ func (k Querier) Rewards(c context.Context, req *types.QueryRewardsRequest) (*ty
 		Rewards: sdk.NewCoins(),
 	}
 
-	var rewards sdk.Coins
 	if req.StakingCoinDenom == "" {
-		rewards = k.Keeper.AllRewards(ctx, farmerAcc)
+		stakingCoinDenoms := k.Keeper.rewardStakingCoinDenomsByFarmer(ctx, farmerAcc)
+		planRewards := []types.PlanRewards{}
+		pageRes, err := paginateKeys(req.Pagination, sortedStringsIterator(stakingCoinDenoms), func(key []byte) error {
+			stakingCoinDenom := string(key)
+			resp.Rewards = resp.Rewards.Add(k.Keeper.Rewards(ctx, farmerAcc, stakingCoinDenom)...)
+			planRewards = append(planRewards, k.Keeper.RewardsByPlan(ctx, farmerAcc, stakingCoinDenom)...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(planRewards, func(i, j int) bool {
+			return planRewards[i].PlanId < planRewards[j].PlanId
+		})
+		resp.PlanRewards = planRewards
+		resp.Pagination = pageRes
 	} else {
-		rewards = k.Keeper.Rewards(ctx, farmerAcc, req.StakingCoinDenom)
+		resp.Rewards = k.Keeper.Rewards(ctx, farmerAcc, req.StakingCoinDenom)
+		resp.PlanRewards = k.Keeper.RewardsByPlan(ctx, farmerAcc, req.StakingCoinDenom)
+	}
+
+	return resp, nil
+}
+
+// HistoricalRewards queries historical rewards for a staking coin denom.
+func (k Querier) HistoricalRewards(c context.Context, req *types.QueryHistoricalRewardsRequest) (*types.QueryHistoricalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	if req.EndEpoch != 0 && req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d must not be greater than end epoch %d", req.StartEpoch, req.EndEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	historicalRewardsStore := prefix.NewStore(store, types.GetHistoricalRewardsPrefix(req.StakingCoinDenom))
+
+	// Iterate only the records within the epoch range.
+	start := sdk.Uint64ToBigEndian(req.StartEpoch)
+	var end []byte
+	if req.EndEpoch != 0 && req.EndEpoch != math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(req.EndEpoch + 1)
+	}
+
+	historicalRewards := []types.HistoricalRewardsResponse{}
+	pageRes, err := paginateKeys(req.Pagination, storeRangeIterator(historicalRewardsStore, start, end), func(key []byte) error {
+		var rewards types.HistoricalRewards
+		if err := k.cdc.Unmarshal(historicalRewardsStore.Get(key), &rewards); err != nil {
+			return err
+		}
+		historicalRewards = append(historicalRewards, types.HistoricalRewardsResponse{
+			Epoch:                 sdk.BigEndianToUint64(key),
+			CumulativeUnitRewards: rewards.CumulativeUnitRewards,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricalRewardsResponse{HistoricalRewards: historicalRewards, Pagination: pageRes}, nil
+}
+
+// OutstandingRewards queries outstanding rewards for a staking coin denom.
+func (k Querier) OutstandingRewards(c context.Context, req *types.QueryOutstandingRewardsRequest) (*types.QueryOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	outstanding, found := k.Keeper.GetOutstandingRewards(ctx, req.StakingCoinDenom)
+	if !found {
+		outstanding.Rewards = sdk.DecCoins{}
+	}
+
+	return &types.QueryOutstandingRewardsResponse{Rewards: outstanding.Rewards}, nil
+}
+
+// CurrentEpoch queries the current epoch for a staking coin denom.
+func (k Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: k.Keeper.GetCurrentEpoch(ctx, req.StakingCoinDenom)}, nil
+}
+
+// ExpectedRewards queries rewards expected to be allocated for a staking coin
+// denom at the end of the current epoch.
+func (k Querier) ExpectedRewards(c context.Context, req *types.QueryExpectedRewardsRequest) (*types.QueryExpectedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	var farmerAcc sdk.AccAddress
+	if req.Farmer != "" {
+		var err error
+		farmerAcc, err = sdk.AccAddressFromBech32(req.Farmer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stakingAmt := sdk.ZeroInt()
+	if req.StakingAmount != "" {
+		var ok bool
+		stakingAmt, ok = sdk.NewIntFromString(req.StakingAmount)
+		if !ok || stakingAmt.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid staking amount %s", req.StakingAmount)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	totalStakings, found := k.Keeper.GetTotalStakings(ctx, req.StakingCoinDenom)
+	if !found {
+		totalStakings.Amount = sdk.ZeroInt()
+	}
+
+	resp := &types.QueryExpectedRewardsResponse{
+		TotalStakings: totalStakings.Amount.Add(stakingAmt),
+		StakedAmount:  stakingAmt,
+		UnitRewards:   sdk.DecCoins{},
+		Rewards:       sdk.DecCoins{},
+	}
+	if farmerAcc != nil {
+		resp.StakedAmount = resp.StakedAmount.Add(k.Keeper.RewardWeight(ctx, farmerAcc, req.StakingCoinDenom))
+	}
+
+	resp.PlanRewards = k.Keeper.ExpectedPlanRewards(ctx, req.StakingCoinDenom, resp.StakedAmount, resp.TotalStakings)
+	for _, planRewards := range resp.PlanRewards {
+		resp.UnitRewards = resp.UnitRewards.Add(planRewards.UnitRewards...)
+		resp.Rewards = resp.Rewards.Add(planRewards.Rewards...)
 	}
-	resp.Rewards = rewards
 
 	return resp, nil
 }
 
-// CurrentEpochDays queries current epoch days.
+// SimulateAllocation queries how rewards would be allocated at the end of the current epoch.
+func (k Querier) SimulateAllocation(c context.Context, req *types.QuerySimulateAllocationRequest) (*types.QuerySimulateAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	poolAllocs, planAllocs, unitRewards := k.Keeper.SimulateAllocation(ctx)
+
+	return &types.QuerySimulateAllocationResponse{
+		FarmingPoolAllocations: poolAllocs,
+		PlanAllocations:        planAllocs,
+		UnitRewards:            unitRewards,
+	}, nil
+}
+
+// CurrentEpochDays queries current epoch duration rounded to days.
+// Deprecated: use CurrentEpochDuration instead.
 func (k Querier) CurrentEpochDays(c context.Context, req *types.QueryCurrentEpochDaysRequest) (*types.QueryCurrentEpochDaysResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	currentEpochDays := k.Keeper.GetCurrentEpochDays(ctx)
+	day := 24 * time.Hour
+	currentEpochDays := k.Keeper.GetCurrentEpochDuration(ctx).Round(day) / day
+
+	return &types.QueryCurrentEpochDaysResponse{CurrentEpochDays: uint32(currentEpochDays)}, nil
+}
+
+// CurrentEpochDuration queries current epoch duration.
+func (k Querier) CurrentEpochDuration(c context.Context, req *types.QueryCurrentEpochDurationRequest) (*types.QueryCurrentEpochDurationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentEpochDuration := k.Keeper.GetCurrentEpochDuration(ctx)
+
+	return &types.QueryCurrentEpochDurationResponse{CurrentEpochDuration: currentEpochDuration}, nil
+}
+
+// EpochInfo queries information about the current epoch.
+func (k Querier) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryCurrentEpochDaysResponse{CurrentEpochDays: currentEpochDays}, nil
+	resp := &types.QueryEpochInfoResponse{
+		CurrentEpochDuration: k.Keeper.GetCurrentEpochDuration(ctx),
+		CurrentEpochs:        []types.DenomCurrentEpoch{},
+	}
+	if lastEpochTime, found := k.Keeper.GetLastEpochTime(ctx); found {
+		resp.LastEpochTime = &lastEpochTime
+	}
+	if nextEpochTime, found := k.Keeper.NextEpochTime(ctx); found {
+		resp.NextEpochTime = &nextEpochTime
+	}
+
+	if req.StakingCoinDenom == "" {
+		k.Keeper.IterateCurrentEpochs(ctx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+			resp.CurrentEpochs = append(resp.CurrentEpochs, types.DenomCurrentEpoch{
+				StakingCoinDenom: stakingCoinDenom,
+				CurrentEpoch:     currentEpoch,
+			})
+			return false
+		})
+	} else {
+		resp.CurrentEpochs = append(resp.CurrentEpochs, types.DenomCurrentEpoch{
+			StakingCoinDenom: req.StakingCoinDenom,
+			CurrentEpoch:     k.Keeper.GetCurrentEpoch(ctx, req.StakingCoinDenom),
+		})
+	}
+
+	return resp, nil
 }
@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
@@ -281,6 +285,51 @@ func (suite *KeeperTestSuite) TestGRPCStakings() {
 			true,
 			nil,
 		},
+		{
+			"query with pagination",
+			&types.QueryStakingsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryStakingsResponse) {
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)), resp.StakedCoins))
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)), resp.QueuedCoins))
+				suite.Require().EqualValues(2, resp.Pagination.Total)
+				suite.Require().Equal([]byte(denom2), resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with pagination key",
+			&types.QueryStakingsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Key: []byte(denom2), Limit: 1},
+			},
+			false,
+			func(resp *types.QueryStakingsResponse) {
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom2, 1500)), resp.StakedCoins))
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom2, 1500)), resp.QueuedCoins))
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with both pagination key and offset",
+			&types.QueryStakingsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Key: []byte(denom2), Offset: 1},
+			},
+			true,
+			nil,
+		},
+		{
+			"query with reverse pagination",
+			&types.QueryStakingsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			true,
+			nil,
+		},
 	} {
 		suite.Run(tc.name, func() {
 			resp, err := suite.querier.Stakings(sdk.WrapSDKContext(suite.ctx), tc.req)
@@ -294,6 +343,414 @@ func (suite *KeeperTestSuite) TestGRPCStakings() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCStakingsByDenom() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300)))
+	suite.Stake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 700)))
+
+	// Farmers are listed in the order of their address bytes.
+	expected := map[string]types.FarmerStaking{}
+	for _, s := range []types.FarmerStaking{
+		{Farmer: suite.addrs[0].String(), StakedAmount: sdk.NewInt(1000), QueuedAmount: sdk.NewInt(300)},
+		{Farmer: suite.addrs[1].String(), StakedAmount: sdk.NewInt(500), QueuedAmount: sdk.ZeroInt()},
+		{Farmer: suite.addrs[2].String(), StakedAmount: sdk.ZeroInt(), QueuedAmount: sdk.NewInt(700)},
+	} {
+		expected[s.Farmer] = s
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryStakingsByDenomRequest
+		expectErr bool
+		postRun   func(*types.QueryStakingsByDenomResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Len(resp.Stakings, 3)
+				for i, s := range resp.Stakings {
+					if i > 0 {
+						prevAcc, _ := sdk.AccAddressFromBech32(resp.Stakings[i-1].Farmer)
+						acc, _ := sdk.AccAddressFromBech32(s.Farmer)
+						suite.Require().Negative(bytes.Compare(prevAcc, acc))
+					}
+					suite.Require().True(intEq(expected[s.Farmer].StakedAmount, s.StakedAmount))
+					suite.Require().True(intEq(expected[s.Farmer].QueuedAmount, s.QueuedAmount))
+				}
+				suite.Require().EqualValues(3, resp.Pagination.Total)
+			},
+		},
+		{
+			"query by staking coin denom with no stakings",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: denom3},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Empty(resp.Stakings)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryStakingsByDenomRequest{
+				StakingCoinDenom: denom1,
+				Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Len(resp.Stakings, 2)
+				suite.Require().EqualValues(3, resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+
+				nextResp, err := suite.querier.StakingsByDenom(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingsByDenomRequest{
+					StakingCoinDenom: denom1,
+					Pagination:       &query.PageRequest{Key: resp.Pagination.NextKey},
+				})
+				suite.Require().NoError(err)
+				suite.Require().Len(nextResp.Stakings, 1)
+				suite.Require().Nil(nextResp.Pagination.NextKey)
+				for _, s := range resp.Stakings {
+					suite.Require().NotEqual(s.Farmer, nextResp.Stakings[0].Farmer)
+				}
+			},
+		},
+		{
+			"query with pagination offset",
+			&types.QueryStakingsByDenomRequest{
+				StakingCoinDenom: denom1,
+				Pagination:       &query.PageRequest{Offset: 2, Limit: 2},
+			},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Len(resp.Stakings, 1)
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.StakingsByDenom(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueuedStakings() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 1500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryQueuedStakingsRequest
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryQueuedStakingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+		{
+			"query before the first epoch",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().True(coinsEq(
+					sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 1500)),
+					resp.QueuedCoins))
+				suite.Require().Nil(resp.NextEpochTime)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.QueuedStakings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2022-01-01T00:00:00Z"))
+
+	for _, tc := range []struct {
+		name    string
+		req     *types.QueryQueuedStakingsRequest
+		postRun func(*types.QueryQueuedStakingsResponse)
+	}{
+		{
+			"query by farmer addr",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String()},
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().True(coinsEq(
+					sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 1500)),
+					resp.QueuedCoins))
+				suite.Require().Equal(types.ParseTime("2022-01-02T00:00:00Z"), *resp.NextEpochTime)
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom2, 1500)), resp.QueuedCoins))
+				suite.Require().Equal(types.ParseTime("2022-01-02T00:00:00Z"), *resp.NextEpochTime)
+			},
+		},
+		{
+			"query by farmer addr without queued stakings",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[1].String()},
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().True(resp.QueuedCoins.IsZero())
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.QueuedStakings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			suite.Require().NoError(err)
+			tc.postRun(resp)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCLocks() {
+	suite.setLockMultipliers()
+
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)), 7*24*time.Hour)
+	suite.keeper.ProcessQueuedLocks(suite.ctx)
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)), 30*24*time.Hour)
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryLocksRequest
+		expectErr bool
+		postRun   func(*types.QueryLocksResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryLocksRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryLocksResponse) {
+				suite.Require().Len(resp.Locks, 3)
+				suite.Require().Equal(uint64(1), resp.Locks[0].Id)
+				suite.Require().False(resp.Locks[0].IsQueued())
+				suite.Require().Equal(uint64(3), resp.Locks[1].Id)
+				suite.Require().True(resp.Locks[1].IsQueued())
+				suite.Require().True(intEq(sdk.NewInt(1000), resp.Locks[1].Weight))
+				suite.Require().Equal(uint64(2), resp.Locks[2].Id)
+				suite.Require().Equal(denom2, resp.Locks[2].StakingCoinDenom)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryLocksRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryLocksRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryLocksResponse) {
+				suite.Require().Len(resp.Locks, 1)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.Locks[0].Amount))
+				suite.Require().True(intEq(sdk.NewInt(2250), resp.Locks[0].Weight))
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryLocksRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryLocksResponse) {
+				suite.Require().Len(resp.Locks, 2)
+				suite.Require().Equal(uint64(1), resp.Locks[0].Id)
+				suite.Require().Equal(uint64(3), resp.Locks[1].Id)
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+
+				resp, err := suite.querier.Locks(sdk.WrapSDKContext(suite.ctx), &types.QueryLocksRequest{
+					Farmer:     suite.addrs[0].String(),
+					Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+				})
+				suite.Require().NoError(err)
+				suite.Require().Len(resp.Locks, 1)
+				suite.Require().Equal(uint64(2), resp.Locks[0].Id)
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"farmer without locks",
+			&types.QueryLocksRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryLocksResponse) {
+				suite.Require().Empty(resp.Locks)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryLocksRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.Locks(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCUnbondings() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnstakingPeriod = 7 * 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300), sdk.NewInt64Coin(denom2, 500)))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 200)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryUnbondingsRequest
+		expectErr bool
+		postRun   func(*types.QueryUnbondingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Len(resp.Unbondings, 3)
+				suite.Require().Equal(uint64(1), resp.Unbondings[0].Id)
+				suite.Require().Equal(uint64(3), resp.Unbondings[1].Id)
+				suite.Require().True(intEq(sdk.NewInt(200), resp.Unbondings[1].Amount))
+				suite.Require().Equal(uint64(2), resp.Unbondings[2].Id)
+				suite.Require().Equal(denom2, resp.Unbondings[2].StakingCoinDenom)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryUnbondingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Len(resp.Unbondings, 1)
+				suite.Require().True(intEq(sdk.NewInt(500), resp.Unbondings[0].Amount))
+				suite.Require().True(suite.ctx.BlockTime().Add(7 * 24 * time.Hour).Equal(resp.Unbondings[0].CompletionTime))
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryUnbondingsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Len(resp.Unbondings, 2)
+				suite.Require().Equal(uint64(1), resp.Unbondings[0].Id)
+				suite.Require().Equal(uint64(3), resp.Unbondings[1].Id)
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+
+				resp, err := suite.querier.Unbondings(sdk.WrapSDKContext(suite.ctx), &types.QueryUnbondingsRequest{
+					Farmer:     suite.addrs[0].String(),
+					Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+				})
+				suite.Require().NoError(err)
+				suite.Require().Len(resp.Unbondings, 1)
+				suite.Require().Equal(uint64(2), resp.Unbondings[0].Id)
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"farmer without unbondings",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Empty(resp.Unbondings)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.Unbondings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCTotalStakings() {
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
 	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 2000)))
@@ -420,8 +877,50 @@ func (suite *KeeperTestSuite) TestGRPCRewards() {
 			true,
 			nil,
 		},
-	} {
-		resp, err := suite.querier.Rewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+		{
+			"query with pagination",
+			&types.QueryRewardsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryRewardsResponse) {
+				// 0.3 * 1000000 * 1/2
+				// + 1.0 * 2000000 * 1/2
+				// ~= 1150000
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1150000)), resp.Rewards))
+				for _, planRewards := range resp.PlanRewards {
+					suite.Require().Equal(denom1, planRewards.StakingCoinDenom)
+				}
+				suite.Require().EqualValues(2, resp.Pagination.Total)
+				suite.Require().Equal([]byte(denom2), resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with pagination key",
+			&types.QueryRewardsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Key: []byte(denom2)},
+			},
+			false,
+			func(resp *types.QueryRewardsResponse) {
+				// 0.7 * 1000000 * 1/1
+				// ~= 700000
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 699999)), resp.Rewards))
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with reverse pagination",
+			&types.QueryRewardsRequest{
+				Farmer:     suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			true,
+			nil,
+		},
+	} {
+		resp, err := suite.querier.Rewards(sdk.WrapSDKContext(suite.ctx), tc.req)
 		if tc.expectErr {
 			suite.Require().Error(err)
 		} else {
@@ -430,3 +929,545 @@ func (suite *KeeperTestSuite) TestGRPCRewards() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCAutoCompound() {
+	suite.keeper.SetAutoCompound(suite.ctx, suite.addrs[0], true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAutoCompoundRequest
+		expectErr bool
+		postRun   func(*types.QueryAutoCompoundResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryAutoCompoundRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"enabled",
+			&types.QueryAutoCompoundRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryAutoCompoundResponse) {
+				suite.Require().True(resp.Enabled)
+			},
+		},
+		{
+			"disabled",
+			&types.QueryAutoCompoundRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryAutoCompoundResponse) {
+				suite.Require().False(resp.Enabled)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.AutoCompound(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRewardsWithdrawAddress() {
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryRewardsWithdrawAddressRequest
+		expectErr bool
+		postRun   func(*types.QueryRewardsWithdrawAddressResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"withdraw address set",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryRewardsWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[5].String(), resp.WithdrawAddress)
+			},
+		},
+		{
+			"withdraw address not set",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryRewardsWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[1].String(), resp.WithdrawAddress)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.RewardsWithdrawAddress(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCHistoricalRewards() {
+	for epoch := uint64(0); epoch < 5; epoch++ {
+		suite.keeper.SetHistoricalRewards(suite.ctx, denom1, epoch, types.HistoricalRewards{
+			CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, int64(epoch*100))),
+		})
+	}
+	suite.keeper.SetHistoricalRewards(suite.ctx, denom2, 0, types.HistoricalRewards{})
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryHistoricalRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryHistoricalRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty request",
+			&types.QueryHistoricalRewardsRequest{},
+			true,
+			nil,
+		},
+		{
+			"invalid epoch range",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartEpoch: 3, EndEpoch: 2},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 5)
+				for i, rewards := range resp.HistoricalRewards {
+					suite.Require().Equal(uint64(i), rewards.Epoch)
+				}
+				suite.Require().True(decCoinsEq(
+					sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 400)),
+					resp.HistoricalRewards[4].CumulativeUnitRewards))
+			},
+		},
+		{
+			"query by epoch range",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartEpoch: 1, EndEpoch: 3},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 3)
+				suite.Require().Equal(uint64(1), resp.HistoricalRewards[0].Epoch)
+				suite.Require().Equal(uint64(3), resp.HistoricalRewards[2].Epoch)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: denom1,
+				StartEpoch:       1,
+				Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 2)
+				suite.Require().Equal(uint64(1), resp.HistoricalRewards[0].Epoch)
+				suite.Require().EqualValues(4, resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query by epoch range with pagination key",
+			&types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: denom1,
+				StartEpoch:       1,
+				EndEpoch:         3,
+				Pagination:       &query.PageRequest{Key: sdk.Uint64ToBigEndian(3), Limit: 2},
+			},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 1)
+				suite.Require().Equal(uint64(3), resp.HistoricalRewards[0].Epoch)
+				suite.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with pagination key before the epoch range",
+			&types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: denom1,
+				StartEpoch:       2,
+				Pagination:       &query.PageRequest{Key: sdk.Uint64ToBigEndian(0)},
+			},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 3)
+				suite.Require().Equal(uint64(2), resp.HistoricalRewards[0].Epoch)
+			},
+		},
+		{
+			"query with reverse pagination",
+			&types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: denom1,
+				Pagination:       &query.PageRequest{Reverse: true},
+			},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom without historical rewards",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom3},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Empty(resp.HistoricalRewards)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.HistoricalRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCOutstandingRewards() {
+	suite.keeper.SetOutstandingRewards(suite.ctx, denom1, types.OutstandingRewards{
+		Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000)),
+	})
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryOutstandingRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryOutstandingRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty request",
+			&types.QueryOutstandingRewardsRequest{},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryOutstandingRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryOutstandingRewardsResponse) {
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000)), resp.Rewards))
+			},
+		},
+		{
+			"query by staking coin denom without outstanding rewards",
+			&types.QueryOutstandingRewardsRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryOutstandingRewardsResponse) {
+				suite.Require().True(resp.Rewards.IsZero())
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.OutstandingRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCCurrentEpoch() {
+	suite.keeper.SetCurrentEpoch(suite.ctx, denom1, 3)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryCurrentEpochRequest
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty request",
+			&types.QueryCurrentEpochRequest{},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryCurrentEpochRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryCurrentEpochResponse) {
+				suite.Require().Equal(uint64(3), resp.CurrentEpoch)
+			},
+		},
+		{
+			"query by staking coin denom without current epoch",
+			&types.QueryCurrentEpochRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryCurrentEpochResponse) {
+				suite.Require().Equal(uint64(0), resp.CurrentEpoch)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.CurrentEpoch(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCCurrentEpochDays() {
+	_, err := suite.querier.CurrentEpochDays(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+
+	for _, tc := range []struct {
+		currentEpochDuration time.Duration
+		currentEpochDays     uint32
+	}{
+		{24 * time.Hour, 1},
+		{7 * 24 * time.Hour, 7},
+		{36 * time.Hour, 2},
+		{6 * time.Hour, 0},
+	} {
+		suite.keeper.SetCurrentEpochDuration(suite.ctx, tc.currentEpochDuration)
+		resp, err := suite.querier.CurrentEpochDays(sdk.WrapSDKContext(suite.ctx), &types.QueryCurrentEpochDaysRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.currentEpochDays, resp.CurrentEpochDays)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCEpochInfo() {
+	resp, err := suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+	suite.Require().Nil(resp)
+
+	_, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{StakingCoinDenom: "!"})
+	suite.Require().Error(err)
+
+	// Before the first epoch starts.
+	resp, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.LastEpochTime)
+	suite.Require().Nil(resp.NextEpochTime)
+	suite.Require().Equal(types.DefaultNextEpochDuration, resp.CurrentEpochDuration)
+	suite.Require().Empty(resp.CurrentEpochs)
+
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 6*time.Hour)
+	suite.keeper.SetCurrentEpoch(suite.ctx, denom1, 3)
+	suite.keeper.SetCurrentEpoch(suite.ctx, denom2, 5)
+
+	resp, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ParseTime("2022-01-01T00:00:00Z"), *resp.LastEpochTime)
+	suite.Require().Equal(types.ParseTime("2022-01-01T06:00:00Z"), *resp.NextEpochTime)
+	suite.Require().Equal(6*time.Hour, resp.CurrentEpochDuration)
+	suite.Require().Equal([]types.DenomCurrentEpoch{
+		{StakingCoinDenom: denom1, CurrentEpoch: 3},
+		{StakingCoinDenom: denom2, CurrentEpoch: 5},
+	}, resp.CurrentEpochs)
+
+	resp, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{StakingCoinDenom: denom2})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomCurrentEpoch{
+		{StakingCoinDenom: denom2, CurrentEpoch: 5},
+	}, resp.CurrentEpochs)
+}
+
+func (suite *KeeperTestSuite) TestGRPCExpectedRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.3", denom2: "0.7"}, map[string]int64{denom3: 1_000_000})
+	// The farming pool of this plan does not have sufficient balance.
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 2_000_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryExpectedRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryExpectedRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty request",
+			&types.QueryExpectedRewardsRequest{},
+			true,
+			nil,
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1, Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking amount",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1, StakingAmount: "-1"},
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryExpectedRewardsResponse) {
+				suite.Require().True(intEq(sdk.NewInt(1_000_000), resp.TotalStakings))
+				suite.Require().True(resp.StakedAmount.IsZero())
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.NewDecWithPrec(3, 1))), resp.UnitRewards))
+				suite.Require().True(resp.Rewards.IsZero())
+				suite.Require().Len(resp.PlanRewards, 2)
+				suite.Require().Equal(uint64(1), resp.PlanRewards[0].PlanId)
+				suite.Require().True(resp.PlanRewards[0].SufficientBalance)
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), resp.PlanRewards[0].AllocationAmount))
+				suite.Require().Equal(uint64(2), resp.PlanRewards[1].PlanId)
+				suite.Require().False(resp.PlanRewards[1].SufficientBalance)
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000_000)), resp.PlanRewards[1].AllocationAmount))
+				suite.Require().True(resp.PlanRewards[1].Rewards.IsZero())
+			},
+		},
+		{
+			"query by farmer",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1, Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryExpectedRewardsResponse) {
+				suite.Require().True(intEq(sdk.NewInt(1_000_000), resp.StakedAmount))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 300_000)), resp.Rewards))
+			},
+		},
+		{
+			"query by farmer with staking amount",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1, Farmer: suite.addrs[0].String(), StakingAmount: "1000000"},
+			false,
+			func(resp *types.QueryExpectedRewardsResponse) {
+				suite.Require().True(intEq(sdk.NewInt(2_000_000), resp.TotalStakings))
+				suite.Require().True(intEq(sdk.NewInt(2_000_000), resp.StakedAmount))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 300_000)), resp.Rewards))
+			},
+		},
+		{
+			"query by staking amount",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom1, StakingAmount: "3000000"},
+			false,
+			func(resp *types.QueryExpectedRewardsResponse) {
+				suite.Require().True(intEq(sdk.NewInt(4_000_000), resp.TotalStakings))
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 225_000)), resp.Rewards))
+			},
+		},
+		{
+			"query by staking coin denom without stakings",
+			&types.QueryExpectedRewardsRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryExpectedRewardsResponse) {
+				suite.Require().True(resp.TotalStakings.IsZero())
+				suite.Require().True(resp.UnitRewards.IsZero())
+				suite.Require().Len(resp.PlanRewards, 1)
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), resp.PlanRewards[0].AllocationAmount))
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.ExpectedRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCSimulateAllocation() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QuerySimulateAllocationRequest
+		expectErr bool
+		postRun   func(*types.QuerySimulateAllocationResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QuerySimulateAllocationRequest{},
+			false,
+			func(resp *types.QuerySimulateAllocationResponse) {
+				suite.Require().Len(resp.FarmingPoolAllocations, 1)
+				suite.Require().False(resp.FarmingPoolAllocations[0].Skipped)
+				suite.Require().Len(resp.PlanAllocations, 1)
+				suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), resp.PlanAllocations[0].AllocatedAmount))
+				suite.Require().Len(resp.UnitRewards, 1)
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)), resp.UnitRewards[0].UnitRewards))
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.SimulateAllocation(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// Implements FarmingHooks interface
+var _ types.FarmingHooks = Keeper{}
+
+// AfterStaked - call hook if registered
+func (k Keeper) AfterStaked(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterStaked(ctx, farmerAcc, stakingCoins)
+	}
+}
+
+// AfterQueuedStakingProcessed - call hook if registered
+func (k Keeper) AfterQueuedStakingProcessed(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	if k.hooks != nil {
+		k.hooks.AfterQueuedStakingProcessed(ctx, farmerAcc, stakingCoinDenom, amount)
+	}
+}
+
+// BeforeUnstaked - call hook if registered
+func (k Keeper) BeforeUnstaked(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.BeforeUnstaked(ctx, farmerAcc, unstakingCoins)
+	}
+}
+
+// AfterRewardsWithdrawn - call hook if registered
+func (k Keeper) AfterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterRewardsWithdrawn(ctx, farmerAcc, rewards)
+	}
+}
+
+// AfterAllocateRewards - call hook if registered
+func (k Keeper) AfterAllocateRewards(ctx sdk.Context) {
+	if k.hooks != nil {
+		k.hooks.AfterAllocateRewards(ctx)
+	}
+}
+
+// AfterPlanTerminated - call hook if registered
+func (k Keeper) AfterPlanTerminated(ctx sdk.Context, plan types.PlanI) {
+	if k.hooks != nil {
+		k.hooks.AfterPlanTerminated(ctx, plan)
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+var _ types.FarmingHooks = &mockFarmingHooks{}
+
+// mockFarmingHooks records the names of the hooks called.
+type mockFarmingHooks struct {
+	calls []string
+}
+
+func (h *mockFarmingHooks) AfterStaked(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	h.calls = append(h.calls, "AfterStaked")
+}
+
+func (h *mockFarmingHooks) AfterQueuedStakingProcessed(_ sdk.Context, _ sdk.AccAddress, _ string, _ sdk.Int) {
+	h.calls = append(h.calls, "AfterQueuedStakingProcessed")
+}
+
+func (h *mockFarmingHooks) BeforeUnstaked(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	h.calls = append(h.calls, "BeforeUnstaked")
+}
+
+func (h *mockFarmingHooks) AfterRewardsWithdrawn(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	h.calls = append(h.calls, "AfterRewardsWithdrawn")
+}
+
+func (h *mockFarmingHooks) AfterAllocateRewards(_ sdk.Context) {
+	h.calls = append(h.calls, "AfterAllocateRewards")
+}
+
+func (h *mockFarmingHooks) AfterPlanTerminated(_ sdk.Context, _ types.PlanI) {
+	h.calls = append(h.calls, "AfterPlanTerminated")
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	h1, h2 := &mockFarmingHooks{}, &mockFarmingHooks{}
+	suite.keeper.SetHooks(types.NewMultiFarmingHooks(h1, h2))
+	suite.Require().Panics(func() {
+		suite.keeper.SetHooks(h1)
+	})
+
+	plan, err := suite.createPublicFixedAmountPlan(
+		suite.addrs[4], suite.addrs[4], parseDecCoins("1denom1"),
+		sampleStartTime, sampleEndTime, parseCoins("1000000denom3"))
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], parseCoins("1000000denom1"))
+	suite.Require().Equal([]string{"AfterStaked"}, h1.calls)
+
+	suite.AdvanceEpoch()
+	suite.Require().Equal([]string{
+		"AfterStaked", "AfterAllocateRewards", "AfterQueuedStakingProcessed",
+	}, h1.calls)
+
+	suite.AdvanceEpoch()
+	h1.calls = nil
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().Equal([]string{"AfterRewardsWithdrawn"}, h1.calls)
+
+	suite.AdvanceEpoch()
+	h1.calls = nil
+	suite.Unstake(suite.addrs[0], parseCoins("1000000denom1"))
+	suite.Require().Equal([]string{"BeforeUnstaked", "AfterRewardsWithdrawn"}, h1.calls)
+
+	h1.calls = nil
+	err = suite.keeper.TerminatePlan(suite.ctx, plan)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"AfterPlanTerminated"}, h1.calls)
+
+	// All hooks are called in sequence.
+	suite.Require().Equal([]string{
+		"AfterStaked", "AfterAllocateRewards", "AfterQueuedStakingProcessed",
+		"AfterAllocateRewards", "AfterRewardsWithdrawn",
+		"AfterAllocateRewards", "BeforeUnstaked", "AfterRewardsWithdrawn",
+		"AfterPlanTerminated",
+	}, h2.calls)
+}
@@ -14,10 +14,16 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveStakingAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "positive-queued-staking-amount",
 		PositiveQueuedStakingAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-lock-amount",
+		PositiveLockAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-unbonding-amount",
+		PositiveUnbondingAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "staking-reserved-amount",
 		StakingReservedAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "remaining-rewards-amount",
 		RemainingRewardsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-reserved-amount",
+		VestingReservedAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "non-negative-outstanding-rewards",
 		NonNegativeOutstandingRewardsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "outstanding-rewards-amount",
@@ -26,6 +32,14 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		NonNegativeHistoricalRewardsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "positive-total-stakings-amount",
 		PositiveTotalStakingsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "non-negative-plan-outstanding-rewards",
+		NonNegativePlanOutstandingRewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "plan-outstanding-rewards-amount",
+		PlanOutstandingRewardsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "plan-historical-rewards",
+		PlanHistoricalRewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "capped-total-stakings",
+		CappedTotalStakingsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the farming module.
@@ -33,12 +47,19 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		for _, inv := range []func(Keeper) sdk.Invariant{
 			PositiveStakingAmountInvariant,
+			PositiveLockAmountInvariant,
+			PositiveUnbondingAmountInvariant,
 			StakingReservedAmountInvariant,
 			RemainingRewardsAmountInvariant,
+			VestingReservedAmountInvariant,
 			NonNegativeOutstandingRewardsInvariant,
 			OutstandingRewardsAmountInvariant,
 			NonNegativeHistoricalRewardsInvariant,
 			PositiveTotalStakingsAmountInvariant,
+			NonNegativePlanOutstandingRewardsInvariant,
+			PlanOutstandingRewardsAmountInvariant,
+			PlanHistoricalRewardsInvariant,
+			CappedTotalStakingsInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -91,13 +112,57 @@ func PositiveQueuedStakingAmountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// StakingReservedAmountInvariant checks that the balance of StakingReserveAcc greater than the amount of staked, Queued coins in all staking objects.
+// PositiveLockAmountInvariant checks that the amount and the weight of
+// locked staking coins are positive.
+func PositiveLockAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		k.IterateLocks(ctx, func(lock types.Lock) (stop bool) {
+			if !lock.Amount.IsPositive() || !lock.Weight.IsPositive() {
+				msg += fmt.Sprintf("\t%v has non-positive lock amount or weight: %v, %v (lock id %d)\n",
+					lock.Farmer, sdk.Coin{Denom: lock.StakingCoinDenom, Amount: lock.Amount}, lock.Weight, lock.Id)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "positive lock amount",
+			fmt.Sprintf("found %d locks with non-positive amount or weight\n%s", count, msg),
+		), broken
+	}
+}
+
+// PositiveUnbondingAmountInvariant checks that the amount of unbonding
+// coins is positive.
+func PositiveUnbondingAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		k.IterateUnbondings(ctx, func(unbonding types.Unbonding) (stop bool) {
+			if !unbonding.Amount.IsPositive() {
+				msg += fmt.Sprintf("\t%v has non-positive unbonding amount: %v (unbonding id %d)\n",
+					unbonding.Farmer, sdk.Coin{Denom: unbonding.StakingCoinDenom, Amount: unbonding.Amount}, unbonding.Id)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "positive unbonding amount",
+			fmt.Sprintf("found %d unbondings with non-positive amount\n%s", count, msg),
+		), broken
+	}
+}
+
+// StakingReservedAmountInvariant checks that the balance of StakingReserveAcc greater than the amount of staked, queued, locked and unbonding coins in all staking objects.
 func StakingReservedAmountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		err := k.ValidateStakingReservedAmount(ctx)
 		broken := err != nil
 		return sdk.FormatInvariant(types.ModuleName, "staking reserved amount",
-			"the balance of StakingReserveAcc less than the amount of staked, queued coins in all staking objects",
+			"the balance of StakingReserveAcc less than the amount of staked, queued, locked, unbonding coins in all staking objects",
 		), broken
 	}
 }
@@ -113,6 +178,19 @@ func RemainingRewardsAmountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// VestingReservedAmountInvariant checks that the balance of the vesting
+// reserve is greater than the amount of unclaimed rewards in all reward
+// vestings.
+func VestingReservedAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateVestingReservedAmount(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "vesting reserved amount",
+			"the balance of VestingReserveAcc less than the amount of unclaimed rewards in all reward vestings",
+		), broken
+	}
+}
+
 // NonNegativeOutstandingRewardsInvariant checks that all OutstandingRewards are
 // non-negative.
 func NonNegativeOutstandingRewardsInvariant(k Keeper) sdk.Invariant {
@@ -199,3 +277,97 @@ func PositiveTotalStakingsAmountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// NonNegativePlanOutstandingRewardsInvariant checks that all plan outstanding
+// rewards are non-negative.
+func NonNegativePlanOutstandingRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		k.IteratePlanOutstandingRewards(ctx, func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool) {
+			if rewards.Rewards.IsAnyNegative() {
+				msg += fmt.Sprintf("\tplan %d has negative outstanding rewards for %v: %v\n",
+					planId, stakingCoinDenom, rewards.Rewards)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "non-negative plan outstanding rewards",
+			fmt.Sprintf("found %d plan outstanding rewards with negative amount\n%s", count, msg),
+		), broken
+	}
+}
+
+// PlanOutstandingRewardsAmountInvariant checks that plan outstanding rewards
+// exist only for staking coin denoms that have outstanding rewards and that
+// the balance of the rewards reserve pool covers all plan outstanding rewards.
+func PlanOutstandingRewardsAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		totalRewards := sdk.DecCoins{}
+		k.IteratePlanOutstandingRewards(ctx, func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool) {
+			if _, found := k.GetOutstandingRewards(ctx, stakingCoinDenom); !found {
+				msg += fmt.Sprintf("\tplan %d has outstanding rewards for %v without outstanding rewards for the denom\n",
+					planId, stakingCoinDenom)
+				count++
+			}
+			totalRewards = totalRewards.Add(rewards.Rewards...)
+			return false
+		})
+		balances := k.bankKeeper.SpendableCoins(ctx, types.RewardsReserveAcc)
+		_, hasNeg := sdk.NewDecCoinsFromCoins(balances...).SafeSub(totalRewards)
+		if hasNeg {
+			msg += fmt.Sprintf("\tbalance of rewards reserve pool is less than plan outstanding rewards\n"+
+				"\t\texpected minimum amount of balance: %s\n"+
+				"\t\tbalance: %s\n", totalRewards, balances)
+			count++
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "wrong plan outstanding rewards",
+			fmt.Sprintf("found %d wrong plan outstanding rewards\n%s", count, msg),
+		), broken
+	}
+}
+
+// PlanHistoricalRewardsInvariant checks that all plan historical rewards
+// are non-negative and recorded for the current and past epochs only.
+func PlanHistoricalRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		k.IteratePlanHistoricalRewards(ctx, func(stakingCoinDenom string, planId uint64, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+			if rewards.CumulativeUnitRewards.IsAnyNegative() {
+				msg += fmt.Sprintf("\tplan %d has negative historical rewards for %v at epoch %d: %v\n",
+					planId, stakingCoinDenom, epoch, rewards.CumulativeUnitRewards)
+				count++
+			}
+			if currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom); epoch > currentEpoch {
+				msg += fmt.Sprintf("\tplan %d has historical rewards for %v at epoch %d, which is after the current epoch %d\n",
+					planId, stakingCoinDenom, epoch, currentEpoch)
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "plan historical rewards",
+			fmt.Sprintf("found %d wrong plan historical rewards\n%s", count, msg),
+		), broken
+	}
+}
+
+// CappedTotalStakingsInvariant checks that the capped total stakings of each
+// plan with stake caps equal the sum of the capped stakes counted toward it.
+func CappedTotalStakingsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateCappedTotalStakings(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "capped total stakings",
+			"the capped total stakings of a plan differ from the sum of the capped stakes counted toward the plan",
+		), broken
+	}
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	farmingkeeper "github.com/tendermint/farming/x/farming/keeper"
@@ -118,6 +120,36 @@ func (suite *KeeperTestSuite) TestStakingReservedAmountInvariant() {
 	suite.Require().True(broken)
 }
 
+func (suite *KeeperTestSuite) TestStakingReservedAmountInvariant_Unbondings() {
+	k, ctx := suite.keeper, suite.ctx
+
+	params := k.GetParams(ctx)
+	params.UnstakingPeriod = 7 * 24 * time.Hour
+	k.SetParams(ctx, params)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400000)))
+
+	// Unbonding coins stay in the staking reserve acc.
+	_, broken := farmingkeeper.StakingReservedAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Unbonding amount in the store > balance of staking reserve acc. This shouldn't be OK.
+	unbonding, _ := k.GetUnbonding(ctx, 1)
+	unbonding.Amount = sdk.NewInt(400001)
+	k.SetUnbonding(ctx, unbonding)
+	_, broken = farmingkeeper.StakingReservedAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	_, broken = farmingkeeper.PositiveUnbondingAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+	unbonding.Amount = sdk.ZeroInt()
+	k.SetUnbonding(ctx, unbonding)
+	_, broken = farmingkeeper.PositiveUnbondingAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
 func (suite *KeeperTestSuite) TestRemainingRewardsAmountInvariant() {
 	k, ctx := suite.keeper, suite.ctx
 
@@ -171,6 +203,89 @@ func (suite *KeeperTestSuite) TestRemainingRewardsAmountInvariant() {
 	suite.Require().True(broken)
 }
 
+func (suite *KeeperTestSuite) TestRemainingRewardsAmountInvariantWithLocks() {
+	k, ctx := suite.keeper, suite.ctx
+
+	suite.setLockMultipliers()
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	// The farmer has only a lock, without any staking.
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), 30*24*time.Hour)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	_, found := k.GetStaking(ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2000000)), suite.AllRewards(suite.addrs[0])))
+
+	_, broken := farmingkeeper.RemainingRewardsAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// The rewards of the lock are not covered by the rewards reserve acc.
+	// Should not be OK.
+	err := suite.app.BankKeeper.SendCoins(
+		ctx, types.RewardsReserveAcc, suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)))
+	suite.Require().NoError(err)
+	_, broken = farmingkeeper.RemainingRewardsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestVestingReservedAmountInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	suite.createVestingPlan(suite.addrs[4], 24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	_, broken := farmingkeeper.VestingReservedAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Unclaimed amount in the store > balance of vesting reserve acc.
+	// Should not be OK.
+	vesting, _ := k.GetRewardVesting(ctx, 1)
+	vesting.TotalAmount = vesting.TotalAmount.Add(sdk.NewInt64Coin(denom3, 1))
+	k.SetRewardVesting(ctx, vesting)
+	_, broken = farmingkeeper.VestingReservedAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Send coins into the vesting reserve acc.
+	// Should be OK.
+	err := suite.app.BankKeeper.SendCoins(
+		ctx, suite.addrs[1], types.VestingReserveAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)))
+	suite.Require().NoError(err)
+	_, broken = farmingkeeper.VestingReservedAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestCappedTotalStakingsInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	plan := suite.createCappedPlan(suite.addrs[4], 1_000_000, 0)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.AdvanceEpoch()
+
+	_, broken := farmingkeeper.CappedTotalStakingsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Capped total stakings differ from the sum of the capped stakes.
+	// Should not be OK.
+	k.SetCappedTotalStakings(ctx, denom1, plan.GetId(), types.TotalStakings{Amount: sdk.NewInt(2_000_000)})
+	_, broken = farmingkeeper.CappedTotalStakingsInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Restore the capped total stakings.
+	// Should be OK.
+	k.SetCappedTotalStakings(ctx, denom1, plan.GetId(), types.TotalStakings{Amount: sdk.NewInt(1_500_000)})
+	_, broken = farmingkeeper.CappedTotalStakingsInvariant(k)(ctx)
+	suite.Require().False(broken)
+}
+
 func (suite *KeeperTestSuite) TestNonNegativeOutstandingRewardsInvariant() {
 	k, ctx := suite.keeper, suite.ctx
 
@@ -293,3 +408,98 @@ func (suite *KeeperTestSuite) TestPositiveTotalStakingsAmountInvariant() {
 	_, broken = farmingkeeper.PositiveTotalStakingsAmountInvariant(k)(ctx)
 	suite.Require().True(broken)
 }
+
+func (suite *KeeperTestSuite) TestNonNegativePlanOutstandingRewardsInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	// This is normal.
+	k.SetPlanOutstandingRewards(ctx, denom1, 1, types.OutstandingRewards{
+		Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000000)),
+	})
+	_, broken := farmingkeeper.NonNegativePlanOutstandingRewardsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Zero-amount plan outstanding rewards
+	k.SetPlanOutstandingRewards(ctx, denom1, 2, types.OutstandingRewards{
+		Rewards: sdk.DecCoins{},
+	})
+	_, broken = farmingkeeper.NonNegativePlanOutstandingRewardsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Negative-amount plan outstanding rewards
+	k.SetPlanOutstandingRewards(ctx, denom1, 2, types.OutstandingRewards{
+		Rewards: sdk.DecCoins{sdk.DecCoin{Denom: denom3, Amount: sdk.NewDec(-1)}},
+	})
+	_, broken = farmingkeeper.NonNegativePlanOutstandingRewardsInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestPlanOutstandingRewardsAmountInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	// Plan outstanding rewards without the denom's outstanding rewards.
+	k.SetPlanOutstandingRewards(ctx, denom1, 1, types.OutstandingRewards{
+		Rewards: sdk.DecCoins{},
+	})
+	_, broken := farmingkeeper.PlanOutstandingRewardsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// This is normal.
+	k.SetOutstandingRewards(ctx, denom1, types.OutstandingRewards{
+		Rewards: sdk.DecCoins{},
+	})
+	_, broken = farmingkeeper.PlanOutstandingRewardsAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Plan outstanding rewards exceed the balance of the rewards reserve acc.
+	k.SetPlanOutstandingRewards(ctx, denom1, 1, types.OutstandingRewards{
+		Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)),
+	})
+	_, broken = farmingkeeper.PlanOutstandingRewardsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Send coins to the rewards reserve acc. Now it is OK.
+	err := suite.app.BankKeeper.SendCoins(
+		ctx, suite.addrs[0], types.RewardsReserveAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)))
+	suite.Require().NoError(err)
+	_, broken = farmingkeeper.PlanOutstandingRewardsAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestPlanHistoricalRewardsInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	k.SetCurrentEpoch(ctx, denom1, 2)
+
+	// This is normal.
+	k.SetPlanHistoricalRewards(ctx, denom1, 1, 1, types.HistoricalRewards{
+		CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000000)),
+	})
+	_, broken := farmingkeeper.PlanHistoricalRewardsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Plan historical rewards for the current epoch, which accumulate the
+	// rewards streamed during the epoch
+	k.SetPlanHistoricalRewards(ctx, denom1, 1, 2, types.HistoricalRewards{
+		CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 2000000)),
+	})
+	_, broken = farmingkeeper.PlanHistoricalRewardsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Plan historical rewards for a future epoch
+	k.SetPlanHistoricalRewards(ctx, denom1, 1, 3, types.HistoricalRewards{
+		CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 3000000)),
+	})
+	_, broken = farmingkeeper.PlanHistoricalRewardsInvariant(k)(ctx)
+	suite.Require().True(broken)
+	k.SetCurrentEpoch(ctx, denom1, 3)
+	_, broken = farmingkeeper.PlanHistoricalRewardsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Negative-amount plan historical rewards
+	k.SetPlanHistoricalRewards(ctx, denom1, 2, 1, types.HistoricalRewards{
+		CumulativeUnitRewards: sdk.DecCoins{sdk.DecCoin{Denom: denom3, Amount: sdk.NewDec(-1)}},
+	})
+	_, broken = farmingkeeper.PlanHistoricalRewardsInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
@@ -49,6 +49,8 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 
 	blockedAddrs map[string]bool
+
+	hooks types.FarmingHooks
 }
 
 // NewKeeper returns a farming keeper. It handles:
@@ -79,6 +81,17 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 	}
 }
 
+// SetHooks sets the farming hooks.
+func (k *Keeper) SetHooks(fh types.FarmingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set farming hooks twice")
+	}
+
+	k.hooks = fh
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetNextLockIdWithUpdate increments lock id by one and set it.
+func (k Keeper) GetNextLockIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalLockId(ctx) + 1
+	k.SetGlobalLockId(ctx, id)
+	return id
+}
+
+// SetGlobalLockId sets the global Lock ID counter.
+func (k Keeper) SetGlobalLockId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalLockIdKey, bz)
+}
+
+// GetGlobalLockId returns the global Lock ID counter.
+func (k Keeper) GetGlobalLockId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalLockIdKey)
+	if bz == nil {
+		return 0
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// GetLock returns a lock for given lock id.
+func (k Keeper) GetLock(ctx sdk.Context, lockId uint64) (lock types.Lock, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLockKey(lockId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &lock)
+	found = true
+	return
+}
+
+// SetLock sets a lock along with its indexes.
+func (k Keeper) SetLock(ctx sdk.Context, lock types.Lock) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lock)
+	store.Set(types.GetLockKey(lock.Id), bz)
+	store.Set(types.GetLockIndexKey(lock.GetFarmer(), lock.StakingCoinDenom, lock.Id), []byte{})
+	store.Set(types.GetLockByDenomIndexKey(lock.StakingCoinDenom, lock.Id), []byte{})
+	store.Set(types.GetLockByEndTimeKey(lock.EndTime, lock.Id), []byte{})
+	if lock.IsQueued() {
+		store.Set(types.GetQueuedLockKey(lock.Id), []byte{})
+	} else {
+		store.Delete(types.GetQueuedLockKey(lock.Id))
+	}
+}
+
+// DeleteLock deletes a lock along with its indexes.
+func (k Keeper) DeleteLock(ctx sdk.Context, lock types.Lock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockKey(lock.Id))
+	store.Delete(types.GetLockIndexKey(lock.GetFarmer(), lock.StakingCoinDenom, lock.Id))
+	store.Delete(types.GetLockByDenomIndexKey(lock.StakingCoinDenom, lock.Id))
+	store.Delete(types.GetLockByEndTimeKey(lock.EndTime, lock.Id))
+	store.Delete(types.GetQueuedLockKey(lock.Id))
+}
+
+// IterateLocks iterates through all locks stored in the store
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLocks(ctx sdk.Context, cb func(lock types.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LockKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lock types.Lock
+		k.cdc.MustUnmarshal(iter.Value(), &lock)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateLocksByFarmer iterates through all locks by a farmer
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLocksByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(lock types.Lock) (stop bool)) {
+	k.iterateLocksByIndexPrefix(ctx, types.GetLocksByFarmerPrefix(farmerAcc), cb)
+}
+
+// IterateLocksByFarmerAndDenom iterates through all locks by a farmer
+// for a staking coin denom stored in the store and invokes callback
+// function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLocksByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, cb func(lock types.Lock) (stop bool)) {
+	k.iterateLocksByIndexPrefix(ctx, types.GetLocksByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), cb)
+}
+
+func (k Keeper) iterateLocksByIndexPrefix(ctx sdk.Context, prefix []byte, cb func(lock types.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, lockId := types.ParseLockIndexKey(iter.Key())
+		lock, _ := k.GetLock(ctx, lockId)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateLocksByDenom iterates through all locks for a staking coin denom
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLocksByDenom(ctx sdk.Context, stakingCoinDenom string, cb func(lock types.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetLocksByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, lockId := types.ParseLockByDenomIndexKey(iter.Key())
+		lock, _ := k.GetLock(ctx, lockId)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateQueuedLocks iterates through all queued locks stored in the store
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateQueuedLocks(ctx sdk.Context, cb func(lock types.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.QueuedLockKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		lock, _ := k.GetLock(ctx, types.ParseQueuedLockKey(iter.Key()))
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// IterateMaturedLocks iterates through all locks whose end time is not after
+// the given time and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateMaturedLocks(ctx sdk.Context, t time.Time, cb func(lock types.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.LockByEndTimeKeyPrefix, sdk.PrefixEndBytes(types.GetLocksByEndTimePrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, lockId := types.ParseLockByEndTimeKey(iter.Key())
+		lock, _ := k.GetLock(ctx, lockId)
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// GetAllLockedCoinsByFarmer returns all coins that are locked by a farmer,
+// including the queued ones.
+func (k Keeper) GetAllLockedCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
+	lockedCoins := sdk.NewCoins()
+	k.IterateLocksByFarmer(ctx, farmerAcc, func(lock types.Lock) (stop bool) {
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(lock.StakingCoinDenom, lock.Amount))
+		return false
+	})
+	return lockedCoins
+}
+
+// StakeWithLock stores staking coins to queued locks, which will be
+// processed in the next epoch.
+// The staking coins cannot be unstaked until the locks mature, and the
+// weights of the locks are boosted by the multiplier for the lock duration.
+// Since rewards are withdrawn in the end blocker when each lock matures,
+// the delayed staking gas fee is imposed for every staking coin denom.
+func (k Keeper) StakeWithLock(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins, lockDuration time.Duration) error {
+	params := k.GetParams(ctx)
+	multiplier, found := params.GetLockMultiplier(lockDuration)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidLockDuration, "no lock multiplier for lock duration %s", lockDuration)
+	}
+
+	if err := k.ReserveStakingCoins(ctx, farmerAcc, amount); err != nil {
+		return err
+	}
+
+	endTime := ctx.BlockTime().Add(lockDuration)
+	for _, coin := range amount {
+		lock := types.NewLock(k.GetNextLockIdWithUpdate(ctx), farmerAcc, coin.Denom, coin.Amount, multiplier, endTime)
+		k.SetLock(ctx, lock)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeLock,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyLockId, strconv.FormatUint(lock.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, lock.StakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, lock.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyLockDuration, lockDuration.String()),
+				sdk.NewAttribute(types.AttributeKeyMultiplier, multiplier.String()),
+				sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+			),
+		})
+	}
+
+	ctx.GasMeter().ConsumeGas(sdk.Gas(len(amount))*params.DelayedStakingGasFee, "DelayedStakingGasFee")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStake,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
+		),
+	})
+
+	k.AfterStaked(ctx, farmerAcc, amount)
+
+	return nil
+}
+
+// ProcessQueuedLocks activates queued locks, so their weights are counted
+// in total stakings from the current epoch.
+func (k Keeper) ProcessQueuedLocks(ctx sdk.Context) {
+	k.IterateQueuedLocks(ctx, func(lock types.Lock) (stop bool) {
+		k.IncreaseTotalStakings(ctx, lock.StakingCoinDenom, lock.Weight)
+		lock.StartingEpoch = k.GetCurrentEpoch(ctx, lock.StakingCoinDenom)
+		k.SetLock(ctx, lock)
+		k.updateCappedStakes(ctx, lock.GetFarmer(), lock.StakingCoinDenom)
+		return false
+	})
+}
+
+// ProcessMaturedLocks releases locks whose end time has come.
+// The coins of an active lock are moved to the farmer's staking,
+// which causes accumulated rewards to be withdrawn to the farmer, and
+// the coins of a queued lock are moved to the farmer's queued staking.
+func (k Keeper) ProcessMaturedLocks(ctx sdk.Context) {
+	k.IterateMaturedLocks(ctx, ctx.BlockTime(), func(lock types.Lock) (stop bool) {
+		farmerAcc := lock.GetFarmer()
+
+		if lock.IsQueued() {
+			queuedStaking, found := k.GetQueuedStaking(ctx, lock.StakingCoinDenom, farmerAcc)
+			if !found {
+				queuedStaking.Amount = sdk.ZeroInt()
+			}
+			queuedStaking.Amount = queuedStaking.Amount.Add(lock.Amount)
+			k.SetQueuedStaking(ctx, lock.StakingCoinDenom, farmerAcc, queuedStaking)
+		} else {
+			if _, err := k.WithdrawRewards(ctx, farmerAcc, lock.StakingCoinDenom); err != nil {
+				panic(err)
+			}
+
+			staking, found := k.GetStaking(ctx, lock.StakingCoinDenom, farmerAcc)
+			if !found {
+				staking.Amount = sdk.ZeroInt()
+			}
+			// The amount of the lock stays staked, so only the boosted part
+			// of the weight is removed from total stakings.
+			k.DecreaseTotalStakings(ctx, lock.StakingCoinDenom, lock.Weight.Sub(lock.Amount))
+			k.SetStaking(ctx, lock.StakingCoinDenom, farmerAcc, types.Staking{
+				Amount:        staking.Amount.Add(lock.Amount),
+				StartingEpoch: k.GetCurrentEpoch(ctx, lock.StakingCoinDenom),
+			})
+		}
+
+		k.DeleteLock(ctx, lock)
+		if !lock.IsQueued() {
+			k.updateCappedStakes(ctx, farmerAcc, lock.StakingCoinDenom)
+			k.updateStartingRewards(ctx, farmerAcc, lock.StakingCoinDenom)
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeLockMatured,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyLockId, strconv.FormatUint(lock.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, lock.StakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, lock.Amount.String()),
+			),
+		})
+
+		return false
+	})
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (suite *KeeperTestSuite) setLockMultipliers() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LockMultipliers = []types.LockMultiplier{
+		{Duration: 7 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.5")},
+		{Duration: 30 * 24 * time.Hour, Multiplier: sdk.NewDec(2)},
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// StakeWithLock is a convenient method to test Keeper.StakeWithLock.
+func (suite *KeeperTestSuite) StakeWithLock(farmerAcc sdk.AccAddress, amt sdk.Coins, lockDuration time.Duration) {
+	err := suite.keeper.StakeWithLock(suite.ctx, farmerAcc, amt, lockDuration)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestStakeWithLock() {
+	// Locking is disabled when there is no lock multiplier.
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LockMultipliers = []types.LockMultiplier{}
+	suite.keeper.SetParams(suite.ctx, params)
+	err := suite.keeper.StakeWithLock(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 7*24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	suite.setLockMultipliers()
+
+	err = suite.keeper.StakeWithLock(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)), 10*24*time.Hour)
+	suite.Require().Equal(uint64(2), suite.keeper.GetGlobalLockId(suite.ctx))
+
+	lock, found := suite.keeper.GetLock(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(lock.IsQueued())
+	suite.Require().Equal(denom1, lock.StakingCoinDenom)
+	suite.Require().True(decEq(sdk.MustNewDecFromStr("1.5"), lock.Multiplier))
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), lock.Weight))
+	suite.Require().True(suite.ctx.BlockTime().Add(10 * 24 * time.Hour).Equal(lock.EndTime))
+
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)),
+		suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+
+	// Locked coins cannot be unstaked.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.keeper.ProcessQueuedLocks(suite.ctx)
+
+	lock, _ = suite.keeper.GetLock(suite.ctx, 1)
+	suite.Require().False(lock.IsQueued())
+	totalStakings, found := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), totalStakings.Amount))
+	totalStakings, _ = suite.keeper.GetTotalStakings(suite.ctx, denom2)
+	suite.Require().True(intEq(sdk.NewInt(750_000), totalStakings.Amount))
+}
+
+func (suite *KeeperTestSuite) TestStakeWithLockDelayedStakingGasFee() {
+	suite.setLockMultipliers()
+	params := suite.keeper.GetParams(suite.ctx)
+
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 7*24*time.Hour)
+	gasConsumedOneDenom := suite.ctx.GasMeter().GasConsumed()
+	suite.Require().GreaterOrEqual(gasConsumedOneDenom, params.DelayedStakingGasFee)
+
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	suite.StakeWithLock(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)), 7*24*time.Hour)
+	gasConsumedTwoDenoms := suite.ctx.GasMeter().GasConsumed()
+	suite.Require().GreaterOrEqual(gasConsumedTwoDenoms, 2*params.DelayedStakingGasFee)
+}
+
+func (suite *KeeperTestSuite) TestLockBoostedRewards() {
+	suite.setLockMultipliers()
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 900_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.StakeWithLock(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 30*24*time.Hour)
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// The locked staking has twice the weight of the liquid staking.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), suite.AllRewards(suite.addrs[1])))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	suite.Harvest(suite.addrs[1], []string{denom1})
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), balancesAfter.Sub(balancesBefore)))
+	suite.Require().True(suite.AllRewards(suite.addrs[1]).IsZero())
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), suite.AllRewards(suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestProcessMaturedLocks() {
+	suite.setLockMultipliers()
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 900_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.StakeWithLock(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 7*24*time.Hour)
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// A queued lock which matures before the next epoch.
+	suite.StakeWithLock(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 7*24*time.Hour)
+
+	// Nothing happens before the end time.
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(7*24*time.Hour - time.Second))
+	suite.keeper.ProcessMaturedLocks(suite.ctx)
+	_, found := suite.keeper.GetLock(suite.ctx, 1)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	suite.keeper.ProcessMaturedLocks(suite.ctx)
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	// Rewards are withdrawn and the locked coins become a normal staking.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 540_000)), balancesAfter.Sub(balancesBefore)))
+	_, found = suite.keeper.GetLock(suite.ctx, 1)
+	suite.Require().False(found)
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), staking.Amount))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), totalStakings.Amount))
+
+	// The queued lock becomes a queued staking.
+	_, found = suite.keeper.GetLock(suite.ctx, 2)
+	suite.Require().False(found)
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[2])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), queuedStaking.Amount))
+
+	suite.Require().NoError(suite.keeper.ValidateStakingReservedAmount(suite.ctx))
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 450_000)), suite.AllRewards(suite.addrs[1])))
+	suite.Unstake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+}
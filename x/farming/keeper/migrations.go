@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/tendermint/farming/x/farming/legacy/v1"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// The epoch length in number of days is converted to the epoch duration,
+// the params added since version 1 are set to their default values and
+// the plan indexes are built.
+// Plans, stakings and rewards are stored in the same layout in both versions,
+// so they are left as they are.
+//
+// Version 1 records the historical rewards of each staking coin denom only
+// in aggregate, so the rewards allocated before the upgrade cannot be
+// attributed to the plans that allocated them. The plan outstanding rewards
+// and the plan historical rewards are therefore not backfilled, and they
+// track only the rewards allocated after the upgrade. The rewards accumulated
+// before the upgrade are still paid in full when withdrawn, but they are not
+// included in the rewards by plan, and they are never vested since plans in
+// version 1 have no vesting duration.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateParamsV1(ctx); err != nil {
+		return err
+	}
+	m.migrateCurrentEpochDaysV1(ctx)
+	m.migratePlanIndexesV1(ctx)
+	return nil
+}
+
+// migrateParamsV1 converts the NextEpochDays param to the NextEpochDuration
+// param and sets the params that did not exist in version 1.
+func (m Migrator) migrateParamsV1(ctx sdk.Context) error {
+	bz := m.keeper.paramSpace.GetRaw(ctx, v1.KeyNextEpochDays)
+	if bz == nil {
+		return fmt.Errorf("next epoch days param not found")
+	}
+	var nextEpochDays uint32
+	if err := codec.NewLegacyAmino().UnmarshalJSON(bz, &nextEpochDays); err != nil {
+		return err
+	}
+	m.keeper.paramSpace.Set(ctx, types.KeyNextEpochDuration, time.Duration(nextEpochDays)*24*time.Hour)
+
+	for _, p := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyLockMultipliers, types.DefaultLockMultipliers},
+		{types.KeyAllocationPolicy, types.DefaultAllocationPolicy},
+		{types.KeyRewardsStreaming, types.DefaultRewardsStreaming},
+		{types.KeyMaxCatchUpEpochs, types.DefaultMaxCatchUpEpochs},
+		{types.KeyUnstakingPeriod, types.DefaultUnstakingPeriod},
+		{types.KeyAutoCompoundGasPrice, types.DefaultAutoCompoundGasPrice},
+	} {
+		if !m.keeper.paramSpace.Has(ctx, p.key) {
+			m.keeper.paramSpace.Set(ctx, p.key, p.value)
+		}
+	}
+
+	return nil
+}
+
+// migrateCurrentEpochDaysV1 converts the current epoch days to the current
+// epoch duration.
+func (m Migrator) migrateCurrentEpochDaysV1(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	bz := store.Get(v1.CurrentEpochDaysKey)
+	if bz == nil {
+		return
+	}
+	var val gogotypes.UInt32Value
+	m.keeper.cdc.MustUnmarshal(bz, &val)
+	m.keeper.SetCurrentEpochDuration(ctx, time.Duration(val.GetValue())*24*time.Hour)
+	store.Delete(v1.CurrentEpochDaysKey)
+}
+
+// migratePlanIndexesV1 sets the index keys of the plans, which did not exist
+// in version 1.
+func (m Migrator) migratePlanIndexesV1(ctx sdk.Context) {
+	m.keeper.IteratePlans(ctx, func(plan types.PlanI) (stop bool) {
+		m.keeper.setPlanIndexes(ctx, plan)
+		return false
+	})
+}
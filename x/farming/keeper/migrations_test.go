@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
+	v1 "github.com/tendermint/farming/x/farming/legacy/v1"
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+// setV1Store builds the store of version 1 with a plan, stakings and rewards.
+func (suite *KeeperTestSuite) setV1Store() {
+	cdc := suite.app.AppCodec()
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	paramsStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+
+	for _, key := range [][]byte{
+		types.KeyNextEpochDuration, types.KeyLockMultipliers, types.KeyAllocationPolicy,
+		types.KeyRewardsStreaming, types.KeyMaxCatchUpEpochs, types.KeyUnstakingPeriod,
+		types.KeyAutoCompoundGasPrice,
+	} {
+		paramsStore.Delete(key)
+	}
+	bz, err := codec.NewLegacyAmino().MarshalJSON(uint32(7))
+	suite.Require().NoError(err)
+	paramsStore.Set(v1.KeyNextEpochDays, bz)
+	store.Delete(types.CurrentEpochDurationKey)
+	store.Set(v1.CurrentEpochDaysKey, cdc.MustMarshal(&gogotypes.UInt32Value{Value: 3}))
+
+	bz, err = cdc.MarshalInterface(suite.sampleFixedAmtPlans[0])
+	suite.Require().NoError(err)
+	store.Set(v1.GetPlanKey(suite.sampleFixedAmtPlans[0].GetId()), bz)
+
+	staking := types.Staking{Amount: sdk.NewInt(1_000_000), StartingEpoch: 2}
+	store.Set(v1.GetStakingKey(denom1, suite.addrs[0]), cdc.MustMarshal(&staking))
+	store.Set(v1.GetStakingIndexKey(suite.addrs[0], denom1), []byte{})
+	queuedStaking := types.QueuedStaking{Amount: sdk.NewInt(500_000)}
+	store.Set(v1.GetQueuedStakingKey(denom1, suite.addrs[1]), cdc.MustMarshal(&queuedStaking))
+	store.Set(v1.GetQueuedStakingIndexKey(suite.addrs[1], denom1), []byte{})
+	totalStakings := types.TotalStakings{Amount: sdk.NewInt(1_000_000)}
+	store.Set(v1.GetTotalStakingsKey(denom1), cdc.MustMarshal(&totalStakings))
+
+	for epoch, unitRewards := range []string{"0", "0.1", "0.3"} {
+		historical := types.HistoricalRewards{
+			CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr(unitRewards))),
+		}
+		store.Set(v1.GetHistoricalRewardsKey(denom1, uint64(epoch)), cdc.MustMarshal(&historical))
+	}
+	store.Set(v1.GetCurrentEpochKey(denom1), cdc.MustMarshal(&gogotypes.UInt64Value{Value: 3}))
+	outstanding := types.OutstandingRewards{Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 300_000))}
+	store.Set(v1.GetOutstandingRewardsKey(denom1), cdc.MustMarshal(&outstanding))
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, types.RewardsReserveAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.setV1Store()
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	suite.Require().NoError(params.Validate())
+	suite.Require().Equal(7*24*time.Hour, params.NextEpochDuration)
+	suite.Require().Equal(types.DefaultLockMultipliers, params.LockMultipliers)
+	suite.Require().Equal(types.DefaultAllocationPolicy, params.AllocationPolicy)
+	suite.Require().Equal(types.DefaultRewardsStreaming, params.RewardsStreaming)
+	suite.Require().Equal(types.DefaultMaxCatchUpEpochs, params.MaxCatchUpEpochs)
+	suite.Require().Equal(types.DefaultUnstakingPeriod, params.UnstakingPeriod)
+	suite.Require().Equal(types.DefaultAutoCompoundGasPrice, params.AutoCompoundGasPrice)
+	suite.Require().Equal(3*24*time.Hour, suite.keeper.GetCurrentEpochDuration(suite.ctx))
+	suite.Require().False(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Has(v1.CurrentEpochDaysKey))
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, suite.sampleFixedAmtPlans[0].GetId())
+	suite.Require().True(found)
+	suite.Require().Equal(suite.sampleFixedAmtPlans[0].String(), plan.String())
+	suite.Require().Len(suite.keeper.GetPlansByFarmingPool(suite.ctx, plan.GetFarmingPoolAddress()), 1)
+	suite.Require().Len(suite.keeper.GetActivePlans(suite.ctx), 1)
+
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), staking.Amount))
+	suite.Require().Equal(uint64(2), staking.StartingEpoch)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(500_000), queuedStaking.Amount))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+
+	totalStakings, found := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), totalStakings.Amount))
+
+	historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 2)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.3"))), historical.CumulativeUnitRewards))
+	suite.Require().Equal(uint64(3), suite.keeper.GetCurrentEpoch(suite.ctx, denom1))
+	outstanding, found := suite.keeper.GetOutstandingRewards(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 300_000)), outstanding.Rewards))
+
+	// The rewards accumulated in version 1 are preserved, but they are not
+	// attributed to any plan.
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 200_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().Empty(suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], denom1))
+
+	// The rewards allocated after the upgrade are tracked by plan, and the
+	// rewards accumulated in version 1 are paid along with them.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	suite.AdvanceEpoch()
+	total := suite.AllRewards(suite.addrs[0])
+	suite.Require().True(total.IsAllGT(sdk.NewCoins(sdk.NewInt64Coin(denom3, 200_000))))
+	planRewards := sdk.NewCoins()
+	for _, rewards := range suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], denom1) {
+		planRewards = planRewards.Add(rewards.Rewards...)
+	}
+	suite.Require().True(coinsEq(total.Sub(sdk.NewCoins(sdk.NewInt64Coin(denom3, 200_000))), planRewards))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(total, balancesAfter.Sub(balancesBefore)))
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2_NoNextEpochDays() {
+	paramsStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Delete(v1.KeyNextEpochDays)
+
+	suite.Require().EqualError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx), "next epoch days param not found")
+}
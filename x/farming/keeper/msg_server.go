@@ -8,8 +8,10 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tendermint/farming/x/farming/types"
 )
@@ -29,6 +31,11 @@ var _ types.MsgServer = msgServer{}
 // CreateFixedAmountPlan defines a method for creating fixed amount farming plan.
 func (k msgServer) CreateFixedAmountPlan(goCtx context.Context, msg *types.MsgCreateFixedAmountPlan) (*types.MsgCreateFixedAmountPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionPlanCreation); err != nil {
+		return nil, err
+	}
+
 	poolAcc, err := k.DerivePrivatePlanFarmingPoolAcc(ctx, msg.Name)
 	if err != nil {
 		return nil, err
@@ -45,6 +52,10 @@ func (k msgServer) CreateFixedAmountPlan(goCtx context.Context, msg *types.MsgCr
 func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRatioPlan) (*types.MsgCreateRatioPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionPlanCreation); err != nil {
+		return nil, err
+	}
+
 	if !EnableRatioPlan {
 		return nil, types.ErrRatioPlanDisabled
 	}
@@ -58,7 +69,7 @@ func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRa
 		return nil, err
 	}
 
-	plans := k.GetPlans(ctx)
+	plans := k.GetPlansByFarmingPool(ctx, poolAcc)
 	if err := types.ValidateTotalEpochRatio(plans); err != nil {
 		return nil, err
 	}
@@ -66,21 +77,71 @@ func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRa
 	return &types.MsgCreateRatioPlanResponse{}, nil
 }
 
+// CreateDecayingAmountPlan defines a method for creating decaying amount farming plan.
+func (k msgServer) CreateDecayingAmountPlan(goCtx context.Context, msg *types.MsgCreateDecayingAmountPlan) (*types.MsgCreateDecayingAmountPlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionPlanCreation); err != nil {
+		return nil, err
+	}
+
+	poolAcc, err := k.DerivePrivatePlanFarmingPoolAcc(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.CreateDecayingAmountPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDecayingAmountPlanResponse{}, nil
+}
+
 // Stake defines a method for staking coins to the farming plan.
 func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.MsgStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.Keeper.Stake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionStake); err != nil {
 		return nil, err
 	}
 
+	if msg.LockDuration > 0 {
+		if err := k.Keeper.StakeWithLock(ctx, msg.GetFarmer(), msg.StakingCoins, msg.LockDuration); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.Keeper.Stake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgStakeResponse{}, nil
 }
 
+// StakeFor defines a method for staking coins into the farming plan on behalf
+// of another farmer.
+func (k msgServer) StakeFor(goCtx context.Context, msg *types.MsgStakeFor) (*types.MsgStakeForResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionStake); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.StakeFor(ctx, msg.GetPayer(), msg.GetFarmer(), msg.StakingCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStakeForResponse{}, nil
+}
+
 // Unstake defines a method for unstaking coins from the farming plan.
 func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types.MsgUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionUnstake); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.Unstake(ctx, msg.GetFarmer(), msg.UnstakingCoins); err != nil {
 		return nil, err
 	}
@@ -88,10 +149,34 @@ func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types
 	return &types.MsgUnstakeResponse{}, nil
 }
 
+// TransferStaking defines a method for transferring staked and queued coins
+// to another farmer.
+// It is rejected if either staking or unstaking is paused.
+func (k msgServer) TransferStaking(goCtx context.Context, msg *types.MsgTransferStaking) (*types.MsgTransferStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionStake); err != nil {
+		return nil, err
+	}
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionUnstake); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferStaking(ctx, msg.GetFarmer(), msg.GetRecipient(), msg.StakingCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferStakingResponse{}, nil
+}
+
 // Harvest defines a method for claiming farming rewards from the farming plan.
 func (k msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types.MsgHarvestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionHarvest); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.Harvest(ctx, msg.GetFarmer(), msg.StakingCoinDenoms); err != nil {
 		return nil, err
 	}
@@ -109,6 +194,76 @@ func (k msgServer) RemovePlan(goCtx context.Context, msg *types.MsgRemovePlan) (
 	return &types.MsgRemovePlanResponse{}, nil
 }
 
+// ModifyPrivatePlan defines a method for modifying a live private plan.
+func (k msgServer) ModifyPrivatePlan(goCtx context.Context, msg *types.MsgModifyPrivatePlan) (*types.MsgModifyPrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionPlanCreation); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.ModifyPrivatePlan(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgModifyPrivatePlanResponse{}, nil
+}
+
+// SetAutoCompound defines a method for enabling or disabling auto-compounding of rewards.
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Enabled && !k.hasStakingPositions(ctx, msg.GetFarmer()) {
+		return nil, sdkerrors.Wrap(types.ErrStakingNotExists, "farmer has no staking position to auto-compound")
+	}
+
+	k.Keeper.SetAutoCompound(ctx, msg.GetFarmer(), msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyFarmer, msg.Farmer),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+// SetRewardsWithdrawAddress defines a method for setting the address to which the rewards of a farmer are sent.
+func (k msgServer) SetRewardsWithdrawAddress(goCtx context.Context, msg *types.MsgSetRewardsWithdrawAddress) (*types.MsgSetRewardsWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetRewardsWithdrawAddress(ctx, msg.GetFarmer(), msg.GetWithdrawAddress()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRewardsWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyFarmer, msg.Farmer),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.WithdrawAddress),
+		),
+	})
+
+	return &types.MsgSetRewardsWithdrawAddressResponse{}, nil
+}
+
+// ClaimVested defines a method for claiming vested rewards.
+func (k msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertFunctionNotPaused(ctx, types.PausableFunctionHarvest); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.ClaimVested(ctx, msg.GetFarmer()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVestedResponse{}, nil
+}
+
 // AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
 // and shouldn't be used in real world.
 func (k msgServer) AdvanceEpoch(goCtx context.Context, msg *types.MsgAdvanceEpoch) (*types.MsgAdvanceEpochResponse, error) {
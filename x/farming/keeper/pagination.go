@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// keyIterator iterates over keys in ascending order, starting from the
+// first key which is greater than or equal to start.
+// Iteration stops when cb returns true.
+type keyIterator func(start []byte, cb func(key []byte) (stop bool))
+
+// paginateKeys paginates keys produced by iterate the same way as
+// query.Paginate does for a store, calling onResult for each key in the page.
+// Reverse pagination is not supported.
+func paginateKeys(pageReq *query.PageRequest, iterate keyIterator, onResult func(key []byte) error) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	// Total is not counted when paginating by key, as query.Paginate does.
+	if pageReq.Key != nil {
+		countTotal = false
+	}
+
+	var count uint64
+	var nextKey []byte
+	var err error
+	iterate(pageReq.Key, func(key []byte) (stop bool) {
+		count++
+		switch {
+		case count <= pageReq.Offset:
+			return false
+		case count <= pageReq.Offset+limit:
+			if err = onResult(key); err != nil {
+				return true
+			}
+			return false
+		case count == pageReq.Offset+limit+1:
+			nextKey = key
+		}
+		return !countTotal
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+
+	return res, nil
+}
+
+// sortedStringsIterator returns a keyIterator over the given strings, which
+// must be sorted in ascending order.
+func sortedStringsIterator(ss []string) keyIterator {
+	return func(start []byte, cb func(key []byte) (stop bool)) {
+		i := sort.SearchStrings(ss, string(start))
+		for ; i < len(ss); i++ {
+			if cb([]byte(ss[i])) {
+				break
+			}
+		}
+	}
+}
+
+// storeRangeIterator returns a keyIterator over the keys of the store in
+// the range [start, end). A nil end means no upper bound.
+func storeRangeIterator(store prefix.Store, start, end []byte) keyIterator {
+	return func(pageKey []byte, cb func(key []byte) (stop bool)) {
+		from := start
+		if bytes.Compare(pageKey, from) > 0 {
+			from = pageKey
+		}
+		iter := store.Iterator(from, end)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			if cb(append([]byte(nil), iter.Key()...)) {
+				break
+			}
+		}
+	}
+}
+
+// mergedStoresIterator returns a keyIterator over the union of keys in
+// both stores.
+// Keys are copied before advancing the iterators, since an iterator may
+// reuse the buffer of its key.
+func mergedStoresIterator(store1, store2 prefix.Store) keyIterator {
+	return func(start []byte, cb func(key []byte) (stop bool)) {
+		iter1 := store1.Iterator(start, nil)
+		defer iter1.Close()
+		iter2 := store2.Iterator(start, nil)
+		defer iter2.Close()
+
+		for iter1.Valid() || iter2.Valid() {
+			var key []byte
+			switch {
+			case !iter2.Valid():
+				key = append([]byte(nil), iter1.Key()...)
+				iter1.Next()
+			case !iter1.Valid():
+				key = append([]byte(nil), iter2.Key()...)
+				iter2.Next()
+			default:
+				switch cmp := bytes.Compare(iter1.Key(), iter2.Key()); {
+				case cmp < 0:
+					key = append([]byte(nil), iter1.Key()...)
+					iter1.Next()
+				case cmp > 0:
+					key = append([]byte(nil), iter2.Key()...)
+					iter2.Next()
+				default:
+					key = append([]byte(nil), iter1.Key()...)
+					iter1.Next()
+					iter2.Next()
+				}
+			}
+			if cb(key) {
+				break
+			}
+		}
+	}
+}
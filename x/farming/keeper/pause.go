@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// IsFunctionPaused returns whether the function is paused by governance.
+func (k Keeper) IsFunctionPaused(ctx sdk.Context, function types.PausableFunction) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPausedFunctionKey(function))
+}
+
+// SetFunctionPaused pauses or unpauses the function.
+func (k Keeper) SetFunctionPaused(ctx sdk.Context, function types.PausableFunction, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.GetPausedFunctionKey(function), []byte{})
+	} else {
+		store.Delete(types.GetPausedFunctionKey(function))
+	}
+}
+
+// IteratePausedFunctions iterates through all paused functions
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePausedFunctions(ctx sdk.Context, cb func(function types.PausableFunction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PausedFunctionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		function := types.ParsePausedFunctionKey(iter.Key())
+		if cb(function) {
+			break
+		}
+	}
+}
+
+// GetPausedFunctions returns all paused functions.
+func (k Keeper) GetPausedFunctions(ctx sdk.Context) []types.PausableFunction {
+	functions := []types.PausableFunction{}
+	k.IteratePausedFunctions(ctx, func(function types.PausableFunction) (stop bool) {
+		functions = append(functions, function)
+		return false
+	})
+	return functions
+}
+
+// assertFunctionNotPaused returns an error if the function is paused.
+func (k Keeper) assertFunctionNotPaused(ctx sdk.Context, function types.PausableFunction) error {
+	if k.IsFunctionPaused(ctx, function) {
+		return sdkerrors.Wrapf(types.ErrFunctionPaused, "%s", function)
+	}
+	return nil
+}
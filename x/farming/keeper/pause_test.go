@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (suite *KeeperTestSuite) TestPausedFunctions() {
+	suite.Require().Empty(suite.keeper.GetPausedFunctions(suite.ctx))
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionHarvest, true)
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionStake, true)
+	suite.Require().True(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionStake))
+	suite.Require().True(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionHarvest))
+	suite.Require().False(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionUnstake))
+	suite.Require().Equal(
+		[]types.PausableFunction{types.PausableFunctionStake, types.PausableFunctionHarvest},
+		suite.keeper.GetPausedFunctions(suite.ctx))
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionStake, false)
+	suite.Require().False(suite.keeper.IsFunctionPaused(suite.ctx, types.PausableFunctionStake))
+	suite.Require().Equal([]types.PausableFunction{types.PausableFunctionHarvest}, suite.keeper.GetPausedFunctions(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestPauseProposal() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.handleProposal(types.NewPauseProposal("title", "description", []types.PausableFunction{
+		types.PausableFunctionStake,
+		types.PausableFunctionHarvest,
+		types.PausableFunctionPlanCreation,
+		types.PausableFunctionRewardAllocation,
+	}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.msgServer.Stake(ctx, types.NewMsgStake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000))))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	_, err = suite.msgServer.Harvest(ctx, types.NewMsgHarvest(suite.addrs[0], []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	_, err = suite.msgServer.CreateFixedAmountPlan(ctx, types.NewMsgCreateFixedAmountPlan(
+		"plan", suite.addrs[4], sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	plan, err := suite.createPrivateFixedAmountPlan(
+		suite.addrs[3], parseDecCoins("1denom1"), sampleStartTime, sampleEndTime, parseCoins("1000000denom3"))
+	suite.Require().NoError(err)
+	newEndTime := sampleEndTime.AddDate(1, 0, 0)
+	_, err = suite.msgServer.ModifyPrivatePlan(ctx, types.NewMsgModifyPrivatePlan(
+		suite.addrs[3], plan.GetId(), nil, &newEndTime, nil, sdk.Dec{}, sdk.Dec{}, 0))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	// No rewards are allocated while reward allocation is paused.
+	rewards := suite.AllRewards(suite.addrs[0])
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(rewards, suite.AllRewards(suite.addrs[0])))
+
+	// Unstaking is still allowed, so funds can always be withdrawn.
+	_, err = suite.msgServer.Unstake(ctx, types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000))))
+	suite.Require().NoError(err)
+
+	suite.handleProposal(types.NewUnpauseProposal("title", "description", []types.PausableFunction{
+		types.PausableFunctionStake,
+		types.PausableFunctionHarvest,
+		types.PausableFunctionPlanCreation,
+		types.PausableFunctionRewardAllocation,
+	}))
+	suite.Require().Empty(suite.keeper.GetPausedFunctions(suite.ctx))
+
+	_, err = suite.msgServer.Stake(ctx, types.NewMsgStake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000))))
+	suite.Require().NoError(err)
+
+	suite.AdvanceEpoch()
+	suite.Require().False(suite.AllRewards(suite.addrs[0]).IsZero())
+
+	_, err = suite.msgServer.Harvest(ctx, types.NewMsgHarvest(suite.addrs[0], []string{denom1}))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPauseProposal_TransferStaking() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msg := types.NewMsgTransferStaking(suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+
+	for _, function := range []types.PausableFunction{types.PausableFunctionStake, types.PausableFunctionUnstake} {
+		suite.handleProposal(types.NewPauseProposal("title", "description", []types.PausableFunction{function}))
+		_, err := suite.msgServer.TransferStaking(ctx, msg)
+		suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+		suite.handleProposal(types.NewUnpauseProposal("title", "description", []types.PausableFunction{function}))
+	}
+
+	_, err := suite.msgServer.TransferStaking(ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPauseProposal_Unstake() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.handleProposal(types.NewPauseProposal("title", "description", []types.PausableFunction{types.PausableFunctionUnstake}))
+
+	_, err := suite.msgServer.Unstake(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000))))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+}
+
+// pauseHarvest pauses harvesting with two epochs of rewards accrued by the
+// farmer's staking of denom1.
+func (suite *KeeperTestSuite) pauseHarvest(farmerAcc sdk.AccAddress) {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(farmerAcc, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), suite.AllRewards(farmerAcc)))
+
+	suite.handleProposal(types.NewPauseProposal("title", "description", []types.PausableFunction{types.PausableFunctionHarvest}))
+}
+
+// requirePendingRewards checks that the farmer's rewards were kept as
+// pending rewards while harvesting is paused, and that they are sent on
+// harvest after harvesting is unpaused.
+func (suite *KeeperTestSuite) requirePendingRewards(farmerAcc sdk.AccAddress, balanceBefore sdk.Coin) {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000))
+
+	suite.Require().True(balanceBefore.IsEqual(suite.app.BankKeeper.GetBalance(suite.ctx, farmerAcc, denom3)))
+	pending, found := suite.keeper.GetPendingRewards(suite.ctx, farmerAcc)
+	suite.Require().True(found)
+	suite.Require().True(coinsEq(rewards, pending.Rewards))
+	suite.Require().NoError(suite.keeper.ValidateRemainingRewardsAmount(suite.ctx))
+
+	_, err := suite.msgServer.Harvest(sdk.WrapSDKContext(suite.ctx), types.NewMsgHarvest(farmerAcc, []string{denom1}))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	suite.handleProposal(types.NewUnpauseProposal("title", "description", []types.PausableFunction{types.PausableFunctionHarvest}))
+
+	_, err = suite.msgServer.Harvest(sdk.WrapSDKContext(suite.ctx), types.NewMsgHarvest(farmerAcc, []string{denom1}))
+	suite.Require().NoError(err)
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, farmerAcc, denom3)
+	suite.Require().True(coinsEq(rewards, sdk.NewCoins(balanceAfter.Sub(balanceBefore))))
+	_, found = suite.keeper.GetPendingRewards(suite.ctx, farmerAcc)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPendingRewards_Unstake() {
+	suite.pauseHarvest(suite.addrs[0])
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	// The farmer has no staking left after unstaking.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.requirePendingRewards(suite.addrs[0], balanceBefore)
+}
+
+func (suite *KeeperTestSuite) TestPendingRewards_TransferStaking() {
+	suite.pauseHarvest(suite.addrs[0])
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	err := suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.Require().NoError(err)
+
+	suite.requirePendingRewards(suite.addrs[0], balanceBefore)
+}
+
+func (suite *KeeperTestSuite) TestPendingRewards_ProcessQueuedCoins() {
+	suite.pauseHarvest(suite.addrs[0])
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	suite.requirePendingRewards(suite.addrs[0], balanceBefore)
+}
+
+func (suite *KeeperTestSuite) TestPendingRewards_ProcessMaturedLocks() {
+	suite.setLockMultipliers()
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.StakeWithLock(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), 30*24*time.Hour)
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), suite.AllRewards(suite.addrs[0])))
+
+	suite.handleProposal(types.NewPauseProposal("title", "description", []types.PausableFunction{types.PausableFunctionHarvest}))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * 24 * time.Hour))
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom3)
+	suite.keeper.ProcessMaturedLocks(suite.ctx)
+	_, found := suite.keeper.GetLock(suite.ctx, 1)
+	suite.Require().False(found)
+
+	suite.requirePendingRewards(suite.addrs[0], balanceBefore)
+}
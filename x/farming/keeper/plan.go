@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 
 	gogotypes "github.com/gogo/protobuf/types"
@@ -32,7 +33,29 @@ func (k Keeper) GetPlans(ctx sdk.Context) (plans []types.PlanI) {
 	return plans
 }
 
+// GetActivePlans returns all active(non-terminated) plans in the store.
+func (k Keeper) GetActivePlans(ctx sdk.Context) (plans []types.PlanI) {
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
+		plans = append(plans, plan)
+		return false
+	})
+
+	return plans
+}
+
+// GetPlansByFarmingPool returns all plans of a farming pool.
+func (k Keeper) GetPlansByFarmingPool(ctx sdk.Context, farmingPoolAcc sdk.AccAddress) (plans []types.PlanI) {
+	k.IteratePlansByFarmingPool(ctx, farmingPoolAcc, func(plan types.PlanI) (stop bool) {
+		plans = append(plans, plan)
+		return false
+	})
+
+	return plans
+}
+
 // SetPlan sets a plan for a given plan id.
+// It also updates the plan's index keys, and keeps the plan's vesting
+// duration if it has one.
 func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
 	id := plan.GetId()
 	store := ctx.KVStore(k.storeKey)
@@ -42,7 +65,14 @@ func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
 		panic(err)
 	}
 
+	if oldPlan, found := k.GetPlan(ctx, id); found {
+		k.deletePlanIndexes(ctx, oldPlan)
+	}
 	store.Set(types.GetPlanKey(id), bz)
+	k.setPlanIndexes(ctx, plan)
+	if plan.GetVestingDuration() > 0 {
+		k.SetPlanVestingDuration(ctx, id, plan.GetVestingDuration())
+	}
 }
 
 // DeletePlan deletes a plan from the store.
@@ -50,9 +80,43 @@ func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
 func (k Keeper) DeletePlan(ctx sdk.Context, plan types.PlanI) {
 	id := plan.GetId()
 	store := ctx.KVStore(k.storeKey)
+	if oldPlan, found := k.GetPlan(ctx, id); found {
+		k.deletePlanIndexes(ctx, oldPlan)
+	}
 	store.Delete(types.GetPlanKey(id))
 }
 
+// setPlanIndexes sets the index keys of a plan.
+func (k Keeper) setPlanIndexes(ctx sdk.Context, plan types.PlanI) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range planIndexKeys(plan) {
+		store.Set(key, []byte{})
+	}
+}
+
+// deletePlanIndexes deletes the index keys of a plan.
+func (k Keeper) deletePlanIndexes(ctx sdk.Context, plan types.PlanI) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range planIndexKeys(plan) {
+		store.Delete(key)
+	}
+}
+
+// planIndexKeys returns the index keys of a plan.
+func planIndexKeys(plan types.PlanI) [][]byte {
+	id := plan.GetId()
+	keys := [][]byte{types.GetPlanByFarmingPoolIndexKey(plan.GetFarmingPoolAddress(), id)}
+	for _, weight := range plan.GetStakingCoinWeights() {
+		keys = append(keys, types.GetPlanByStakingCoinDenomIndexKey(weight.Denom, id))
+	}
+	if plan.IsTerminated() {
+		keys = append(keys, types.GetTerminatedPlanIndexKey(id))
+	} else {
+		keys = append(keys, types.GetActivePlanIndexKey(id))
+	}
+	return keys
+}
+
 // IteratePlans iterates over all the stored plans and performs a callback function.
 // Stops iteration when callback returns true.
 func (k Keeper) IteratePlans(ctx sdk.Context, cb func(plan types.PlanI) (stop bool)) {
@@ -69,6 +133,48 @@ func (k Keeper) IteratePlans(ctx sdk.Context, cb func(plan types.PlanI) (stop bo
 	}
 }
 
+// IterateActivePlans iterates over all the active(non-terminated) plans and
+// performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateActivePlans(ctx sdk.Context, cb func(plan types.PlanI) (stop bool)) {
+	k.iteratePlanIndex(ctx, types.ActivePlanIndexKeyPrefix, cb)
+}
+
+// IteratePlansByFarmingPool iterates over all the plans of a farming pool
+// and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IteratePlansByFarmingPool(ctx sdk.Context, farmingPoolAcc sdk.AccAddress, cb func(plan types.PlanI) (stop bool)) {
+	k.iteratePlanIndex(ctx, types.GetPlansByFarmingPoolPrefix(farmingPoolAcc), cb)
+}
+
+// IteratePlansByStakingCoinDenom iterates over all the plans that have
+// the staking coin denom in their staking coin weights and performs
+// a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IteratePlansByStakingCoinDenom(ctx sdk.Context, stakingCoinDenom string, cb func(plan types.PlanI) (stop bool)) {
+	k.iteratePlanIndex(ctx, types.GetPlansByStakingCoinDenomPrefix(stakingCoinDenom), cb)
+}
+
+// iteratePlanIndex iterates over the plans indexed under the key prefix
+// in ascending order of plan id and performs a callback function.
+func (k Keeper) iteratePlanIndex(ctx sdk.Context, keyPrefix []byte, cb func(plan types.PlanI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		planId := types.ParsePlanIndexKey(iterator.Key())
+		plan, found := k.GetPlan(ctx, planId)
+		if !found { // sanity check
+			panic(fmt.Sprintf("plan %d not found", planId))
+		}
+
+		if cb(plan) {
+			break
+		}
+	}
+}
+
 // GetNextPlanIdWithUpdate returns and increments the global Plan ID counter.
 // If the global plan number is not set, it initializes it with value 0.
 func (k Keeper) GetNextPlanIdWithUpdate(ctx sdk.Context) uint64 {
@@ -114,8 +220,8 @@ func (k Keeper) decodePlan(bz []byte) types.PlanI {
 // private plans.
 func (k Keeper) GetNumActivePrivatePlans(ctx sdk.Context) int {
 	num := 0
-	k.IteratePlans(ctx, func(plan types.PlanI) (stop bool) {
-		if plan.GetType() == types.PlanTypePrivate && !plan.IsTerminated() {
+	k.IterateActivePlans(ctx, func(plan types.PlanI) (stop bool) {
+		if plan.GetType() == types.PlanTypePrivate {
 			num++
 		}
 		return false
@@ -195,10 +301,14 @@ func (k Keeper) CreateFixedAmountPlan(ctx sdk.Context, msg *types.MsgCreateFixed
 		msg.StartTime,
 		msg.EndTime,
 	)
+	_ = basePlan.SetVestingDuration(msg.VestingDuration)
+	_ = basePlan.SetMaxStakePerFarmer(normalizeStakeCap(msg.MaxStakePerFarmer))
+	_ = basePlan.SetMaxTotalStake(normalizeStakeCap(msg.MaxTotalStake))
 
 	fixedPlan := types.NewFixedAmountPlan(basePlan, msg.EpochAmount)
 
 	k.SetPlan(ctx, fixedPlan)
+	k.initCappedStakes(ctx, fixedPlan, true)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -265,10 +375,14 @@ func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan,
 		msg.StartTime,
 		msg.EndTime,
 	)
+	_ = basePlan.SetVestingDuration(msg.VestingDuration)
+	_ = basePlan.SetMaxStakePerFarmer(normalizeStakeCap(msg.MaxStakePerFarmer))
+	_ = basePlan.SetMaxTotalStake(normalizeStakeCap(msg.MaxTotalStake))
 
 	ratioPlan := types.NewRatioPlan(basePlan, msg.EpochRatio)
 
 	k.SetPlan(ctx, ratioPlan)
+	k.initCappedStakes(ctx, ratioPlan, true)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -285,8 +399,97 @@ func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan,
 	return ratioPlan, nil
 }
 
+// CreateDecayingAmountPlan sets decaying amount plan.
+func (k Keeper) CreateDecayingAmountPlan(ctx sdk.Context, msg *types.MsgCreateDecayingAmountPlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	if !ctx.BlockTime().Before(msg.EndTime) { // EndTime <= BlockTime
+		return nil, sdkerrors.Wrap(types.ErrInvalidPlanEndTime, "end time has already passed")
+	}
+
+	for _, coin := range msg.StakingCoinWeights {
+		if k.bankKeeper.GetSupply(ctx, coin.Denom).Amount.IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStakingCoinWeights, "denom %s has no supply", coin.Denom)
+		}
+	}
+	for _, coin := range msg.EpochAmount {
+		if k.bankKeeper.GetSupply(ctx, coin.Denom).Amount.IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidEpochAmount, "denom %s has no supply", coin.Denom)
+		}
+	}
+
+	var maxNumDenoms int
+	switch typ {
+	case types.PlanTypePrivate:
+		maxNumDenoms = types.PrivatePlanMaxNumDenoms
+	case types.PlanTypePublic:
+		maxNumDenoms = types.PublicPlanMaxNumDenoms
+	}
+	if len(msg.StakingCoinWeights) > maxNumDenoms {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNumMaxDenomsLimit,
+			"number of denoms in staking coin weights is %d, which exceeds the limit %d",
+			len(msg.StakingCoinWeights), maxNumDenoms)
+	}
+	if len(msg.EpochAmount) > maxNumDenoms {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNumMaxDenomsLimit,
+			"number of denoms in epoch amount is %d, which exceeds the limit %d",
+			len(msg.EpochAmount), maxNumDenoms)
+	}
+
+	params := k.GetParams(ctx)
+
+	if typ == types.PlanTypePrivate {
+		if uint32(k.GetNumActivePrivatePlans(ctx)) >= params.MaxNumPrivatePlans {
+			return nil, types.ErrNumPrivatePlansLimit
+		}
+
+		feeCollectorAcc, _ := sdk.AccAddressFromBech32(params.FarmingFeeCollector) // Already validated
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), feeCollectorAcc, params.PrivatePlanCreationFee); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to pay private plan creation fee")
+		}
+	}
+
+	nextId := k.GetNextPlanIdWithUpdate(ctx)
+	basePlan := types.NewBasePlan(
+		nextId,
+		msg.Name,
+		typ,
+		farmingPoolAcc.String(),
+		terminationAcc.String(),
+		msg.StakingCoinWeights,
+		msg.StartTime,
+		msg.EndTime,
+	)
+	_ = basePlan.SetVestingDuration(msg.VestingDuration)
+	_ = basePlan.SetMaxStakePerFarmer(normalizeStakeCap(msg.MaxStakePerFarmer))
+	_ = basePlan.SetMaxTotalStake(normalizeStakeCap(msg.MaxTotalStake))
+
+	decayingPlan := types.NewDecayingAmountPlan(basePlan, msg.EpochAmount, msg.DecayFactor, msg.DecayPeriod)
+
+	k.SetPlan(ctx, decayingPlan)
+	k.initCappedStakes(ctx, decayingPlan, true)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDecayingAmountPlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, farmingPoolAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochAmount, msg.EpochAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayFactor, msg.DecayFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayPeriod, strconv.FormatUint(uint64(msg.DecayPeriod), 10)),
+		),
+	})
+
+	return decayingPlan, nil
+}
+
 // TerminatePlan marks the plan as terminated.
 // It moves the plan under different store key, which is for terminated plans.
+// Stakes are no longer counted toward the plan if it has stake caps, so the
+// capped stakes are removed here rather than when a private plan is removed.
 func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 	if plan.GetFarmingPoolAddress().String() != plan.GetTerminationAddress().String() {
 		balances := k.bankKeeper.SpendableCoins(ctx, plan.GetFarmingPoolAddress())
@@ -297,6 +500,10 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 		}
 	}
 
+	for _, weight := range plan.GetStakingCoinWeights() {
+		k.removeCappedStakes(ctx, weight.Denom, plan.GetId())
+	}
+
 	switch plan.GetType() {
 	case types.PlanTypePrivate:
 		// For private plans, mark the plan as terminated so that it can be removed
@@ -315,6 +522,8 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 		),
 	})
 
+	k.AfterPlanTerminated(ctx, plan)
+
 	return nil
 }
 
@@ -359,6 +568,139 @@ func (k Keeper) RemovePlan(ctx sdk.Context, creator sdk.AccAddress, planId uint6
 	return nil
 }
 
+// ModifyPrivatePlan modifies a live private plan with the given message.
+// Only the plan creator can modify the plan, and the end time of the plan
+// can only be extended.
+func (k Keeper) ModifyPrivatePlan(ctx sdk.Context, msg *types.MsgModifyPrivatePlan) (types.PlanI, error) {
+	plan, found := k.GetPlan(ctx, msg.PlanId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "plan %d not found", msg.PlanId)
+	}
+
+	if plan.GetType() != types.PlanTypePrivate {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan %d is not a private plan", msg.PlanId)
+	}
+
+	if !plan.GetTerminationAddress().Equals(msg.GetCreator()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the plan creator can modify the plan")
+	}
+
+	if plan.IsTerminated() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan %d is already terminated", msg.PlanId)
+	}
+
+	for _, coin := range msg.StakingCoinWeights {
+		if k.bankKeeper.GetSupply(ctx, coin.Denom).Amount.IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStakingCoinWeights, "denom %s has no supply", coin.Denom)
+		}
+	}
+	for _, coin := range msg.EpochAmount {
+		if k.bankKeeper.GetSupply(ctx, coin.Denom).Amount.IsZero() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidEpochAmount, "denom %s has no supply", coin.Denom)
+		}
+	}
+
+	if len(msg.StakingCoinWeights) > types.PrivatePlanMaxNumDenoms {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNumMaxDenomsLimit,
+			"number of denoms in staking coin weights is %d, which exceeds the limit %d",
+			len(msg.StakingCoinWeights), types.PrivatePlanMaxNumDenoms)
+	}
+	if len(msg.EpochAmount) > types.PrivatePlanMaxNumDenoms {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNumMaxDenomsLimit,
+			"number of denoms in epoch amount is %d, which exceeds the limit %d",
+			len(msg.EpochAmount), types.PrivatePlanMaxNumDenoms)
+	}
+
+	oldStakingCoinWeights := plan.GetStakingCoinWeights()
+	if msg.StakingCoinWeights != nil {
+		if err := plan.SetStakingCoinWeights(msg.StakingCoinWeights); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.EndTime != nil {
+		if !msg.EndTime.After(plan.GetEndTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPlanEndTime,
+				"end time %s must be after the current end time %s", msg.EndTime, plan.GetEndTime())
+		}
+		if err := plan.SetEndTime(*msg.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
+	plan, err := modifyPlanDistribution(plan, msg.ModifyPlanRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	// Other plans can share the farming pool, so the total epoch ratio of
+	// the plans with the modified plan must still be valid.
+	plans := k.GetPlansByFarmingPool(ctx, plan.GetFarmingPoolAddress())
+	for i, p := range plans {
+		if p.GetId() == plan.GetId() {
+			plans[i] = plan
+		}
+	}
+	if err := types.ValidateTotalEpochRatio(plans); err != nil {
+		return nil, err
+	}
+
+	k.SetPlan(ctx, plan)
+	k.removeCappedStakesOfRemovedDenoms(ctx, plan, oldStakingCoinWeights)
+	k.initCappedStakes(ctx, plan, false)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeModifyPrivatePlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(plan.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, plan.GetFarmingPoolAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyTerminationAddress, plan.GetTerminationAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, plan.GetEndTime().String()),
+		),
+	})
+
+	return plan, nil
+}
+
+// modifyPlanDistribution changes the plan to the plan type that the request
+// is for, with the distribution parameters in the request.
+// The plan is returned as is if the request has no distribution parameters.
+func modifyPlanDistribution(plan types.PlanI, req types.ModifyPlanRequest) (types.PlanI, error) {
+	switch {
+	case req.IsForFixedAmountPlan():
+		return types.NewFixedAmountPlan(plan.GetBasePlan(), req.EpochAmount), nil
+	case req.IsForDecayingAmountPlan():
+		// The decay schedule restarts from the new epoch amount, unless the
+		// plan was already a decaying amount plan with the same schedule.
+		var elapsedEpochs uint64
+		if decayingPlan, ok := plan.(*types.DecayingAmountPlan); ok &&
+			decayingPlan.EpochAmount.IsEqual(req.EpochAmount) &&
+			decayingPlan.DecayFactor.Equal(req.DecayFactor) &&
+			decayingPlan.DecayPeriod == req.DecayPeriod {
+			elapsedEpochs = decayingPlan.ElapsedEpochs
+		}
+		decayingPlan := types.NewDecayingAmountPlan(plan.GetBasePlan(), req.EpochAmount, req.DecayFactor, req.DecayPeriod)
+		decayingPlan.ElapsedEpochs = elapsedEpochs
+		return decayingPlan, nil
+	case req.IsForRatioPlan():
+		if !EnableRatioPlan {
+			return nil, types.ErrRatioPlanDisabled
+		}
+		return types.NewRatioPlan(plan.GetBasePlan(), req.EpochRatio), nil
+	}
+	return plan, nil
+}
+
+// normalizeStakeCap returns zero for an unset stake cap, which means no limit.
+func normalizeStakeCap(amt sdk.Int) sdk.Int {
+	if amt.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return amt
+}
+
 // DerivePrivatePlanFarmingPoolAcc returns a unique account address
 // of a farming pool for a private plan.
 func (k Keeper) DerivePrivatePlanFarmingPoolAcc(ctx sdk.Context, name string) (sdk.AccAddress, error) {
@@ -289,4 +289,193 @@ func (suite *KeeperTestSuite) TestRatioPlanDefaultDisabled() {
 	suite.Require().NoError(proposal.ValidateBasic())
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrRatioPlanDisabled)
+
+	// Modifying a private plan to ratio plan will fail as well.
+	plan, err = suite.createPrivateFixedAmountPlan(
+		suite.addrs[3], parseDecCoins("1denom1"), sampleStartTime, sampleEndTime, parseCoins("1000000stake"))
+	suite.Require().NoError(err)
+	modifyMsg := types.NewMsgModifyPrivatePlan(
+		suite.addrs[3], plan.GetId(), nil, nil, nil, parseDec("0.01"), sdk.Dec{}, 0)
+	suite.Require().NoError(modifyMsg.ValidateBasic())
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), modifyMsg)
+	suite.Require().ErrorIs(err, types.ErrRatioPlanDisabled)
+}
+
+func (suite *KeeperTestSuite) TestModifyPrivatePlan() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+
+	plan, err := suite.createPrivateFixedAmountPlan(
+		suite.addrs[4], parseDecCoins("1denom1"),
+		types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2023-01-01T00:00:00Z"),
+		parseCoins("1000000denom3"))
+	suite.Require().NoError(err)
+
+	newEndTime := types.ParseTime("2024-01-01T00:00:00Z")
+	shorterEndTime := types.ParseTime("2022-06-01T00:00:00Z")
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgModifyPrivatePlan
+		expectedErr string
+	}{
+		{
+			"not found",
+			types.NewMsgModifyPrivatePlan(suite.addrs[4], 10, nil, &newEndTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+			"plan 10 not found: not found",
+		},
+		{
+			"unauthorized",
+			types.NewMsgModifyPrivatePlan(suite.addrs[0], plan.GetId(), nil, &newEndTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+			"only the plan creator can modify the plan: unauthorized",
+		},
+		{
+			"shorter end time",
+			types.NewMsgModifyPrivatePlan(suite.addrs[4], plan.GetId(), nil, &shorterEndTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+			"end time 2022-06-01 00:00:00 +0000 UTC must be after the current end time 2023-01-01 00:00:00 +0000 UTC: invalid plan end time",
+		},
+		{
+			"no supply",
+			types.NewMsgModifyPrivatePlan(suite.addrs[4], plan.GetId(), nil, nil, parseCoins("1000000denom4"), sdk.Dec{}, sdk.Dec{}, 0),
+			"denom denom4 has no supply: invalid epoch amount",
+		},
+	} {
+		suite.Run(tc.name, func() {
+			suite.Require().NoError(tc.msg.ValidateBasic())
+			_, err := suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			suite.Require().EqualError(err, tc.expectedErr)
+		})
+	}
+
+	// Extend the end time and change the weights and the epoch amount.
+	msg := types.NewMsgModifyPrivatePlan(
+		suite.addrs[4], plan.GetId(), parseDecCoins("0.5denom1,0.5denom2"), &newEndTime,
+		parseCoins("2000000denom3"), sdk.Dec{}, sdk.Dec{}, 0)
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().Equal(newEndTime, plan.GetEndTime())
+	suite.Require().True(decCoinsEq(parseDecCoins("0.5denom1,0.5denom2"), plan.GetStakingCoinWeights()))
+	fixedPlan, ok := plan.(*types.FixedAmountPlan)
+	suite.Require().True(ok)
+	suite.Require().True(coinsEq(parseCoins("2000000denom3"), fixedPlan.EpochAmount))
+
+	// Change the plan to a decaying amount plan.
+	msg = types.NewMsgModifyPrivatePlan(
+		suite.addrs[4], plan.GetId(), nil, nil,
+		parseCoins("2000000denom3"), sdk.Dec{}, parseDec("0.5"), 2)
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().Equal(newEndTime, plan.GetEndTime())
+	_, ok = plan.(*types.DecayingAmountPlan)
+	suite.Require().True(ok)
+
+	// A terminated plan cannot be modified.
+	suite.ctx = suite.ctx.WithBlockTime(newEndTime)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	endTime := types.ParseTime("2025-01-01T00:00:00Z")
+	msg = types.NewMsgModifyPrivatePlan(suite.addrs[4], plan.GetId(), nil, &endTime, nil, sdk.Dec{}, sdk.Dec{}, 0)
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().EqualError(err, fmt.Sprintf("plan %d is already terminated: invalid request", plan.GetId()))
+}
+
+func (suite *KeeperTestSuite) TestModifyPrivatePlanTotalEpochRatio() {
+	plan, err := suite.createPrivateRatioPlan(
+		suite.addrs[4], parseDecCoins("1denom1"), sampleStartTime, sampleEndTime, parseDec("0.3"))
+	suite.Require().NoError(err)
+
+	// A public plan shares the farming pool of the private plan.
+	_, err = suite.createPublicRatioPlan(
+		plan.GetFarmingPoolAddress(), suite.addrs[4], parseDecCoins("1denom1"),
+		sampleStartTime, sampleEndTime, parseDec("0.6"))
+	suite.Require().NoError(err)
+
+	// The total epoch ratio of the farming pool would exceed 1.
+	msg := types.NewMsgModifyPrivatePlan(
+		suite.addrs[4], plan.GetId(), nil, nil, nil, parseDec("0.5"), sdk.Dec{}, 0)
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidTotalEpochRatio)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().True(decEq(parseDec("0.3"), plan.(*types.RatioPlan).EpochRatio))
+
+	msg = types.NewMsgModifyPrivatePlan(
+		suite.addrs[4], plan.GetId(), nil, nil, nil, parseDec("0.4"), sdk.Dec{}, 0)
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().True(decEq(parseDec("0.4"), plan.(*types.RatioPlan).EpochRatio))
+}
+
+func (suite *KeeperTestSuite) TestModifyPrivatePlanPublicPlan() {
+	plan, err := suite.createPublicFixedAmountPlan(
+		suite.addrs[4], suite.addrs[4], parseDecCoins("1denom1"),
+		sampleStartTime, sampleEndTime, parseCoins("1000000denom3"))
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgModifyPrivatePlan(
+		suite.addrs[4], plan.GetId(), parseDecCoins("1denom2"), nil, nil, sdk.Dec{}, sdk.Dec{}, 0)
+	_, err = suite.msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().EqualError(err, fmt.Sprintf("plan %d is not a private plan: invalid request", plan.GetId()))
+}
+
+func (suite *KeeperTestSuite) TestPlanIndexes() {
+	planIds := func(iterate func(cb func(plan types.PlanI) (stop bool))) (ids []uint64) {
+		iterate(func(plan types.PlanI) (stop bool) {
+			ids = append(ids, plan.GetId())
+			return false
+		})
+		return
+	}
+	byFarmingPool := func(farmingPoolAcc sdk.AccAddress) []uint64 {
+		return planIds(func(cb func(plan types.PlanI) (stop bool)) {
+			suite.keeper.IteratePlansByFarmingPool(suite.ctx, farmingPoolAcc, cb)
+		})
+	}
+	byStakingCoinDenom := func(stakingCoinDenom string) []uint64 {
+		return planIds(func(cb func(plan types.PlanI) (stop bool)) {
+			suite.keeper.IteratePlansByStakingCoinDenom(suite.ctx, stakingCoinDenom, cb)
+		})
+	}
+	active := func() []uint64 {
+		return planIds(func(cb func(plan types.PlanI) (stop bool)) {
+			suite.keeper.IterateActivePlans(suite.ctx, cb)
+		})
+	}
+
+	for _, plan := range suite.sampleFixedAmtPlans {
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+	suite.Require().Equal([]uint64{1}, byFarmingPool(suite.addrs[4]))
+	suite.Require().Equal([]uint64{2}, byFarmingPool(suite.addrs[5]))
+	suite.Require().Equal([]uint64{1, 2}, byStakingCoinDenom(denom1))
+	suite.Require().Equal([]uint64{1}, byStakingCoinDenom(denom2))
+	suite.Require().Equal([]uint64{1, 2}, active())
+
+	// Changing the staking coin weights of a plan updates the indexes.
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().NoError(plan.SetStakingCoinWeights(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom2, sdk.OneDec()))))
+	suite.keeper.SetPlan(suite.ctx, plan)
+	suite.Require().Equal([]uint64{2}, byStakingCoinDenom(denom1))
+	suite.Require().Equal([]uint64{1}, byStakingCoinDenom(denom2))
+
+	// Terminating a plan moves the plan to the terminated plans.
+	suite.Require().NoError(plan.SetTerminated(true))
+	suite.keeper.SetPlan(suite.ctx, plan)
+	suite.Require().Equal([]uint64{2}, active())
+	suite.Require().Len(suite.keeper.GetActivePlans(suite.ctx), 1)
+	suite.Require().Equal([]uint64{1}, byFarmingPool(suite.addrs[4]))
+
+	// Deleting a plan deletes its indexes.
+	suite.keeper.DeletePlan(suite.ctx, plan)
+	suite.Require().Empty(byFarmingPool(suite.addrs[4]))
+	suite.Require().Empty(byStakingCoinDenom(denom2))
+	suite.Require().Empty(suite.keeper.GetPlansByFarmingPool(suite.ctx, suite.addrs[4]))
+	suite.Require().Len(suite.keeper.GetPlansByFarmingPool(suite.ctx, suite.addrs[5]), 1)
 }
@@ -35,6 +35,38 @@ func HandlePublicPlanProposal(ctx sdk.Context, k Keeper, proposal *types.PublicP
 	return nil
 }
 
+// HandlePauseProposal is a handler for executing a pause proposal.
+func HandlePauseProposal(ctx sdk.Context, k Keeper, proposal *types.PauseProposal) error {
+	for _, function := range proposal.Functions {
+		k.SetFunctionPaused(ctx, function, true)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePauseFunction,
+				sdk.NewAttribute(types.AttributeKeyFunction, function.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// HandleUnpauseProposal is a handler for executing an unpause proposal.
+func HandleUnpauseProposal(ctx sdk.Context, k Keeper, proposal *types.UnpauseProposal) error {
+	for _, function := range proposal.Functions {
+		k.SetFunctionPaused(ctx, function, false)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnpauseFunction,
+				sdk.NewAttribute(types.AttributeKeyFunction, function.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
 // AddPublicPlanProposal adds a new public plan once the governance proposal is passed.
 func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlanRequest) error {
 	for _, p := range proposals {
@@ -57,6 +89,9 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlan
 				p.GetEndTime(),
 				p.EpochAmount,
 			)
+			msg.VestingDuration = p.VestingDuration
+			msg.MaxStakePerFarmer = p.MaxStakePerFarmer
+			msg.MaxTotalStake = p.MaxTotalStake
 
 			plan, err := k.CreateFixedAmountPlan(ctx, msg, farmingPoolAcc, terminationAcc, types.PlanTypePublic)
 			if err != nil {
@@ -65,6 +100,28 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlan
 
 			logger := k.Logger(ctx)
 			logger.Info("created public fixed amount plan", "fixed_amount_plan", plan)
+		} else if p.IsForDecayingAmountPlan() {
+			msg := types.NewMsgCreateDecayingAmountPlan(
+				p.GetName(),
+				farmingPoolAcc,
+				p.GetStakingCoinWeights(),
+				p.GetStartTime(),
+				p.GetEndTime(),
+				p.EpochAmount,
+				p.DecayFactor,
+				p.DecayPeriod,
+			)
+			msg.VestingDuration = p.VestingDuration
+			msg.MaxStakePerFarmer = p.MaxStakePerFarmer
+			msg.MaxTotalStake = p.MaxTotalStake
+
+			plan, err := k.CreateDecayingAmountPlan(ctx, msg, farmingPoolAcc, terminationAcc, types.PlanTypePublic)
+			if err != nil {
+				return err
+			}
+
+			logger := k.Logger(ctx)
+			logger.Info("created public decaying amount plan", "decaying_amount_plan", plan)
 		} else {
 			if !EnableRatioPlan {
 				return types.ErrRatioPlanDisabled
@@ -78,6 +135,9 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlan
 				p.GetEndTime(),
 				p.EpochRatio,
 			)
+			msg.VestingDuration = p.VestingDuration
+			msg.MaxStakePerFarmer = p.MaxStakePerFarmer
+			msg.MaxTotalStake = p.MaxTotalStake
 
 			plan, err := k.CreateRatioPlan(ctx, msg, farmingPoolAcc, terminationAcc, types.PlanTypePublic)
 			if err != nil {
@@ -130,6 +190,7 @@ func (k Keeper) ModifyPublicPlanProposal(ctx sdk.Context, proposals []types.Modi
 			}
 		}
 
+		oldStakingCoinWeights := plan.GetStakingCoinWeights()
 		if p.GetStakingCoinWeights() != nil {
 			if err := plan.SetStakingCoinWeights(p.GetStakingCoinWeights()); err != nil {
 				return err
@@ -148,26 +209,17 @@ func (k Keeper) ModifyPublicPlanProposal(ctx sdk.Context, proposals []types.Modi
 			}
 		}
 
-		if p.IsForFixedAmountPlan() {
-			// change the plan to fixed amount plan
-			plan = types.NewFixedAmountPlan(plan.GetBasePlan(), p.GetEpochAmount())
-
-			logger := k.Logger(ctx)
-			logger.Info("updated public fixed amount plan", "fixed_amount_plan", plan)
-
-		} else if p.IsForRatioPlan() {
-			if !EnableRatioPlan {
-				return types.ErrRatioPlanDisabled
-			}
-
-			// change the plan to ratio plan
-			plan = types.NewRatioPlan(plan.GetBasePlan(), p.EpochRatio)
-
-			logger := k.Logger(ctx)
-			logger.Info("updated public ratio plan", "ratio_plan", plan)
+		plan, err := modifyPlanDistribution(plan, p)
+		if err != nil {
+			return err
 		}
 
+		logger := k.Logger(ctx)
+		logger.Info("updated public plan", "plan", plan)
+
 		k.SetPlan(ctx, plan)
+		k.removeCappedStakesOfRemovedDenoms(ctx, plan, oldStakingCoinWeights)
+		k.initCappedStakes(ctx, plan, false)
 	}
 
 	return nil
@@ -743,6 +743,97 @@ func (suite *KeeperTestSuite) TestUpdatePlanType() {
 	suite.Require().Equal(plan.(*types.RatioPlan).EpochRatio, sdk.NewDecWithPrec(7, 2))
 }
 
+func (suite *KeeperTestSuite) TestDecayingAmountPlanProposal() {
+	suite.handleProposal(
+		types.NewPublicPlanProposal("testTitle", "testDescription", []types.AddPlanRequest{
+			types.NewAddDecayingAmountPlanRequest(
+				"testPlan",
+				suite.addrs[0].String(),
+				suite.addrs[0].String(),
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+				types.ParseTime("0001-01-01T00:00:00Z"),
+				types.ParseTime("9999-12-31T00:00:00Z"),
+				sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+				sdk.NewDecWithPrec(5, 1),
+				1,
+			),
+		}, nil, nil),
+	)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), plan.(*types.DecayingAmountPlan).DecayFactor)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// The decay schedule advances in the first epoch as well, although no
+	// rewards are allocated since there are no staked coins yet.
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().EqualValues(3, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 125_000)), plan.(*types.DecayingAmountPlan).CurrentEpochAmount()))
+
+	// Modifying the plan with the same decay schedule keeps the decay progress.
+	req := types.NewModifyPlanRequest(
+		plan.GetId(),
+		"",
+		"",
+		"",
+		nil,
+		plan.GetStartTime(),
+		plan.GetEndTime(),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		sdk.Dec{},
+	)
+	req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	req.DecayPeriod = 1
+	suite.handleProposal(
+		types.NewPublicPlanProposal("testTitle", "testDescription", nil, []types.ModifyPlanRequest{req}, nil),
+	)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().EqualValues(3, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 125_000)), plan.(*types.DecayingAmountPlan).CurrentEpochAmount()))
+
+	// Modifying the decay factor restarts the decay schedule from the epoch amount.
+	req.DecayFactor = sdk.NewDecWithPrec(9, 1)
+	suite.handleProposal(
+		types.NewPublicPlanProposal("testTitle", "testDescription", nil, []types.ModifyPlanRequest{req}, nil),
+	)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().EqualValues(0, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), plan.(*types.DecayingAmountPlan).CurrentEpochAmount()))
+
+	suite.AdvanceEpoch()
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().EqualValues(1, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 900_000)), plan.(*types.DecayingAmountPlan).CurrentEpochAmount()))
+
+	// Modifying the epoch amount restarts the decay schedule as well.
+	req.EpochAmount = sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000))
+	suite.handleProposal(
+		types.NewPublicPlanProposal("testTitle", "testDescription", nil, []types.ModifyPlanRequest{req}, nil),
+	)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().EqualValues(0, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), plan.(*types.DecayingAmountPlan).CurrentEpochAmount()))
+
+	// Switching to a fixed amount plan drops the decay schedule.
+	req.DecayFactor = sdk.Dec{}
+	req.DecayPeriod = 0
+	suite.handleProposal(
+		types.NewPublicPlanProposal("testTitle", "testDescription", nil, []types.ModifyPlanRequest{req}, nil),
+	)
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, uint64(1))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), plan.(*types.FixedAmountPlan).EpochAmount))
+}
+
 func (suite *KeeperTestSuite) TestDeletePublicPlan() {
 	for _, tc := range []struct {
 		name             string
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/tendermint/farming/x/farming/types"
@@ -177,115 +180,796 @@ func (k Keeper) DecreaseOutstandingRewards(ctx sdk.Context, stakingCoinDenom str
 	k.SetOutstandingRewards(ctx, stakingCoinDenom, outstanding)
 }
 
+// GetPlanHistoricalRewards returns historical rewards of a plan for a given
+// staking coin denom and an epoch number.
+func (k Keeper) GetPlanHistoricalRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, epoch uint64) (rewards types.HistoricalRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPlanHistoricalRewardsKey(stakingCoinDenom, planId, epoch))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &rewards)
+	found = true
+	return
+}
+
+// SetPlanHistoricalRewards sets historical rewards of a plan for a given
+// staking coin denom and an epoch number.
+func (k Keeper) SetPlanHistoricalRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, epoch uint64, rewards types.HistoricalRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetPlanHistoricalRewardsKey(stakingCoinDenom, planId, epoch), bz)
+}
+
+// DeleteAllPlanHistoricalRewards deletes all plans' historical rewards
+// for a staking coin denom.
+func (k Keeper) DeleteAllPlanHistoricalRewards(ctx sdk.Context, stakingCoinDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPlanHistoricalRewardsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// DeletePlanHistoricalRewards deletes all historical rewards of a plan for
+// a given staking coin denom.
+func (k Keeper) DeletePlanHistoricalRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPlanHistoricalRewardsPrefix(stakingCoinDenom, planId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// IteratePlanHistoricalRewards iterates through all plan historical rewards
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanHistoricalRewards(ctx sdk.Context, cb func(stakingCoinDenom string, planId uint64, epoch uint64, rewards types.HistoricalRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PlanHistoricalRewardsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.HistoricalRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		stakingCoinDenom, planId, epoch := types.ParsePlanHistoricalRewardsKey(iter.Key())
+		if cb(stakingCoinDenom, planId, epoch, rewards) {
+			break
+		}
+	}
+}
+
+// PlanCumulativeUnitRewards returns cumulative unit rewards of a plan for
+// a given staking coin denom as of an epoch number.
+// Plan historical rewards are recorded only for the epochs in which the plan
+// actually allocated rewards, so the latest record at or before the epoch is used.
+func (k Keeper) PlanCumulativeUnitRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, epoch uint64) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(
+		types.GetPlanHistoricalRewardsKey(stakingCoinDenom, planId, 0),
+		types.GetPlanHistoricalRewardsKey(stakingCoinDenom, planId, epoch+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return sdk.DecCoins{}
+	}
+	var rewards types.HistoricalRewards
+	k.cdc.MustUnmarshal(iter.Value(), &rewards)
+	return rewards.CumulativeUnitRewards
+}
+
+// GetPlanOutstandingRewards returns outstanding rewards of a plan for a given
+// staking coin denom.
+func (k Keeper) GetPlanOutstandingRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64) (rewards types.OutstandingRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPlanOutstandingRewardsKey(stakingCoinDenom, planId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &rewards)
+	found = true
+	return
+}
+
+// SetPlanOutstandingRewards sets outstanding rewards of a plan for a given
+// staking coin denom.
+func (k Keeper) SetPlanOutstandingRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetPlanOutstandingRewardsKey(stakingCoinDenom, planId), bz)
+}
+
+// DeleteAllPlanOutstandingRewards deletes all plans' outstanding rewards
+// for a staking coin denom.
+func (k Keeper) DeleteAllPlanOutstandingRewards(ctx sdk.Context, stakingCoinDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPlanOutstandingRewardsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// IteratePlanOutstandingRewards iterates through all plan outstanding rewards
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanOutstandingRewards(ctx sdk.Context, cb func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PlanOutstandingRewardsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.OutstandingRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		stakingCoinDenom, planId := types.ParsePlanOutstandingRewardsKey(iter.Key())
+		if cb(stakingCoinDenom, planId, rewards) {
+			break
+		}
+	}
+}
+
+// IteratePlanOutstandingRewardsByDenom iterates through all plan outstanding
+// rewards for a staking coin denom and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanOutstandingRewardsByDenom(ctx sdk.Context, stakingCoinDenom string, cb func(planId uint64, rewards types.OutstandingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPlanOutstandingRewardsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.OutstandingRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		_, planId := types.ParsePlanOutstandingRewardsKey(iter.Key())
+		if cb(planId, rewards) {
+			break
+		}
+	}
+}
+
+// IncreasePlanOutstandingRewards increases outstanding rewards of a plan for
+// a given staking coin denom by given amount.
+func (k Keeper) IncreasePlanOutstandingRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, amount sdk.DecCoins) {
+	outstanding, _ := k.GetPlanOutstandingRewards(ctx, stakingCoinDenom, planId)
+	outstanding.Rewards = outstanding.Rewards.Add(amount...)
+	k.SetPlanOutstandingRewards(ctx, stakingCoinDenom, planId, outstanding)
+}
+
+// DecreasePlanOutstandingRewards decreases outstanding rewards of a plan for
+// a given staking coin denom by given amount.
+func (k Keeper) DecreasePlanOutstandingRewards(ctx sdk.Context, stakingCoinDenom string, planId uint64, amount sdk.DecCoins) {
+	outstanding, found := k.GetPlanOutstandingRewards(ctx, stakingCoinDenom, planId)
+	if !found {
+		panic("plan outstanding rewards not found")
+	}
+	outstanding.Rewards = outstanding.Rewards.Sub(amount)
+	k.SetPlanOutstandingRewards(ctx, stakingCoinDenom, planId, outstanding)
+}
+
+// GetStartingRewards returns the starting rewards of a farmer's positions
+// for a given staking coin denom.
+func (k Keeper) GetStartingRewards(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) (rewards types.StartingRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStartingRewardsKey(stakingCoinDenom, farmerAcc))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &rewards)
+	found = true
+	return
+}
+
+// SetStartingRewards sets the starting rewards of a farmer's positions for
+// a given staking coin denom.
+func (k Keeper) SetStartingRewards(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress, rewards types.StartingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetStartingRewardsKey(stakingCoinDenom, farmerAcc), bz)
+}
+
+// DeleteStartingRewards deletes the starting rewards of a farmer's positions
+// for a given staking coin denom.
+func (k Keeper) DeleteStartingRewards(ctx sdk.Context, stakingCoinDenom string, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStartingRewardsKey(stakingCoinDenom, farmerAcc))
+}
+
+// DeleteAllStartingRewards deletes all starting rewards for a staking coin
+// denom.
+func (k Keeper) DeleteAllStartingRewards(ctx sdk.Context, stakingCoinDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetStartingRewardsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// IterateStartingRewards iterates through all starting rewards stored in
+// the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateStartingRewards(ctx sdk.Context, cb func(stakingCoinDenom string, farmerAcc sdk.AccAddress, rewards types.StartingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.StartingRewardsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.StartingRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		stakingCoinDenom, farmerAcc := types.ParseStartingRewardsKey(iter.Key())
+		if cb(stakingCoinDenom, farmerAcc, rewards) {
+			break
+		}
+	}
+}
+
+// CumulativeUnitRewards returns cumulative unit rewards for a given staking
+// coin denom as of an epoch number.
+// The historical rewards of the current epoch are recorded while rewards are
+// streamed during the epoch, and the ones of the previous epoch are used
+// until then.
+func (k Keeper) CumulativeUnitRewards(ctx sdk.Context, stakingCoinDenom string, epoch uint64) sdk.DecCoins {
+	if rewards, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch); found {
+		return rewards.CumulativeUnitRewards
+	}
+	if epoch == 0 {
+		return sdk.DecCoins{}
+	}
+	rewards, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch-1)
+	return rewards.CumulativeUnitRewards
+}
+
+// updateStartingRewards records the cumulative unit rewards streamed so far
+// in the current epoch as the starting rewards of a farmer's positions for
+// a given staking coin denom, which must have just been started from the
+// current epoch.
+// Nothing is recorded if no rewards have been streamed in the current epoch
+// or the farmer has no positions.
+func (k Keeper) updateStartingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) {
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	historical, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch)
+	if !found || !k.hasRewardPositions(ctx, farmerAcc, stakingCoinDenom) {
+		k.DeleteStartingRewards(ctx, stakingCoinDenom, farmerAcc)
+		return
+	}
+
+	startingRewards := types.StartingRewards{
+		Epoch:                     currentEpoch,
+		CumulativeUnitRewards:     historical.CumulativeUnitRewards,
+		PlanCumulativeUnitRewards: []types.PlanCumulativeUnitRewards{},
+	}
+	k.IteratePlanOutstandingRewardsByDenom(ctx, stakingCoinDenom, func(planId uint64, _ types.OutstandingRewards) (stop bool) {
+		if rewards, found := k.GetPlanHistoricalRewards(ctx, stakingCoinDenom, planId, currentEpoch); found {
+			startingRewards.PlanCumulativeUnitRewards = append(startingRewards.PlanCumulativeUnitRewards, types.PlanCumulativeUnitRewards{
+				PlanId:                planId,
+				CumulativeUnitRewards: rewards.CumulativeUnitRewards,
+			})
+		}
+		return false
+	})
+	k.SetStartingRewards(ctx, stakingCoinDenom, farmerAcc, startingRewards)
+}
+
 // CalculateRewards returns rewards accumulated until endingEpoch
 // for a farmer for a given staking coin denom.
+// The rewards from plans with stake caps are calculated with the capped
+// stakes of the farmer instead of the positions.
 func (k Keeper) CalculateRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) (rewards sdk.DecCoins) {
-	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
-	if !found {
-		return sdk.NewDecCoins()
+	rewards = sdk.NewDecCoins()
+	ending := k.CumulativeUnitRewards(ctx, stakingCoinDenom, endingEpoch)
+	startingRewards, hasStartingRewards := k.GetStartingRewards(ctx, stakingCoinDenom, farmerAcc)
+	k.iterateRewardPositions(ctx, farmerAcc, stakingCoinDenom, func(weight sdk.Int, startingEpoch uint64) {
+		var starting sdk.DecCoins
+		if hasStartingRewards && startingRewards.Epoch == startingEpoch {
+			starting = startingRewards.CumulativeUnitRewards
+		} else {
+			starting = k.CumulativeUnitRewards(ctx, stakingCoinDenom, startingEpoch-1)
+		}
+		diff := ending.Sub(starting)
+		rewards = rewards.Add(diff.MulDecTruncate(weight.ToDec())...)
+	})
+	k.IterateCappedStakesByFarmer(ctx, stakingCoinDenom, farmerAcc, func(planId uint64, cappedStake types.CappedStake) (stop bool) {
+		rewards = rewards.Add(k.cappedStakeRewards(ctx, stakingCoinDenom, planId, cappedStake, endingEpoch)...)
+		return false
+	})
+	return
+}
+
+// CalculateRewardsByPlan returns rewards accumulated until endingEpoch
+// for a farmer for a given staking coin denom, broken down by plans.
+// It maps plan id to the rewards from the plan.
+func (k Keeper) CalculateRewardsByPlan(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) map[uint64]sdk.DecCoins {
+	rewardsByPlan := map[uint64]sdk.DecCoins{}
+	startingRewards, hasStartingRewards := k.GetStartingRewards(ctx, stakingCoinDenom, farmerAcc)
+
+	// Every plan that has ever allocated rewards for the staking coin denom
+	// has its outstanding rewards record, so use it as the list of plans.
+	k.IteratePlanOutstandingRewardsByDenom(ctx, stakingCoinDenom, func(planId uint64, _ types.OutstandingRewards) (stop bool) {
+		rewards := sdk.NewDecCoins()
+		// A capped stake can be left with its settled rewards after the plan
+		// stopped counting stakes for the denom.
+		if cappedStake, found := k.GetCappedStake(ctx, stakingCoinDenom, farmerAcc, planId); found {
+			rewards = k.cappedStakeRewards(ctx, stakingCoinDenom, planId, cappedStake, endingEpoch)
+		}
+		if _, found := k.GetCappedTotalStakings(ctx, stakingCoinDenom, planId); found {
+			if !rewards.IsZero() {
+				rewardsByPlan[planId] = rewards
+			}
+			return false
+		}
+
+		ending := k.PlanCumulativeUnitRewards(ctx, stakingCoinDenom, planId, endingEpoch)
+		if ending.IsZero() {
+			// The plan's historical rewards have been deleted, or the plan
+			// has never allocated rewards through the positions.
+			if !rewards.IsZero() {
+				rewardsByPlan[planId] = rewards
+			}
+			return false
+		}
+		k.iterateRewardPositions(ctx, farmerAcc, stakingCoinDenom, func(weight sdk.Int, startingEpoch uint64) {
+			starting := k.PlanCumulativeUnitRewards(ctx, stakingCoinDenom, planId, startingEpoch-1)
+			if hasStartingRewards && startingRewards.Epoch == startingEpoch {
+				for _, pr := range startingRewards.PlanCumulativeUnitRewards {
+					if pr.PlanId == planId {
+						starting = pr.CumulativeUnitRewards
+						break
+					}
+				}
+			}
+			rewards = rewards.Add(ending.Sub(starting).MulDecTruncate(weight.ToDec())...)
+		})
+		if !rewards.IsZero() {
+			rewardsByPlan[planId] = rewards
+		}
+		return false
+	})
+
+	return rewardsByPlan
+}
+
+// iterateRewardPositions invokes callback function for each position of
+// a farmer that accumulates rewards for a given staking coin denom, which
+// are the staking and the active locks.
+// The weight of a position is the amount of it counted in total stakings.
+func (k Keeper) iterateRewardPositions(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, cb func(weight sdk.Int, startingEpoch uint64)) {
+	if staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc); found {
+		cb(staking.Amount, staking.StartingEpoch)
 	}
+	k.IterateLocksByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom, func(lock types.Lock) (stop bool) {
+		if !lock.IsQueued() {
+			cb(lock.Weight, lock.StartingEpoch)
+		}
+		return false
+	})
+}
 
-	starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
-	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
-	diff := ending.CumulativeUnitRewards.Sub(starting.CumulativeUnitRewards)
-	rewards = diff.MulDecTruncate(staking.Amount.ToDec())
+// hasRewardPositions returns true if a farmer has any position that
+// accumulates rewards for a given staking coin denom.
+func (k Keeper) hasRewardPositions(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (found bool) {
+	k.iterateRewardPositions(ctx, farmerAcc, stakingCoinDenom, func(sdk.Int, uint64) {
+		found = true
+	})
 	return
 }
 
-// Rewards returns truncated rewards accumulated until the current epoch
-// for a farmer for a given staking coin denom.
-func (k Keeper) Rewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) sdk.Coins {
-	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
-	rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
-	truncatedRewards, _ := rewards.TruncateDecimal()
+// rewardStakingCoinDenomsByFarmer returns sorted staking coin denoms for which
+// a farmer has a staking or an active lock.
+func (k Keeper) rewardStakingCoinDenomsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) []string {
+	denomSet := map[string]struct{}{}
+	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, _ types.Staking) (stop bool) {
+		denomSet[stakingCoinDenom] = struct{}{}
+		return false
+	})
+	k.IterateLocksByFarmer(ctx, farmerAcc, func(lock types.Lock) (stop bool) {
+		if !lock.IsQueued() {
+			denomSet[lock.StakingCoinDenom] = struct{}{}
+		}
+		return false
+	})
+
+	// Sort map keys for deterministic execution.
+	var denoms []string
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// resetStartingEpochs sets the starting epoch of the staking, the active
+// locks and the capped stakes of a farmer for a given staking coin denom to
+// the current epoch.
+// If rewards have already been streamed in the current epoch, the streamed
+// cumulative unit rewards are recorded as the starting rewards of the
+// positions.
+func (k Keeper) resetStartingEpochs(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, currentEpoch uint64) {
+	if staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc); found {
+		staking.StartingEpoch = currentEpoch
+		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+	}
+	var locks []types.Lock
+	k.IterateLocksByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom, func(lock types.Lock) (stop bool) {
+		if !lock.IsQueued() {
+			locks = append(locks, lock)
+		}
+		return false
+	})
+	for _, lock := range locks {
+		lock.StartingEpoch = currentEpoch
+		k.SetLock(ctx, lock)
+	}
+	k.resetCappedStakes(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
+	k.updateStartingRewards(ctx, farmerAcc, stakingCoinDenom)
+}
+
+// Rewards returns truncated rewards accumulated until now for a farmer for
+// a given staking coin denom, including the rewards streamed in the current
+// epoch.
+func (k Keeper) Rewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) sdk.Coins {
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
+	truncatedRewards, _ := rewards.TruncateDecimal()
+
+	return truncatedRewards
+}
+
+// AllRewards returns truncated total rewards accumulated until the
+// current epoch for a farmer.
+func (k Keeper) AllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
+	totalRewards := sdk.NewCoins()
+	for _, stakingCoinDenom := range k.rewardStakingCoinDenomsByFarmer(ctx, farmerAcc) {
+		rewards := k.Rewards(ctx, farmerAcc, stakingCoinDenom)
+		totalRewards = totalRewards.Add(rewards...)
+	}
+	return totalRewards
+}
+
+// RewardsByPlan returns truncated rewards accumulated until the current
+// epoch for a farmer for a given staking coin denom, broken down by plans.
+// Note that the sum of the breakdown can be slightly less than the result of
+// Rewards, since each plan's rewards are truncated separately.
+func (k Keeper) RewardsByPlan(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) []types.PlanRewards {
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	rewardsByPlan := k.CalculateRewardsByPlan(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
+	return truncatePlanRewards(stakingCoinDenom, rewardsByPlan)
+}
+
+// AllRewardsByPlan returns truncated total rewards accumulated until the
+// current epoch for a farmer, broken down by plans and staking coin denoms.
+func (k Keeper) AllRewardsByPlan(ctx sdk.Context, farmerAcc sdk.AccAddress) []types.PlanRewards {
+	planRewards := []types.PlanRewards{}
+	for _, stakingCoinDenom := range k.rewardStakingCoinDenomsByFarmer(ctx, farmerAcc) {
+		planRewards = append(planRewards, k.RewardsByPlan(ctx, farmerAcc, stakingCoinDenom)...)
+	}
+	sort.SliceStable(planRewards, func(i, j int) bool {
+		return planRewards[i].PlanId < planRewards[j].PlanId
+	})
+	return planRewards
+}
+
+// truncatePlanRewards returns truncated plan rewards sorted by plan id,
+// omitting the plans with zero truncated rewards.
+func truncatePlanRewards(stakingCoinDenom string, rewardsByPlan map[uint64]sdk.DecCoins) []types.PlanRewards {
+	// Sort map keys for deterministic execution.
+	var planIds []uint64
+	for planId := range rewardsByPlan {
+		planIds = append(planIds, planId)
+	}
+	sort.Slice(planIds, func(i, j int) bool {
+		return planIds[i] < planIds[j]
+	})
+
+	planRewards := []types.PlanRewards{}
+	for _, planId := range planIds {
+		truncatedRewards, _ := rewardsByPlan[planId].TruncateDecimal()
+		if truncatedRewards.IsZero() {
+			continue
+		}
+		planRewards = append(planRewards, types.PlanRewards{
+			PlanId:           planId,
+			StakingCoinDenom: stakingCoinDenom,
+			Rewards:          truncatedRewards,
+		})
+	}
+	return planRewards
+}
+
+// withdrawPlanRewards decreases outstanding rewards of each plan by the
+// amount of rewards a farmer accumulated from the plan until endingEpoch,
+// and returns the truncated breakdown.
+// It must be called before the starting epochs of the farmer's staking and
+// locks are reset.
+func (k Keeper) withdrawPlanRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) []types.PlanRewards {
+	rewardsByPlan := k.CalculateRewardsByPlan(ctx, farmerAcc, stakingCoinDenom, endingEpoch)
+	for planId, rewards := range rewardsByPlan {
+		k.DecreasePlanOutstandingRewards(ctx, stakingCoinDenom, planId, rewards)
+	}
+	return truncatePlanRewards(stakingCoinDenom, rewardsByPlan)
+}
+
+// GetRewardsWithdrawAddress returns the address to which the rewards of the
+// farmer are sent.
+// It returns the farmer's address if the farmer has not set a withdraw address.
+func (k Keeper) GetRewardsWithdrawAddress(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardsWithdrawAddressKey(farmerAcc))
+	if bz == nil {
+		return farmerAcc
+	}
+	return bz
+}
+
+// SetRewardsWithdrawAddress sets the address to which the rewards of the
+// farmer are sent.
+// Setting the farmer's own address removes the withdraw address.
+func (k Keeper) SetRewardsWithdrawAddress(ctx sdk.Context, farmerAcc, withdrawAcc sdk.AccAddress) error {
+	if k.blockedAddrs[withdrawAcc.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAcc)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if withdrawAcc.Equals(farmerAcc) {
+		store.Delete(types.GetRewardsWithdrawAddressKey(farmerAcc))
+	} else {
+		store.Set(types.GetRewardsWithdrawAddressKey(farmerAcc), withdrawAcc)
+	}
+	return nil
+}
+
+// IterateRewardsWithdrawAddresses iterates through all rewards withdraw
+// addresses set by farmers and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardsWithdrawAddresses(ctx sdk.Context, cb func(farmerAcc, withdrawAcc sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardsWithdrawAddressKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc := types.ParseRewardsWithdrawAddressKey(iter.Key())
+		if cb(farmerAcc, iter.Value()) {
+			break
+		}
+	}
+}
+
+// GetPendingRewards returns the rewards withdrawn for a farmer while
+// harvesting was paused.
+func (k Keeper) GetPendingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (rewards types.PendingRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingRewardsKey(farmerAcc))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &rewards)
+	found = true
+	return
+}
+
+// SetPendingRewards sets the rewards withdrawn for a farmer while harvesting
+// was paused.
+func (k Keeper) SetPendingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards types.PendingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetPendingRewardsKey(farmerAcc), bz)
+}
+
+// DeletePendingRewards deletes the rewards withdrawn for a farmer while
+// harvesting was paused.
+func (k Keeper) DeletePendingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingRewardsKey(farmerAcc))
+}
+
+// IteratePendingRewards iterates through all pending rewards stored in the
+// store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePendingRewards(ctx sdk.Context, cb func(farmerAcc sdk.AccAddress, rewards types.PendingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingRewardsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.PendingRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		farmerAcc := types.ParsePendingRewardsKey(iter.Key())
+		if cb(farmerAcc, rewards) {
+			break
+		}
+	}
+}
+
+// addPendingRewards keeps rewards withdrawn for a farmer while harvesting is
+// paused in the rewards reserve pool, so that the farmer can harvest them
+// after harvesting is unpaused.
+func (k Keeper) addPendingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards sdk.Coins) {
+	pending, _ := k.GetPendingRewards(ctx, farmerAcc)
+	pending.Rewards = pending.Rewards.Add(rewards...)
+	k.SetPendingRewards(ctx, farmerAcc, pending)
 
-	return truncatedRewards
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardsPending,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, rewards.String()),
+		),
+	})
 }
 
-// AllRewards returns truncated total rewards accumulated until the
-// current epoch for a farmer.
-func (k Keeper) AllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
-	totalRewards := sdk.NewCoins()
-	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
-		rewards := k.Rewards(ctx, farmerAcc, stakingCoinDenom)
-		totalRewards = totalRewards.Add(rewards...)
-		return false
+// claimPendingRewards sends the pending rewards of a farmer to the farmer's
+// rewards withdraw address.
+// It returns the rewards sent.
+func (k Keeper) claimPendingRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	pending, found := k.GetPendingRewards(ctx, farmerAcc)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+	k.DeletePendingRewards(ctx, farmerAcc)
+
+	if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, k.GetRewardsWithdrawAddress(ctx, farmerAcc), pending.Rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardsWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, pending.Rewards.String()),
+		),
 	})
-	return totalRewards
+
+	k.AfterRewardsWithdrawn(ctx, farmerAcc, pending.Rewards)
+
+	return pending.Rewards, nil
 }
 
 // WithdrawRewards withdraws accumulated rewards for a farmer for a given
 // staking coin denom.
 // It decreases outstanding rewards and set the starting epoch of a
-// staking.
+// staking and active locks.
+// The rewards from plans with a vesting duration start vesting, and the
+// rest of the rewards are sent to the farmer's rewards withdraw address.
+// While harvesting is paused, the rest of the rewards are kept as the
+// farmer's pending rewards instead of being sent.
+// It returns the rewards sent.
 func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (sdk.Coins, error) {
-	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
-	if !found {
+	if !k.hasRewardPositions(ctx, farmerAcc, stakingCoinDenom) {
 		return nil, types.ErrStakingNotExists
 	}
 
 	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
-	rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
+	rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
 	truncatedRewards, _ := rewards.TruncateDecimal()
+	planRewards := k.withdrawPlanRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
 
+	liquidRewards := sdk.NewCoins()
 	if !rewards.IsZero() {
 		if !truncatedRewards.IsZero() {
-			if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, farmerAcc, truncatedRewards); err != nil {
+			var err error
+			liquidRewards, err = k.vestPlanRewards(ctx, farmerAcc, truncatedRewards, planRewards)
+			if err != nil {
 				return nil, err
 			}
 
-			ctx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeRewardsWithdrawn,
-					sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
-					sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, stakingCoinDenom),
-					sdk.NewAttribute(types.AttributeKeyRewardCoins, truncatedRewards.String()),
-				),
-			})
+			if !liquidRewards.IsZero() && k.IsFunctionPaused(ctx, types.PausableFunctionHarvest) {
+				k.addPendingRewards(ctx, farmerAcc, liquidRewards)
+				liquidRewards = sdk.NewCoins()
+			}
+
+			if !liquidRewards.IsZero() {
+				if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, k.GetRewardsWithdrawAddress(ctx, farmerAcc), liquidRewards); err != nil {
+					return nil, err
+				}
+
+				ctx.EventManager().EmitEvents(sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeRewardsWithdrawn,
+						sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+						sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, stakingCoinDenom),
+						sdk.NewAttribute(types.AttributeKeyRewardCoins, liquidRewards.String()),
+					),
+				})
+			}
+			emitPlanRewardsWithdrawnEvents(ctx, farmerAcc, planRewards)
 		}
 
 		k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
 	}
 
-	staking.StartingEpoch = currentEpoch
-	k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+	k.resetStartingEpochs(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
+
+	if !liquidRewards.IsZero() {
+		k.AfterRewardsWithdrawn(ctx, farmerAcc, liquidRewards)
+	}
 
-	return truncatedRewards, nil
+	return liquidRewards, nil
 }
 
 // WithdrawAllRewards withdraws all accumulated rewards for a farmer.
+// The rewards from plans with a vesting duration start vesting, and the
+// rest of the rewards are sent to the farmer's rewards withdraw address.
+// While harvesting is paused, the rest of the rewards are kept as the
+// farmer's pending rewards instead of being sent.
+// It returns the rewards sent.
 func (k Keeper) WithdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards, totalPlanRewards, err := k.settleAllRewards(ctx, farmerAcc)
+	if err != nil {
+		return nil, err
+	}
+	emitPlanRewardsWithdrawnEvents(ctx, farmerAcc, totalPlanRewards)
+
+	if !totalRewards.IsZero() && k.IsFunctionPaused(ctx, types.PausableFunctionHarvest) {
+		k.addPendingRewards(ctx, farmerAcc, totalRewards)
+		return sdk.NewCoins(), nil
+	}
+
+	if !totalRewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, k.GetRewardsWithdrawAddress(ctx, farmerAcc), totalRewards); err != nil {
+			return nil, err
+		}
+
+		k.AfterRewardsWithdrawn(ctx, farmerAcc, totalRewards)
+	}
+
+	return totalRewards, nil
+}
+
+// settleAllRewards decreases outstanding rewards by all accumulated rewards
+// for a farmer and resets the starting epochs, without sending the rewards.
+// The rewards from plans with a vesting duration start vesting.
+// It returns the rest of the truncated rewards and the breakdown of all
+// withdrawn rewards by plans.
+func (k Keeper) settleAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, []types.PlanRewards, error) {
 	totalRewards := sdk.NewCoins()
-	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+	var totalPlanRewards []types.PlanRewards
+	for _, stakingCoinDenom := range k.rewardStakingCoinDenomsByFarmer(ctx, farmerAcc) {
 		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
-		rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
+		rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
 		truncatedRewards, _ := rewards.TruncateDecimal()
 		totalRewards = totalRewards.Add(truncatedRewards...)
+		totalPlanRewards = append(totalPlanRewards, k.withdrawPlanRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch)...)
 
 		if !rewards.IsZero() {
 			k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
 		}
 
-		staking.StartingEpoch = currentEpoch
-		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
-
-		return false
-	})
+		k.resetStartingEpochs(ctx, farmerAcc, stakingCoinDenom, currentEpoch)
+	}
 
-	if !totalRewards.IsZero() {
-		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, farmerAcc, totalRewards); err != nil {
-			return nil, err
-		}
+	liquidRewards, err := k.vestPlanRewards(ctx, farmerAcc, totalRewards, totalPlanRewards)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return totalRewards, nil
+	return liquidRewards, totalPlanRewards, nil
+}
+
+// emitPlanRewardsWithdrawnEvents emits an event for each plan from which
+// a farmer withdrew rewards.
+func emitPlanRewardsWithdrawnEvents(ctx sdk.Context, farmerAcc sdk.AccAddress, planRewards []types.PlanRewards) {
+	for _, pr := range planRewards {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePlanRewardsWithdrawn,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(pr.PlanId, 10)),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, pr.StakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyRewardCoins, pr.Rewards.String()),
+			),
+		)
+	}
 }
 
 // Harvest claims farming rewards from the reward pool.
+// The pending rewards of the farmer, which were withdrawn while harvesting
+// was paused, are claimed as well.
 func (k Keeper) Harvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string) error {
-	totalRewards := sdk.NewCoins()
+	pendingRewards, err := k.claimPendingRewards(ctx, farmerAcc)
+	if err != nil {
+		return err
+	}
+	totalRewards := pendingRewards
 
 	for _, denom := range stakingCoinDenoms {
+		// A farmer whose positions were removed while harvesting was paused
+		// harvests only the pending rewards.
+		if !pendingRewards.IsZero() && !k.hasRewardPositions(ctx, farmerAcc, denom) {
+			continue
+		}
 		rewards, err := k.WithdrawRewards(ctx, farmerAcc, denom)
 		if err != nil {
 			return err
@@ -311,11 +995,99 @@ type AllocationInfo struct {
 	Amount sdk.Coins
 }
 
+// plannedAllocation holds the amount of coins a plan wants to allocate and
+// the amount of coins actually allocated under the allocation policy.
+type plannedAllocation struct {
+	AllocationInfo
+	Allocated sdk.Coins
+	Skipped   bool
+}
+
+// farmingPoolAllocation holds the planned allocations from a farming pool.
+type farmingPoolAllocation struct {
+	FarmingPool string
+	Balance     sdk.Coins
+	TotalAmount sdk.Coins
+	Plans       []plannedAllocation // sorted by plan id
+}
+
+// Sufficient returns true if the farming pool's balance covers the total
+// amount of the allocations.
+func (poolAlloc farmingPoolAllocation) Sufficient() bool {
+	return poolAlloc.TotalAmount.IsAllLTE(poolAlloc.Balance)
+}
+
 // AllocationInfos returns allocation infos for the end
-// of the current epoch.
+// of the current epoch, which ends at epochEndTime.
 // When total allocated coins for a farming pool exceeds the pool's
-// balance, then allocation will not happen.
-func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
+// balance, the allocations from the pool are skipped or adjusted
+// according to the AllocationPolicy param.
+// If some of the rewards for the current epoch have already been streamed,
+// only the rest of the rewards are allocated.
+func (k Keeper) AllocationInfos(ctx sdk.Context, epochEndTime time.Time) []AllocationInfo {
+	return k.allocationInfos(ctx, plansActiveAt(k.streamedPortion(ctx), sdk.OneDec(), epochEndTime))
+}
+
+// allocationInfos returns allocation infos for the parts of the current
+// epoch given by portions.
+func (k Keeper) allocationInfos(ctx sdk.Context, portions allocationPortions) []AllocationInfo {
+	var allocInfos []AllocationInfo
+	for _, poolAlloc := range k.plannedAllocations(ctx, portions) {
+		for _, planAlloc := range poolAlloc.Plans {
+			if !planAlloc.Skipped {
+				allocInfos = append(allocInfos, AllocationInfo{
+					Plan:   planAlloc.Plan,
+					Amount: planAlloc.Allocated,
+				})
+			}
+		}
+	}
+
+	return allocInfos
+}
+
+// allocationPortions returns the part of the current epoch, between the from
+// and to portions of the epoch, for which a plan allocates rewards.
+// ok is false if the plan allocates no rewards.
+type allocationPortions func(plan types.PlanI) (from, to sdk.Dec, ok bool)
+
+// plansActiveAt returns allocationPortions with which the plans active at t
+// allocate rewards for the part of the current epoch between the from and to
+// portions.
+// While catching up with missed epochs, t is the end time of the epoch being
+// processed rather than the block time, so that a plan which ended while the
+// chain was halted still allocates rewards for the epochs before its end.
+func plansActiveAt(from, to sdk.Dec, t time.Time) allocationPortions {
+	return func(plan types.PlanI) (sdk.Dec, sdk.Dec, bool) {
+		return from, to, types.IsPlanActiveAt(plan, t)
+	}
+}
+
+// plansActiveBetween returns allocationPortions with which each plan
+// allocates rewards for the part of the current epoch between fromTime and
+// toTime during which the plan is active.
+// It is used when streaming rewards, so that a plan allocates rewards for
+// the time until its end time even if no block is made at the end time.
+func (k Keeper) plansActiveBetween(ctx sdk.Context, fromTime, toTime time.Time) allocationPortions {
+	return func(plan types.PlanI) (sdk.Dec, sdk.Dec, bool) {
+		startTime, endTime := fromTime, toTime
+		if plan.GetStartTime().After(startTime) {
+			startTime = plan.GetStartTime()
+		}
+		if plan.GetEndTime().Before(endTime) {
+			endTime = plan.GetEndTime()
+		}
+		if !endTime.After(startTime) {
+			return sdk.Dec{}, sdk.Dec{}, false
+		}
+		return k.epochPortion(ctx, startTime), k.epochPortion(ctx, endTime), true
+	}
+}
+
+// plannedAllocations returns the planned allocations of the plans for the
+// parts of the current epoch given by portions, grouped by farming pools and
+// sorted by farming pool address.
+func (k Keeper) plannedAllocations(ctx sdk.Context, portions allocationPortions) []farmingPoolAllocation {
 	// farmingPoolBalances is a cache for balances of each farming pool,
 	// to reduce number of BankKeeper.SpendableCoins calls.
 	// It maps farmingPoolAddress to the pool's balance.
@@ -329,11 +1101,15 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 
 	plans := map[uint64]types.PlanI{} // it maps planId to plan.
 	var planIds []uint64
-	for _, plan := range k.GetPlans(ctx) {
+	fromPortions := map[uint64]sdk.Dec{}
+	toPortions := map[uint64]sdk.Dec{}
+	for _, plan := range k.GetActivePlans(ctx) {
 		// Add plans that are not terminated and active to the map.
-		if !plan.IsTerminated() && types.IsPlanActiveAt(plan, ctx.BlockTime()) {
+		if from, to, ok := portions(plan); ok {
 			plans[plan.GetId()] = plan
 			planIds = append(planIds, plan.GetId())
+			fromPortions[plan.GetId()] = from
+			toPortions[plan.GetId()] = to
 		}
 	}
 
@@ -364,12 +1140,16 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 
 		// Based on the plan's type, record how many coins the plan wants to
 		// allocate in the allocation map.
+		var epochAmt sdk.Coins
 		switch plan := plan.(type) {
 		case *types.FixedAmountPlan:
-			ac[plan.GetId()] = plan.EpochAmount
+			epochAmt = plan.EpochAmount
 		case *types.RatioPlan:
-			ac[plan.GetId()], _ = sdk.NewDecCoinsFromCoins(balances...).MulDecTruncate(plan.EpochRatio).TruncateDecimal()
+			epochAmt, _ = sdk.NewDecCoinsFromCoins(balances...).MulDecTruncate(plan.EpochRatio).TruncateDecimal()
+		case *types.DecayingAmountPlan:
+			epochAmt = plan.CurrentEpochAmount()
 		}
+		ac[plan.GetId()] = types.CoinsPortion(epochAmt, fromPortions[planId], toPortions[planId])
 	}
 
 	// Sort map keys for deterministic execution.
@@ -380,21 +1160,13 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 	sort.Strings(farmingPools)
 
 	// In this step, we check if farming pools have sufficient balance for allocations.
-	// If not, we don't allocate rewards from that farming pool for this epoch.
-	var allocInfos []AllocationInfo
+	// If not, the allocations from that farming pool are skipped or adjusted
+	// according to the allocation policy.
+	policy := k.GetParams(ctx).AllocationPolicy
+	var poolAllocs []farmingPoolAllocation
 	for _, farmingPool := range farmingPools {
 		planCoins := allocCoins[farmingPool]
 
-		totalCoins := sdk.NewCoins()
-		for _, amt := range planCoins {
-			totalCoins = totalCoins.Add(amt...)
-		}
-
-		balances := farmingPoolBalances[farmingPool]
-		if !totalCoins.IsAllLTE(balances) {
-			continue
-		}
-
 		// Sort map keys for deterministic execution.
 		var planIds []uint64
 		for planId := range planCoins {
@@ -404,20 +1176,393 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 			return planIds[i] < planIds[j]
 		})
 
+		poolAlloc := farmingPoolAllocation{
+			FarmingPool: farmingPool,
+			Balance:     farmingPoolBalances[farmingPool],
+			TotalAmount: sdk.NewCoins(),
+		}
 		for _, planId := range planIds {
-			allocInfos = append(allocInfos, AllocationInfo{
-				Plan:   plans[planId],
-				Amount: planCoins[planId],
+			poolAlloc.TotalAmount = poolAlloc.TotalAmount.Add(planCoins[planId]...)
+			poolAlloc.Plans = append(poolAlloc.Plans, plannedAllocation{
+				AllocationInfo: AllocationInfo{
+					Plan:   plans[planId],
+					Amount: planCoins[planId],
+				},
+				Allocated: planCoins[planId],
 			})
 		}
+
+		if !poolAlloc.Sufficient() {
+			applyAllocationPolicy(policy, poolAlloc)
+		}
+
+		poolAllocs = append(poolAllocs, poolAlloc)
 	}
 
-	return allocInfos
+	return poolAllocs
+}
+
+// applyAllocationPolicy adjusts the planned allocations from a farming pool
+// whose balance does not cover the total amount of the allocations.
+func applyAllocationPolicy(policy types.AllocationPolicy, poolAlloc farmingPoolAllocation) {
+	switch policy {
+	case types.AllocationPolicyProRata:
+		// Scale down the amount of each denom by the ratio of the pool's
+		// balance to the total amount of the denom.
+		for i, planAlloc := range poolAlloc.Plans {
+			allocated := sdk.NewCoins()
+			for _, coin := range planAlloc.Amount {
+				amt := coin.Amount
+				totalAmt := poolAlloc.TotalAmount.AmountOf(coin.Denom)
+				balance := poolAlloc.Balance.AmountOf(coin.Denom)
+				if totalAmt.GT(balance) {
+					amt = amt.Mul(balance).Quo(totalAmt)
+				}
+				if amt.IsPositive() {
+					allocated = allocated.Add(sdk.NewCoin(coin.Denom, amt))
+				}
+			}
+			poolAlloc.Plans[i].Allocated = allocated
+		}
+	case types.AllocationPolicyPriority:
+		// Allocate in ascending order of plan id, skipping plans that the
+		// remaining balance cannot cover.
+		remaining := poolAlloc.Balance
+		for i, planAlloc := range poolAlloc.Plans {
+			if planAlloc.Amount.IsAllLTE(remaining) {
+				remaining = remaining.Sub(planAlloc.Amount)
+			} else {
+				poolAlloc.Plans[i].Allocated = nil
+				poolAlloc.Plans[i].Skipped = true
+			}
+		}
+	default: // types.AllocationPolicySkipAll
+		for i := range poolAlloc.Plans {
+			poolAlloc.Plans[i].Allocated = nil
+			poolAlloc.Plans[i].Skipped = true
+		}
+	}
+}
+
+// SimulateAllocation returns how rewards would be allocated at the end of
+// the current epoch, without changing the state.
+// Unlike AllocationInfos, it also reports the farming pools and the plans
+// whose allocations would be skipped or adjusted, with the reasons.
+func (k Keeper) SimulateAllocation(ctx sdk.Context) (poolAllocs []types.FarmingPoolAllocation, planAllocs []types.PlanAllocation, unitRewards []types.DenomUnitRewards) {
+	plannedAllocs := k.plannedAllocations(ctx, plansActiveAt(k.streamedPortion(ctx), sdk.OneDec(), ctx.BlockTime()))
+
+	// The following calculation must be kept in sync with AllocateRewards.
+	unitRewardsByDenom := map[string]sdk.DecCoins{}
+	totalStakingsByDenom := map[string]sdk.Int{}
+	for _, poolAlloc := range plannedAllocs {
+		skipped := true
+		for _, plannedAlloc := range poolAlloc.Plans {
+			planAlloc := types.PlanAllocation{
+				PlanId:             plannedAlloc.Plan.GetId(),
+				FarmingPoolAddress: poolAlloc.FarmingPool,
+				Amount:             plannedAlloc.Amount,
+				AllocatedAmount:    sdk.NewCoins(),
+			}
+			if plannedAlloc.Skipped {
+				planAlloc.Skipped = true
+				planAlloc.Reason = "farming pool has insufficient balance"
+				planAllocs = append(planAllocs, planAlloc)
+				continue
+			}
+			skipped = false
+
+			for _, weight := range plannedAlloc.Plan.GetStakingCoinWeights() {
+				totalStakings, ok := totalStakingsByDenom[weight.Denom]
+				if !ok {
+					totalStakings = sdk.ZeroInt()
+					if ts, found := k.GetTotalStakings(ctx, weight.Denom); found {
+						totalStakings = ts.Amount
+					}
+					totalStakingsByDenom[weight.Denom] = totalStakings
+				}
+				if !totalStakings.IsPositive() {
+					continue
+				}
+				cappedTotalStakings, capped := k.cappedTotalStakingsForAllocation(ctx, plannedAlloc.Plan, weight.Denom)
+				if capped && !cappedTotalStakings.IsPositive() {
+					continue
+				}
+
+				allocCoins, _ := sdk.NewDecCoinsFromCoins(plannedAlloc.Allocated...).MulDecTruncate(weight.Amount).TruncateDecimal()
+				if !capped {
+					allocCoinsDec := sdk.NewDecCoinsFromCoins(allocCoins...)
+					unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(allocCoinsDec.QuoDecTruncate(totalStakings.ToDec())...)
+				}
+				planAlloc.AllocatedAmount = planAlloc.AllocatedAmount.Add(allocCoins...)
+			}
+			if planAlloc.AllocatedAmount.IsZero() {
+				planAlloc.Skipped = true
+				planAlloc.Reason = "no coins are staked for the staking coin denoms or the allocation amount is too small"
+			}
+			planAllocs = append(planAllocs, planAlloc)
+		}
+
+		poolAllocation := types.FarmingPoolAllocation{
+			FarmingPoolAddress: poolAlloc.FarmingPool,
+			Balance:            poolAlloc.Balance,
+			TotalAmount:        poolAlloc.TotalAmount,
+		}
+		if !poolAlloc.Sufficient() {
+			poolAllocation.Skipped = skipped
+			poolAllocation.Reason = fmt.Sprintf("total amount %s exceeds the farming pool's spendable balance %s", poolAlloc.TotalAmount, poolAlloc.Balance)
+		}
+		poolAllocs = append(poolAllocs, poolAllocation)
+	}
+
+	// Sort keys for deterministic execution.
+	var denoms []string
+	for denom := range unitRewardsByDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		unitRewards = append(unitRewards, types.DenomUnitRewards{
+			StakingCoinDenom: denom,
+			TotalStakings:    totalStakingsByDenom[denom],
+			UnitRewards:      unitRewardsByDenom[denom],
+		})
+	}
+
+	return
+}
+
+// ExpectedPlanRewards returns the rewards that each active plan is expected
+// to allocate for a staking coin denom at the end of the current epoch,
+// based on the same calculation as AllocateRewards.
+// The expected rewards are calculated for the given staked amount out of
+// the given total stakings of the staking coin denom.
+// For plans with stake caps, the amounts are capped by the plans' limits and
+// the total stakings are estimated from the plans' capped total stakings.
+// Plans whose allocations are skipped are included with zero rewards.
+// The result is sorted by plan id.
+func (k Keeper) ExpectedPlanRewards(ctx sdk.Context, stakingCoinDenom string, stakedAmt, totalStakings sdk.Int) []types.ExpectedPlanRewards {
+	currentTotalStakings := sdk.ZeroInt()
+	if ts, found := k.GetTotalStakings(ctx, stakingCoinDenom); found {
+		currentTotalStakings = ts.Amount
+	}
+
+	expected := []types.ExpectedPlanRewards{}
+	for _, poolAlloc := range k.plannedAllocations(ctx, plansActiveAt(sdk.ZeroDec(), sdk.OneDec(), ctx.BlockTime())) {
+		for _, plannedAlloc := range poolAlloc.Plans {
+			for _, weight := range plannedAlloc.Plan.GetStakingCoinWeights() {
+				if weight.Denom != stakingCoinDenom {
+					continue
+				}
+
+				amt := plannedAlloc.Allocated
+				if plannedAlloc.Skipped {
+					amt = plannedAlloc.Amount
+				}
+				allocCoins, _ := sdk.NewDecCoinsFromCoins(amt...).MulDecTruncate(weight.Amount).TruncateDecimal()
+
+				planRewards := types.ExpectedPlanRewards{
+					PlanId:             plannedAlloc.Plan.GetId(),
+					FarmingPoolAddress: poolAlloc.FarmingPool,
+					AllocationAmount:   allocCoins,
+					SufficientBalance:  poolAlloc.Sufficient(),
+					UnitRewards:        sdk.DecCoins{},
+					Rewards:            sdk.DecCoins{},
+				}
+				// For a plan with stake caps, only the capped portion of the
+				// staked amount is counted, out of the plan's capped total
+				// stakings including the additional stakings.
+				counted, countedTotal := stakedAmt, totalStakings
+				if cappedTotalStakings, capped := k.cappedTotalStakingsForAllocation(ctx, plannedAlloc.Plan, stakingCoinDenom); capped {
+					counted, countedTotal = expectedCappedStake(plannedAlloc.Plan, stakedAmt, cappedTotalStakings.Add(totalStakings.Sub(currentTotalStakings)))
+				}
+
+				// Rewards are not allocated when there are no stakings for the
+				// denom, or the allocation is skipped.
+				if !plannedAlloc.Skipped && countedTotal.IsPositive() {
+					planRewards.UnitRewards = sdk.NewDecCoinsFromCoins(allocCoins...).QuoDecTruncate(countedTotal.ToDec())
+					planRewards.Rewards = planRewards.UnitRewards.MulDecTruncate(counted.ToDec())
+				}
+				expected = append(expected, planRewards)
+			}
+		}
+	}
+
+	sort.Slice(expected, func(i, j int) bool {
+		return expected[i].PlanId < expected[j].PlanId
+	})
+
+	return expected
+}
+
+// expectedCappedStake returns the portion of a staked amount counted toward
+// a plan with stake caps and the plan's total counted stakings, given the
+// estimated total counted stakings.
+func expectedCappedStake(plan types.PlanI, stakedAmt, totalStakings sdk.Int) (counted, countedTotal sdk.Int) {
+	counted = stakedAmt
+	if maxPerFarmer := plan.GetMaxStakePerFarmer(); maxPerFarmer.IsPositive() {
+		counted = sdk.MinInt(counted, maxPerFarmer)
+	}
+	countedTotal = totalStakings
+	if maxTotal := plan.GetMaxTotalStake(); maxTotal.IsPositive() {
+		countedTotal = sdk.MinInt(countedTotal, maxTotal)
+	}
+	countedTotal = sdk.MaxInt(countedTotal, sdk.ZeroInt())
+	counted = sdk.MinInt(counted, countedTotal)
+	return
+}
+
+// RewardWeight returns the total weight of the positions of a farmer that
+// accumulate rewards for a given staking coin denom.
+func (k Keeper) RewardWeight(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) sdk.Int {
+	total := sdk.ZeroInt()
+	k.iterateRewardPositions(ctx, farmerAcc, stakingCoinDenom, func(weight sdk.Int, _ uint64) {
+		total = total.Add(weight)
+	})
+	return total
+}
+
+// AllocateRewards allocates rewards at the end of the current epoch, which
+// ends at epochEndTime, and advances the current epochs of the staking coin
+// denoms for which rewards have been allocated during the epoch.
+// When the RewardsStreaming param is enabled, the rewards have already been
+// streamed every block by StreamRewards, so it only streams the rest of the
+// epoch which has not been streamed yet, if any.
+func (k Keeper) AllocateRewards(ctx sdk.Context, epochEndTime time.Time) error {
+	if k.GetParams(ctx).RewardsStreaming {
+		// The epoch may end before it has been fully streamed, for example
+		// when it is advanced before its scheduled end time.
+		scheduledEndTime, _ := k.NextEpochTime(ctx)
+		if err := k.streamRewards(ctx, k.streamedTime(ctx), scheduledEndTime, epochEndTime); err != nil {
+			return err
+		}
+	} else if err := k.allocateRewards(ctx, k.AllocationInfos(ctx, epochEndTime), epochEndTime); err != nil {
+		return err
+	}
+	k.advanceCurrentEpochs(ctx)
+
+	k.AfterAllocateRewards(ctx)
+
+	return nil
+}
+
+// StreamRewards allocates the rewards for the time elapsed since rewards
+// were last streamed, in proportion to the elapsed time of the current epoch.
+// In other words, each plan allocates its epoch amount divided by the length
+// of the current epoch per second.
+// It is called every block when the RewardsStreaming param is enabled.
+func (k Keeper) StreamRewards(ctx sdk.Context) error {
+	epochEndTime, found := k.NextEpochTime(ctx)
+	if !found {
+		return nil
+	}
+
+	// While rewards allocation is paused, the elapsed time is marked as
+	// streamed so that the rewards for it are not allocated after unpausing.
+	if k.IsFunctionPaused(ctx, types.PausableFunctionRewardAllocation) {
+		k.SetLastStreamingTime(ctx, ctx.BlockTime())
+		return nil
+	}
+
+	// While catching up with missed epochs, the rest of an epoch that has
+	// already ended is streamed as of the end of the epoch.
+	t := ctx.BlockTime()
+	if t.After(epochEndTime) {
+		t = epochEndTime
+	}
+
+	fromTime := k.streamedTime(ctx)
+	k.SetLastStreamingTime(ctx, ctx.BlockTime())
+
+	return k.streamRewards(ctx, fromTime, t, t)
+}
+
+// streamRewards allocates the rewards for the part of the current epoch
+// between fromTime and toTime.
+// t is recorded as the last distribution time of the plans.
+func (k Keeper) streamRewards(ctx sdk.Context, fromTime, toTime, t time.Time) error {
+	if !toTime.After(fromTime) {
+		return nil
+	}
+
+	// Skip plans with nothing to allocate in this block, so that historical
+	// rewards are not recorded needlessly.
+	var allocInfos []AllocationInfo
+	for _, allocInfo := range k.allocationInfos(ctx, k.plansActiveBetween(ctx, fromTime, toTime)) {
+		if !allocInfo.Amount.IsZero() {
+			allocInfos = append(allocInfos, allocInfo)
+		}
+	}
+
+	return k.allocateRewards(ctx, allocInfos, t)
+}
+
+// epochPortion returns the portion of the current epoch that has elapsed at t.
+// The portion is calculated against the actual length of the current epoch,
+// which may be shorter than the epoch duration.
+func (k Keeper) epochPortion(ctx sdk.Context, t time.Time) sdk.Dec {
+	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return types.EpochPortion(lastEpochTime, types.NextEpochTime(lastEpochTime, k.GetCurrentEpochDuration(ctx)), t)
+}
+
+// streamedTime returns the time until which rewards for the current epoch
+// have already been streamed.
+func (k Keeper) streamedTime(ctx sdk.Context) time.Time {
+	lastEpochTime, _ := k.GetLastEpochTime(ctx)
+	if lastStreamingTime, found := k.GetLastStreamingTime(ctx); found && lastStreamingTime.After(lastEpochTime) {
+		return lastStreamingTime
+	}
+	return lastEpochTime
+}
+
+// streamedPortion returns the portion of the current epoch for which rewards
+// have already been streamed.
+func (k Keeper) streamedPortion(ctx sdk.Context) sdk.Dec {
+	return k.epochPortion(ctx, k.streamedTime(ctx))
+}
+
+// advanceDecayingPlans advances the decay schedules of the decaying amount
+// plans that are active during the current epoch, which ends at epochEndTime.
+// A plan is active during the epoch if it would allocate rewards for any
+// part of the epoch, so the schedules advance even when the plans allocate
+// nothing, for example when there are no stakers, when the farming pools are
+// underfunded or while rewards allocation is paused.
+func (k Keeper) advanceDecayingPlans(ctx sdk.Context, epochEndTime time.Time) {
+	var portions allocationPortions
+	if k.GetParams(ctx).RewardsStreaming {
+		// Rewards are streamed only after the first epoch has started.
+		lastEpochTime, found := k.GetLastEpochTime(ctx)
+		if !found {
+			return
+		}
+		portions = k.plansActiveBetween(ctx, lastEpochTime, epochEndTime)
+	} else {
+		portions = plansActiveAt(sdk.ZeroDec(), sdk.OneDec(), epochEndTime)
+	}
+	for _, plan := range k.GetActivePlans(ctx) {
+		plan, ok := plan.(*types.DecayingAmountPlan)
+		if !ok {
+			continue
+		}
+		if _, _, active := portions(plan); active {
+			plan.ElapsedEpochs++
+			k.SetPlan(ctx, plan)
+		}
+	}
 }
 
-// AllocateRewards updates historical rewards and current epoch info
-// based on the allocation infos.
-func (k Keeper) AllocateRewards(ctx sdk.Context) error {
+// allocateRewards adds the unit rewards of the allocations to the historical
+// rewards of the current epoch based on the allocation infos.
+// The historical rewards of the current epoch accumulate every allocation
+// made during the epoch, and the current epoch advances only at the end of
+// the epoch, so that streaming rewards every block does not record
+// historical rewards for every block.
+// t is recorded as the last distribution time of the plans.
+// SimulateAllocation must be kept in sync with the calculation here.
+func (k Keeper) allocateRewards(ctx sdk.Context, allocInfos []AllocationInfo, t time.Time) error {
 	// unitRewardsByDenom is a table that records how much unit rewards should
 	// be increased in this epoch, for each staking coin denom.
 	// It maps staking coin denom to unit rewards.
@@ -428,9 +1573,6 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 	// stakings for the denom then it stores nil pointer.
 	totalStakingsCache := map[string]*types.TotalStakings{}
 
-	// Get allocation information first.
-	allocInfos := k.AllocationInfos(ctx)
-
 	for _, allocInfo := range allocInfos {
 		totalAllocCoins := sdk.NewCoins()
 
@@ -455,15 +1597,47 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 			}
 			totalStakings := *totalStakingsPtr
 
+			// Only the capped portion of each farmer's stake is counted toward
+			// a plan with stake caps, so its unit rewards are calculated with
+			// the plan's capped total stakings instead.
+			cappedTotalStakings, capped := k.cappedTotalStakingsForAllocation(ctx, allocInfo.Plan, weight.Denom)
+			if capped && !cappedTotalStakings.IsPositive() {
+				continue
+			}
+
 			allocCoins, _ := sdk.NewDecCoinsFromCoins(allocInfo.Amount...).MulDecTruncate(weight.Amount).TruncateDecimal()
 			allocCoinsDec := sdk.NewDecCoinsFromCoins(allocCoins...)
 
-			// Multiple plans can have same denom in their staking coin weights,
-			// so we accumulate all unit rewards for this denom in the table.
-			unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(allocCoinsDec.QuoDecTruncate(totalStakings.Amount.ToDec())...)
+			var unitRewards sdk.DecCoins
+			if capped {
+				unitRewards = allocCoinsDec.QuoDecTruncate(cappedTotalStakings.ToDec())
+				// The rewards are tracked by the capped stakes, but the historical
+				// rewards of the denom are still recorded, so that the current
+				// epoch of the denom advances.
+				if _, ok := unitRewardsByDenom[weight.Denom]; !ok {
+					unitRewardsByDenom[weight.Denom] = sdk.DecCoins{}
+				}
+			} else {
+				// Multiple plans can have same denom in their staking coin weights,
+				// so we accumulate all unit rewards for this denom in the table.
+				unitRewards = allocCoinsDec.QuoDecTruncate(totalStakings.Amount.ToDec())
+				unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(unitRewards...)
+			}
 
 			k.IncreaseOutstandingRewards(ctx, weight.Denom, allocCoinsDec)
 
+			// Record the plan's own share of the unit rewards separately,
+			// so that rewards can be broken down by plans later.
+			if !allocCoinsDec.IsZero() {
+				planId := allocInfo.Plan.GetId()
+				currentEpoch := k.GetCurrentEpoch(ctx, weight.Denom)
+				cumulative := k.PlanCumulativeUnitRewards(ctx, weight.Denom, planId, currentEpoch)
+				k.SetPlanHistoricalRewards(ctx, weight.Denom, planId, currentEpoch, types.HistoricalRewards{
+					CumulativeUnitRewards: cumulative.Add(unitRewards...),
+				})
+				k.IncreasePlanOutstandingRewards(ctx, weight.Denom, planId, allocCoinsDec)
+			}
+
 			totalAllocCoins = totalAllocCoins.Add(allocCoins...)
 		}
 
@@ -478,8 +1652,8 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 			return err
 		}
 
-		t := ctx.BlockTime()
-		_ = allocInfo.Plan.SetLastDistributionTime(&t)
+		distributionTime := t
+		_ = allocInfo.Plan.SetLastDistributionTime(&distributionTime)
 		_ = allocInfo.Plan.SetDistributedCoins(allocInfo.Plan.GetDistributedCoins().Add(totalAllocCoins...))
 		k.SetPlan(ctx, allocInfo.Plan)
 
@@ -499,30 +1673,97 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 	}
 	sort.Strings(denoms)
 
-	// For each staking coin denom in the table, increase cumulative unit rewards
-	// and increment current epoch number by 1.
+	// For each staking coin denom in the table, increase cumulative unit
+	// rewards of the current epoch.
 	for _, stakingCoinDenom := range denoms {
 		unitRewards := unitRewardsByDenom[stakingCoinDenom]
 
 		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
-		historical, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch-1)
+		cumulative := k.CumulativeUnitRewards(ctx, stakingCoinDenom, currentEpoch)
 		k.SetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch, types.HistoricalRewards{
-			CumulativeUnitRewards: historical.CumulativeUnitRewards.Add(unitRewards...),
+			CumulativeUnitRewards: cumulative.Add(unitRewards...),
 		})
-		k.SetCurrentEpoch(ctx, stakingCoinDenom, currentEpoch+1)
 	}
 
 	return nil
 }
 
+// advanceCurrentEpochs increments the current epoch number by 1 for each
+// staking coin denom whose historical rewards of the current epoch have been
+// recorded, at the end of an epoch.
+func (k Keeper) advanceCurrentEpochs(ctx sdk.Context) {
+	var denoms []string
+	k.IterateCurrentEpochs(ctx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+		if _, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch); found {
+			denoms = append(denoms, stakingCoinDenom)
+		}
+		return false
+	})
+	for _, stakingCoinDenom := range denoms {
+		k.SetCurrentEpoch(ctx, stakingCoinDenom, k.GetCurrentEpoch(ctx, stakingCoinDenom)+1)
+	}
+}
+
+// cappedTotalStakingsForAllocation returns the capped total stakings of
+// a plan for a staking coin denom, and whether the plan has stake caps.
+func (k Keeper) cappedTotalStakingsForAllocation(ctx sdk.Context, plan types.PlanI, stakingCoinDenom string) (sdk.Int, bool) {
+	if !types.HasStakeCaps(plan) {
+		return sdk.Int{}, false
+	}
+	totalStakings, found := k.GetCappedTotalStakings(ctx, stakingCoinDenom, plan.GetId())
+	if !found {
+		return sdk.ZeroInt(), true
+	}
+	return totalStakings.Amount, true
+}
+
 // ValidateRemainingRewardsAmount checks that the balance of the
 // rewards reserve pool is greater than the total amount of
 // unwithdrawn rewards.
+// The rewards of every farmer who has a staking, an active lock or a capped
+// stake for a staking coin denom are counted.
 func (k Keeper) ValidateRemainingRewardsAmount(ctx sdk.Context) error {
+	type farmerDenom struct {
+		farmerAcc        sdk.AccAddress
+		stakingCoinDenom string
+	}
+	// It maps a key made of the staking coin denom and the farmer's address
+	// to the pair, so that each farmer's rewards for a denom are counted once.
+	holders := map[string]farmerDenom{}
+	addHolder := func(farmerAcc sdk.AccAddress, stakingCoinDenom string) {
+		holders[stakingCoinDenom+"/"+farmerAcc.String()] = farmerDenom{farmerAcc, stakingCoinDenom}
+	}
+	k.IterateStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, _ types.Staking) (stop bool) {
+		addHolder(farmerAcc, stakingCoinDenom)
+		return false
+	})
+	k.IterateLocks(ctx, func(lock types.Lock) (stop bool) {
+		if !lock.IsQueued() {
+			addHolder(lock.GetFarmer(), lock.StakingCoinDenom)
+		}
+		return false
+	})
+	k.IterateCappedStakes(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, _ uint64, _ types.CappedStake) (stop bool) {
+		addHolder(farmerAcc, stakingCoinDenom)
+		return false
+	})
+
+	// Sort map keys for deterministic execution.
+	var keys []string
+	for key := range holders {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
 	remainingRewards := sdk.NewCoins()
-	k.IterateStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, staking types.Staking) (stop bool) {
-		rewards := k.Rewards(ctx, farmerAcc, stakingCoinDenom)
+	for _, key := range keys {
+		holder := holders[key]
+		rewards := k.Rewards(ctx, holder.farmerAcc, holder.stakingCoinDenom)
 		remainingRewards = remainingRewards.Add(rewards...)
+	}
+	// The pending rewards are kept in the rewards reserve pool as well.
+	k.IteratePendingRewards(ctx, func(_ sdk.AccAddress, rewards types.PendingRewards) (stop bool) {
+		remainingRewards = remainingRewards.Add(rewards.Rewards...)
 		return false
 	})
 
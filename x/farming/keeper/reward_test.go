@@ -4,9 +4,12 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming"
+	farmingkeeper "github.com/tendermint/farming/x/farming/keeper"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -201,7 +204,7 @@ func (suite *KeeperTestSuite) TestAllocationInfos() {
 			}
 
 			suite.ctx = suite.ctx.WithBlockTime(tc.t)
-			distrInfos := suite.keeper.AllocationInfos(suite.ctx)
+			distrInfos := suite.keeper.AllocationInfos(suite.ctx, suite.ctx.BlockTime())
 			if suite.Len(distrInfos, len(tc.distrAmts)) {
 				for _, distrInfo := range distrInfos {
 					distrAmt, ok := tc.distrAmts[distrInfo.Plan.GetId()]
@@ -214,6 +217,292 @@ func (suite *KeeperTestSuite) TestAllocationInfos() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllocationInfos_AllocationPolicy() {
+	for _, tc := range []struct {
+		name      string
+		policy    types.AllocationPolicy
+		distrAmts map[uint64]sdk.Coins // planID => sdk.Coins
+	}{
+		{
+			"skip all",
+			types.AllocationPolicySkipAll,
+			map[uint64]sdk.Coins{},
+		},
+		{
+			"pro rata",
+			types.AllocationPolicyProRata,
+			map[uint64]sdk.Coins{
+				1: sdk.NewCoins(sdk.NewInt64Coin(denom3, 384_615_384)),
+				2: sdk.NewCoins(sdk.NewInt64Coin(denom3, 538_461_538)),
+				3: sdk.NewCoins(sdk.NewInt64Coin(denom3, 76_923_076)),
+			},
+		},
+		{
+			"priority",
+			types.AllocationPolicyPriority,
+			map[uint64]sdk.Coins{
+				1: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500_000_000)),
+				3: sdk.NewCoins(sdk.NewInt64Coin(denom3, 100_000_000)),
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.keeper.GetParams(suite.ctx)
+			params.AllocationPolicy = tc.policy
+			suite.keeper.SetParams(suite.ctx, params)
+
+			// The total amount of the plans exceeds the farming pool's balance.
+			suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 500_000_000})
+			suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 700_000_000})
+			suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 100_000_000})
+
+			allocInfos := suite.keeper.AllocationInfos(suite.ctx, suite.ctx.BlockTime())
+			suite.Require().Len(allocInfos, len(tc.distrAmts))
+			totalAmt := sdk.NewCoins()
+			for _, allocInfo := range allocInfos {
+				suite.Require().True(coinsEq(tc.distrAmts[allocInfo.Plan.GetId()], allocInfo.Amount))
+				totalAmt = totalAmt.Add(allocInfo.Amount...)
+			}
+			suite.Require().True(totalAmt.IsAllLTE(suite.app.BankKeeper.SpendableCoins(suite.ctx, suite.addrs[5])))
+
+			suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+			suite.keeper.ProcessQueuedCoins(suite.ctx)
+			suite.Require().NoError(suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime()))
+
+			_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStreamRewards() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// 1000denom3 per second.
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 86_400_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	t := types.ParseTime("2022-01-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	suite.AdvanceEpoch() // No rewards are allocated at the end of an epoch.
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+
+	for _, tc := range []struct {
+		elapsed time.Duration
+		rewards int64
+	}{
+		{time.Hour, 3_600_000},
+		{time.Hour, 3_600_000}, // Nothing is streamed twice in the same time.
+		{90 * time.Minute, 5_400_000},
+		{24 * time.Hour, 86_400_000},
+		{25 * time.Hour, 86_400_000}, // Streaming is capped by the epoch amount.
+	} {
+		suite.ctx = suite.ctx.WithBlockTime(t.Add(tc.elapsed))
+		suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, tc.rewards)), suite.AllRewards(suite.addrs[0])))
+	}
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 86_400_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The next epoch starts at 01:00 and is shortened to end at midnight, so
+	// its epoch amount is streamed over 23 hours.
+	// Stream the first half of the epoch and then disable streaming.
+	// Only the other half is allocated at the end of the epoch.
+	t = suite.ctx.BlockTime()
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(11*time.Hour + 30*time.Minute))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 129_600_000)), suite.AllRewards(suite.addrs[0])))
+
+	params.RewardsStreaming = false
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(23 * time.Hour))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 172_800_000)), suite.AllRewards(suite.addrs[0])))
+
+	_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestStreamRewards_ShortenedEpoch() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 86_400_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	// The first epoch starts at 10:37 and ends at midnight.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T10:37:00Z"))
+	suite.AdvanceEpoch()
+	epochEndTime, _ := suite.keeper.NextEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2022-01-02T00:00:00Z"), epochEndTime)
+
+	// Half of the epoch amount is streamed in the first half of the epoch.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T17:18:30Z"))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 43_200_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The whole epoch amount is streamed by the end of the epoch.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-02T00:00:05Z"))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().NoError(suite.keeper.AdvanceEpochTo(suite.ctx, epochEndTime))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 86_400_000)), suite.AllRewards(suite.addrs[0])))
+
+	// When an epoch ends before it has been fully streamed, the rest of the
+	// epoch amount is streamed at the end of the epoch.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-02T06:00:00Z"))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 108_000_000)), suite.AllRewards(suite.addrs[0])))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 172_800_000)), suite.AllRewards(suite.addrs[0])))
+
+	_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestStreamRewards_PlanEndTime() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	t := types.ParseTime("2022-01-01T00:00:00Z")
+	_, err := suite.createPublicFixedAmountPlan(
+		suite.addrs[5], suite.addrs[5], parseDecCoins("1denom1"),
+		types.ParseTime("0001-01-01T00:00:00Z"), t.Add(12*time.Hour),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 86_400_000)))
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(6 * time.Hour))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 21_600_000)), suite.AllRewards(suite.addrs[0])))
+
+	// The plan streams the rewards until its end time, even though the next
+	// block is made after the end time.
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(13 * time.Hour))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 43_200_000)), suite.AllRewards(suite.addrs[0])))
+
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(24 * time.Hour))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 43_200_000)), suite.AllRewards(suite.addrs[0])))
+
+	_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestStreamRewards_HistoricalRewardsPerEpoch() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// 1000denom3 per second.
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 86_400_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	t := types.ParseTime("2022-01-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	suite.AdvanceEpoch()
+	currentEpoch := suite.keeper.GetCurrentEpoch(suite.ctx, denom1)
+
+	numRecords := func() (historical, planHistorical int) {
+		suite.keeper.IterateHistoricalRewards(suite.ctx, func(string, uint64, types.HistoricalRewards) (stop bool) {
+			historical++
+			return false
+		})
+		suite.keeper.IteratePlanHistoricalRewards(suite.ctx, func(string, uint64, uint64, types.HistoricalRewards) (stop bool) {
+			planHistorical++
+			return false
+		})
+		return
+	}
+
+	// Stream rewards every minute for the first half of the epoch.
+	// Neither the current epoch nor the number of records changes.
+	var numHistorical, numPlanHistorical int
+	for i := 1; i <= 12*60; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(t.Add(time.Duration(i) * time.Minute))
+		suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+		suite.Require().Equal(currentEpoch, suite.keeper.GetCurrentEpoch(suite.ctx, denom1))
+
+		historical, planHistorical := numRecords()
+		if i == 1 {
+			numHistorical, numPlanHistorical = historical, planHistorical
+		}
+		suite.Require().Equal(numHistorical, historical)
+		suite.Require().Equal(numPlanHistorical, planHistorical)
+	}
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 43_200_000)), suite.AllRewards(suite.addrs[0])))
+
+	// Rewards withdrawn in the middle of the epoch are not paid again, and
+	// a staking transferred in the middle of the epoch earns only the rewards
+	// streamed after the transfer.
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+	suite.Require().NoError(suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000))))
+
+	suite.ctx = suite.ctx.WithBlockTime(t.Add(24 * time.Hour))
+	suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 21_600_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 21_600_000)), suite.AllRewards(suite.addrs[1])))
+
+	// The current epoch advances only at the end of the epoch.
+	suite.AdvanceEpoch()
+	suite.Require().Equal(currentEpoch+1, suite.keeper.GetCurrentEpoch(suite.ctx, denom1))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 21_600_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 21_600_000)), suite.AllRewards(suite.addrs[1])))
+
+	_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestStreamRewards_DecayingAmountPlan() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardsStreaming = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The epoch amount is halved every epoch.
+	msg := types.NewMsgCreateDecayingAmountPlan(
+		"plan1", suite.addrs[5], sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 86_400_000)), sdk.NewDecWithPrec(5, 1), 1,
+	)
+	_, err := suite.keeper.CreateDecayingAmountPlan(suite.ctx, msg, suite.addrs[5], suite.addrs[5], types.PlanTypePublic)
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	t := types.ParseTime("2022-01-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	suite.AdvanceEpoch()
+
+	// Stream rewards every hour for two epochs.
+	for i := 1; i <= 48; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(t.Add(time.Duration(i) * time.Hour))
+		suite.Require().NoError(suite.keeper.StreamRewards(suite.ctx))
+		if i%24 == 0 {
+			suite.AdvanceEpoch()
+		}
+	}
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 129_600_000)), suite.AllRewards(suite.addrs[0])))
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(uint64(2), plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+}
+
 func (suite *KeeperTestSuite) TestAllocateRewards() {
 	for _, plan := range suite.sampleFixedAmtPlans {
 		_ = plan.SetStartTime(types.ParseTime("0001-01-01T00:00:00Z"))
@@ -231,7 +520,7 @@ func (suite *KeeperTestSuite) TestAllocateRewards() {
 	for i := 0; i < 365; i++ {
 		suite.ctx = suite.ctx.WithBlockTime(t)
 
-		err := suite.keeper.AllocateRewards(suite.ctx)
+		err := suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 		suite.Require().NoError(err)
 
 		for _, plan := range suite.sampleFixedAmtPlans {
@@ -269,6 +558,84 @@ func (suite *KeeperTestSuite) TestAllocateRewards_FixedAmountPlanAllBalances() {
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), rewards))
 }
 
+func (suite *KeeperTestSuite) TestAllocateRewards_DecayingAmountPlan() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)))
+	suite.Require().NoError(err)
+
+	// The epoch amount is halved every 2 epochs.
+	msg := types.NewMsgCreateDecayingAmountPlan(
+		"plan1",
+		farmingPoolAcc,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		sdk.NewDecWithPrec(5, 1),
+		2,
+	)
+	_, err = suite.keeper.CreateDecayingAmountPlan(suite.ctx, msg, farmingPoolAcc, farmingPoolAcc, types.PlanTypePublic)
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	// No rewards are allocated in the first epoch since there is no staked coins,
+	// but the decay schedule advances anyway.
+	suite.AdvanceEpoch()
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().EqualValues(1, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+
+	for _, expected := range []int64{1_000_000, 500_000, 500_000, 250_000, 250_000} {
+		balancesBefore := suite.app.BankKeeper.GetBalance(suite.ctx, farmingPoolAcc, denom3)
+		suite.AdvanceEpoch()
+		balancesAfter := suite.app.BankKeeper.GetBalance(suite.ctx, farmingPoolAcc, denom3)
+		suite.Require().True(intEq(sdk.NewInt(expected), balancesBefore.Amount.Sub(balancesAfter.Amount)))
+	}
+
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().EqualValues(6, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_500_000)), plan.GetDistributedCoins()))
+
+	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_500_000)), rewards))
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_DecayingAmountPlanWithoutAllocation() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+
+	// The epoch amount is halved every epoch.
+	msg := types.NewMsgCreateDecayingAmountPlan(
+		"plan1", farmingPoolAcc, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"), types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 8_000_000)), sdk.NewDecWithPrec(5, 1), 1,
+	)
+	_, err := suite.keeper.CreateDecayingAmountPlan(suite.ctx, msg, farmingPoolAcc, farmingPoolAcc, types.PlanTypePublic)
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	// The farming pool is underfunded, so nothing is allocated, but the
+	// decay schedule advances.
+	suite.AdvanceEpoch()
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().EqualValues(2, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(plan.GetDistributedCoins().IsZero())
+
+	// The decay schedule advances while rewards allocation is paused as well.
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)))
+	suite.Require().NoError(err)
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionRewardAllocation, true)
+	suite.AdvanceEpoch()
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().EqualValues(3, plan.(*types.DecayingAmountPlan).ElapsedEpochs)
+	suite.Require().True(plan.GetDistributedCoins().IsZero())
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionRewardAllocation, false)
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+}
+
 func (suite *KeeperTestSuite) TestAllocateRewards_RatioPlanAllBalances() {
 	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
 	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
@@ -370,7 +737,7 @@ func (suite *KeeperTestSuite) TestHarvest() {
 
 	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
-	err = suite.keeper.AllocateRewards(suite.ctx)
+	err = suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime())
 	suite.Require().NoError(err)
 
 	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
@@ -404,6 +771,61 @@ func (suite *KeeperTestSuite) TestMultipleHarvest() {
 	suite.Require().True(coinsEq(balancesBefore, balancesAfter))
 }
 
+func (suite *KeeperTestSuite) TestSetRewardsWithdrawAddress() {
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[5], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	// Module accounts cannot receive rewards.
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(suite.addrs[5], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	// Setting the farmer's own address removes the withdraw address.
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+	suite.keeper.IterateRewardsWithdrawAddresses(suite.ctx, func(farmerAcc, withdrawAcc sdk.AccAddress) (stop bool) {
+		suite.FailNow("there must be no withdraw address")
+		return false
+	})
+}
+
+func (suite *KeeperTestSuite) TestRewardsWithdrawAddress() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[5])
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	farmerBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	withdrawBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])
+
+	// Harvested rewards are sent to the withdraw address.
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().True(coinsEq(farmerBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		withdrawBalances.Add(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+
+	suite.AdvanceEpoch()
+
+	// Rewards withdrawn by unstaking are sent to the withdraw address, while
+	// the unstaked coins are sent to the farmer.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().True(coinsEq(
+		farmerBalances.Add(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		withdrawBalances.Add(sdk.NewInt64Coin(denom3, 2_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[5])))
+}
+
 func (suite *KeeperTestSuite) TestHistoricalRewards() {
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
 
@@ -447,6 +869,106 @@ func (suite *KeeperTestSuite) TestHistoricalRewards() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRewardsByPlan() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
+
+	// Create two plans that share same staking coin denom in their staking coin weights.
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000))},
+		{PlanId: 2, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[0], denom1))
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000))},
+		{PlanId: 2, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+		{PlanId: 2, StakingCoinDenom: denom2, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+	}, suite.keeper.AllRewardsByPlan(suite.ctx, suite.addrs[0]))
+
+	// The other farmer stakes later, so the farmer gets rewards only from the later epoch.
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().Equal([]types.PlanRewards{
+		{PlanId: 1, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000))},
+		{PlanId: 2, StakingCoinDenom: denom1, Rewards: sdk.NewCoins(sdk.NewInt64Coin(denom3, 250000))},
+	}, suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[1], denom1))
+
+	// The plans' outstanding rewards decrease as farmers withdraw rewards.
+	outstanding, found := suite.keeper.GetPlanOutstandingRewards(suite.ctx, denom1, 1)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 3000000)), outstanding.Rewards))
+
+	suite.Harvest(suite.addrs[1], []string{denom1})
+	suite.Require().Empty(suite.keeper.RewardsByPlan(suite.ctx, suite.addrs[1], denom1))
+
+	outstanding, _ = suite.keeper.GetPlanOutstandingRewards(suite.ctx, denom1, 1)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 2500000)), outstanding.Rewards))
+	outstanding, _ = suite.keeper.GetPlanOutstandingRewards(suite.ctx, denom1, 2)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1250000)), outstanding.Rewards))
+
+	_, err := suite.keeper.WithdrawAllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.keeper.IteratePlanOutstandingRewards(suite.ctx, func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool) {
+		suite.Require().True(rewards.Rewards.IsZero())
+		return false
+	})
+}
+
+func (suite *KeeperTestSuite) TestPlanHistoricalRewards() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// Plan historical rewards are recorded only for the epochs in which the plan allocated rewards.
+	for i := uint64(1); i <= 2; i++ {
+		historical, found := suite.keeper.GetPlanHistoricalRewards(suite.ctx, denom1, 1, i)
+		suite.Require().True(found)
+		suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, int64(i))), historical.CumulativeUnitRewards))
+	}
+
+	// A plan created later has no records for the earlier epochs.
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.AdvanceEpoch()
+
+	_, found := suite.keeper.GetPlanHistoricalRewards(suite.ctx, denom1, 2, 2)
+	suite.Require().False(found)
+	suite.Require().True(suite.keeper.PlanCumulativeUnitRewards(suite.ctx, denom1, 2, 2).IsZero())
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)),
+		suite.keeper.PlanCumulativeUnitRewards(suite.ctx, denom1, 2, 10)))
+
+	// The sum of all plans' cumulative unit rewards equals to the cumulative unit rewards.
+	historical, _ := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 3)
+	suite.Require().True(decCoinsEq(
+		historical.CumulativeUnitRewards,
+		suite.keeper.PlanCumulativeUnitRewards(suite.ctx, denom1, 1, 3).Add(
+			suite.keeper.PlanCumulativeUnitRewards(suite.ctx, denom1, 2, 3)...)))
+
+	// Pruning the staking coin info also deletes plan rewards records.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.keeper.PruneTotalStakings(suite.ctx)
+	suite.keeper.IteratePlanHistoricalRewards(suite.ctx, func(stakingCoinDenom string, planId uint64, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+		suite.FailNow("plan historical rewards must be deleted")
+		return false
+	})
+	suite.keeper.IteratePlanOutstandingRewards(suite.ctx, func(stakingCoinDenom string, planId uint64, rewards types.OutstandingRewards) (stop bool) {
+		suite.FailNow("plan outstanding rewards must be deleted")
+		return false
+	})
+}
+
 // Test if initialization and pruning of staking coin info work properly.
 func (suite *KeeperTestSuite) TestInitializeAndPruneStakingCoinInfo() {
 	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
@@ -521,3 +1043,78 @@ func (suite *KeeperTestSuite) TestAllocateRewardsZeroTotalStakings() {
 	_, found = suite.keeper.GetTotalStakings(suite.ctx, denom1)
 	suite.Require().False(found)
 }
+
+func (suite *KeeperTestSuite) TestExpectedPlanRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.CreateRatioPlan(suite.addrs[5], map[string]string{denom1: "1"}, "0.01")
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 3_000_000)))
+	suite.AdvanceEpoch()
+
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	stakedAmt := suite.keeper.RewardWeight(suite.ctx, suite.addrs[0], denom1)
+	expected := sdk.DecCoins{}
+	for _, planRewards := range suite.keeper.ExpectedPlanRewards(suite.ctx, denom1, stakedAmt, totalStakings.Amount) {
+		expected = expected.Add(planRewards.Rewards...)
+	}
+	suite.Require().False(expected.IsZero())
+
+	// The expected rewards are the same as the rewards actually allocated.
+	suite.AdvanceEpoch()
+	truncated, _ := expected.TruncateDecimal()
+	suite.Require().True(coinsEq(truncated, suite.AllRewards(suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestSimulateAllocation() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.3", denom2: "0.7"}, map[string]int64{denom3: 1_000_000})
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom2: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 2_000_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	poolAllocs, planAllocs, unitRewards := suite.keeper.SimulateAllocation(suite.ctx)
+
+	suite.Require().Len(poolAllocs, 2)
+	for _, poolAlloc := range poolAllocs {
+		switch poolAlloc.FarmingPoolAddress {
+		case suite.addrs[4].String():
+			suite.Require().False(poolAlloc.Skipped)
+			suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), poolAlloc.TotalAmount))
+		case suite.addrs[5].String():
+			suite.Require().True(poolAlloc.Skipped)
+			suite.Require().NotEmpty(poolAlloc.Reason)
+			suite.Require().True(coinsEq(initialBalances, poolAlloc.Balance))
+		default:
+			suite.FailNow("unexpected farming pool", poolAlloc.FarmingPoolAddress)
+		}
+	}
+
+	suite.Require().Len(planAllocs, 3)
+	planAllocsById := map[uint64]types.PlanAllocation{}
+	for _, planAlloc := range planAllocs {
+		planAllocsById[planAlloc.PlanId] = planAlloc
+	}
+	suite.Require().False(planAllocsById[1].Skipped)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), planAllocsById[1].AllocatedAmount))
+	// No coins are staked for denom2.
+	suite.Require().True(planAllocsById[2].Skipped)
+	suite.Require().True(planAllocsById[2].AllocatedAmount.IsZero())
+	// The farming pool has insufficient balance.
+	suite.Require().True(planAllocsById[3].Skipped)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000_000)), planAllocsById[3].Amount))
+
+	suite.Require().Len(unitRewards, 1)
+	suite.Require().Equal(denom1, unitRewards[0].StakingCoinDenom)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), unitRewards[0].TotalStakings))
+
+	// The simulation matches the actual allocation.
+	currentEpoch := suite.keeper.GetCurrentEpoch(suite.ctx, denom1)
+	suite.Require().NoError(suite.keeper.AllocateRewards(suite.ctx, suite.ctx.BlockTime()))
+	historical, _ := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, currentEpoch)
+	suite.Require().True(decCoinsEq(unitRewards[0].UnitRewards, historical.CumulativeUnitRewards))
+	suite.Require().True(coinsEq(
+		initialBalances.Sub(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000))),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[4])))
+}
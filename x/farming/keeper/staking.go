@@ -68,6 +68,23 @@ func (k Keeper) IterateStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddres
 	}
 }
 
+// IterateStakingsByDenom iterates through all stakings for a staking coin
+// denom stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateStakingsByDenom(ctx sdk.Context, stakingCoinDenom string, cb func(farmerAcc sdk.AccAddress, staking types.Staking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetStakingsByStakingCoinDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var staking types.Staking
+		k.cdc.MustUnmarshal(iter.Value(), &staking)
+		_, farmerAcc := types.ParseStakingKey(iter.Key())
+		if cb(farmerAcc, staking) {
+			break
+		}
+	}
+}
+
 // GetAllStakedCoinsByFarmer returns all coins that are staked by a farmer.
 func (k Keeper) GetAllStakedCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
 	stakedCoins := sdk.NewCoins()
@@ -295,12 +312,25 @@ func (k Keeper) afterStakingCoinRemoved(ctx sdk.Context, stakingCoinDenom string
 
 	k.DeleteOutstandingRewards(ctx, stakingCoinDenom)
 	k.DeleteAllHistoricalRewards(ctx, stakingCoinDenom)
+	k.DeleteAllPlanOutstandingRewards(ctx, stakingCoinDenom)
+	k.DeleteAllPlanHistoricalRewards(ctx, stakingCoinDenom)
+	k.DeleteAllStartingRewards(ctx, stakingCoinDenom)
 	return nil
 }
 
 // Stake stores staking coins to queued coins, and it will be processed in the next epoch.
 func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
-	if err := k.ReserveStakingCoins(ctx, farmerAcc, amount); err != nil {
+	return k.StakeFor(ctx, farmerAcc, farmerAcc, amount)
+}
+
+// StakeFor stores staking coins paid by the payer to queued coins of the
+// farmer, and it will be processed in the next epoch.
+// The farmer owns the staking and its rewards, so only the farmer can unstake
+// the coins later.
+// Other modules can use it to stake coins held by module accounts on behalf
+// of their users.
+func (k Keeper) StakeFor(ctx sdk.Context, payerAcc, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.ReserveStakingCoins(ctx, payerAcc, amount); err != nil {
 		return err
 	}
 
@@ -328,16 +358,26 @@ func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coin
 		sdk.NewEvent(
 			types.EventTypeStake,
 			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, payerAcc.String()),
 			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
 		),
 	})
 
+	k.AfterStaked(ctx, farmerAcc, amount)
+
 	return nil
 }
 
 // Unstake unstakes an amount of staking coins from the staking reserve account.
 // It causes accumulated rewards to be withdrawn to the farmer.
+// If the unstaking period is set, the unstaked coins that were staked are paid
+// out to the farmer after the period instead of immediately, while the
+// queued coins, which have never earned rewards, are still paid out
+// immediately.
 func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	k.BeforeUnstaked(ctx, farmerAcc, amount)
+
+	unstakedFromQueue, unstakedFromStaking := sdk.NewCoins(), sdk.NewCoins()
 	for _, coin := range amount {
 		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
 		if !found {
@@ -355,6 +395,10 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 				sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s%s", availableAmt, coin.Denom, coin.Amount, coin.Denom)
 		}
 
+		if queuedAmt := sdk.MinInt(queuedStaking.Amount, coin.Amount); queuedAmt.IsPositive() {
+			unstakedFromQueue = unstakedFromQueue.Add(sdk.NewCoin(coin.Denom, queuedAmt))
+		}
+
 		queuedStaking.Amount = queuedStaking.Amount.Sub(coin.Amount)
 		if queuedStaking.Amount.IsNegative() {
 			if _, err := k.WithdrawRewards(ctx, farmerAcc, coin.Denom); err != nil {
@@ -362,6 +406,7 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 			}
 
 			removedFromStaking := queuedStaking.Amount.Neg() // Make negative a positive
+			unstakedFromStaking = unstakedFromStaking.Add(sdk.NewCoin(coin.Denom, removedFromStaking))
 			staking.Amount = staking.Amount.Sub(removedFromStaking)
 			if staking.Amount.IsPositive() {
 				currentEpoch := k.GetCurrentEpoch(ctx, coin.Denom)
@@ -373,6 +418,8 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 
 			k.DeleteQueuedStaking(ctx, coin.Denom, farmerAcc)
 			k.DecreaseTotalStakings(ctx, coin.Denom, removedFromStaking)
+			k.updateCappedStakes(ctx, farmerAcc, coin.Denom)
+			k.updateStartingRewards(ctx, farmerAcc, coin.Denom)
 		} else if queuedStaking.Amount.IsPositive() {
 			k.SetQueuedStaking(ctx, coin.Denom, farmerAcc, queuedStaking)
 		} else {
@@ -380,8 +427,17 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 		}
 	}
 
-	if err := k.ReleaseStakingCoins(ctx, farmerAcc, amount); err != nil {
-		return err
+	released := amount
+	if unstakingPeriod := k.GetParams(ctx).UnstakingPeriod; unstakingPeriod > 0 {
+		released = unstakedFromQueue
+		if !unstakedFromStaking.IsZero() {
+			k.Unbond(ctx, farmerAcc, unstakedFromStaking, unstakingPeriod)
+		}
+	}
+	if !released.IsZero() {
+		if err := k.ReleaseStakingCoins(ctx, farmerAcc, released); err != nil {
+			return err
+		}
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -395,6 +451,101 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 	return nil
 }
 
+// TransferStaking moves an amount of staked and queued coins of a farmer to
+// a recipient.
+// Like Unstake, the queued coins are moved first. If staked coins are moved,
+// accumulated rewards of the farmer and the recipient are withdrawn first.
+// The coins stay in the staking reserve account, so the total stakings don't
+// change.
+func (k Keeper) TransferStaking(ctx sdk.Context, farmerAcc, recipientAcc sdk.AccAddress, amount sdk.Coins) error {
+	k.BeforeUnstaked(ctx, farmerAcc, amount)
+
+	for _, coin := range amount {
+		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
+		if !found {
+			staking.Amount = sdk.ZeroInt()
+		}
+
+		queuedStaking, found := k.GetQueuedStaking(ctx, coin.Denom, farmerAcc)
+		if !found {
+			queuedStaking.Amount = sdk.ZeroInt()
+		}
+
+		availableAmt := staking.Amount.Add(queuedStaking.Amount)
+		if availableAmt.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s%s", availableAmt, coin.Denom, coin.Amount, coin.Denom)
+		}
+
+		queuedAmt := sdk.MinInt(queuedStaking.Amount, coin.Amount)
+		stakedAmt := coin.Amount.Sub(queuedAmt)
+
+		if queuedAmt.IsPositive() {
+			queuedStaking.Amount = queuedStaking.Amount.Sub(queuedAmt)
+			if queuedStaking.Amount.IsPositive() {
+				k.SetQueuedStaking(ctx, coin.Denom, farmerAcc, queuedStaking)
+			} else {
+				k.DeleteQueuedStaking(ctx, coin.Denom, farmerAcc)
+			}
+
+			recipientQueuedStaking, found := k.GetQueuedStaking(ctx, coin.Denom, recipientAcc)
+			if !found {
+				recipientQueuedStaking.Amount = sdk.ZeroInt()
+			}
+			recipientQueuedStaking.Amount = recipientQueuedStaking.Amount.Add(queuedAmt)
+			k.SetQueuedStaking(ctx, coin.Denom, recipientAcc, recipientQueuedStaking)
+		}
+
+		if stakedAmt.IsPositive() {
+			if _, err := k.WithdrawRewards(ctx, farmerAcc, coin.Denom); err != nil {
+				return err
+			}
+			if k.hasRewardPositions(ctx, recipientAcc, coin.Denom) {
+				if _, err := k.WithdrawRewards(ctx, recipientAcc, coin.Denom); err != nil {
+					return err
+				}
+			}
+
+			currentEpoch := k.GetCurrentEpoch(ctx, coin.Denom)
+
+			staking.Amount = staking.Amount.Sub(stakedAmt)
+			if staking.Amount.IsPositive() {
+				staking.StartingEpoch = currentEpoch
+				k.SetStaking(ctx, coin.Denom, farmerAcc, staking)
+			} else {
+				k.DeleteStaking(ctx, coin.Denom, farmerAcc)
+			}
+
+			recipientStaking, found := k.GetStaking(ctx, coin.Denom, recipientAcc)
+			if !found {
+				recipientStaking.Amount = sdk.ZeroInt()
+			}
+			k.SetStaking(ctx, coin.Denom, recipientAcc, types.Staking{
+				Amount:        recipientStaking.Amount.Add(stakedAmt),
+				StartingEpoch: currentEpoch,
+			})
+
+			k.updateCappedStakes(ctx, farmerAcc, coin.Denom)
+			k.updateCappedStakes(ctx, recipientAcc, coin.Denom)
+			k.updateStartingRewards(ctx, farmerAcc, coin.Denom)
+			k.updateStartingRewards(ctx, recipientAcc, coin.Denom)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferStaking,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
+		),
+	})
+
+	k.AfterStaked(ctx, recipientAcc, amount)
+
+	return nil
+}
+
 // ProcessQueuedCoins moves queued coins into staked coins.
 // It causes accumulated rewards to be withdrawn to the farmer.
 func (k Keeper) ProcessQueuedCoins(ctx sdk.Context) {
@@ -414,6 +565,9 @@ func (k Keeper) ProcessQueuedCoins(ctx sdk.Context) {
 			Amount:        staking.Amount.Add(queuedStaking.Amount),
 			StartingEpoch: k.GetCurrentEpoch(ctx, stakingCoinDenom),
 		})
+		k.updateCappedStakes(ctx, farmerAcc, stakingCoinDenom)
+
+		k.AfterQueuedStakingProcessed(ctx, farmerAcc, stakingCoinDenom, queuedStaking.Amount)
 
 		return false
 	})
@@ -434,8 +588,8 @@ func (k Keeper) PruneTotalStakings(ctx sdk.Context) {
 }
 
 // ValidateStakingReservedAmount checks that the balance of
-// StakingReserveAcc greater than the amount of staked, queued coins in all
-// staking objects.
+// StakingReserveAcc greater than the amount of staked, queued, locked and
+// unbonding coins in all staking objects.
 func (k Keeper) ValidateStakingReservedAmount(ctx sdk.Context) error {
 	reservedCoins := sdk.NewCoins()
 	k.IterateStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, staking types.Staking) (stop bool) {
@@ -446,6 +600,14 @@ func (k Keeper) ValidateStakingReservedAmount(ctx sdk.Context) error {
 		reservedCoins = reservedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
 		return false
 	})
+	k.IterateLocks(ctx, func(lock types.Lock) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(lock.StakingCoinDenom, lock.Amount))
+		return false
+	})
+	k.IterateUnbondings(ctx, func(unbonding types.Unbonding) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		return false
+	})
 
 	for _, coin := range reservedCoins {
 		balanceStakingReserveAcc := k.bankKeeper.SpendableCoins(ctx, types.StakingReserveAcc(coin.Denom))
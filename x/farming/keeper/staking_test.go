@@ -4,6 +4,7 @@ import (
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming"
@@ -90,6 +91,103 @@ func (suite *KeeperTestSuite) TestStakeInAdvance() {
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.AllRewards(suite.addrs[0])))
 }
 
+func (suite *KeeperTestSuite) TestStakeFor() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	payerBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom1)
+	farmerBalanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom1)
+
+	err := suite.keeper.StakeFor(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+
+	// The payer pays the staking coins and the farmer owns the staking.
+	suite.Require().True(intEq(
+		payerBalanceBefore.Amount.SubRaw(1000000),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[0], denom1).Amount))
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1000000), queuedStaking.Amount))
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.AllRewards(suite.addrs[1])))
+
+	// Only the farmer can unstake the coins.
+	err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.Unstake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().True(intEq(
+		farmerBalanceBefore.Amount.AddRaw(1000000),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom1).Amount))
+}
+
+func (suite *KeeperTestSuite) TestTransferStaking() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// Queued coins are transferred first.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+
+	balances0 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	balances1 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	err := suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(500000), queuedStaking.Amount))
+
+	staking, _ := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(intEq(sdk.NewInt(500000), staking.Amount))
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(1500000), staking.Amount))
+
+	// The total stakings don't change, and the rewards accumulated so far are
+	// withdrawn to the both farmers.
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2000000), totalStakings.Amount))
+	suite.Require().True(coinsEq(
+		balances0.Add(sdk.NewInt64Coin(denom3, 500000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		balances1.Add(sdk.NewInt64Coin(denom3, 500000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+
+	// The rewards of addrs[1] are withdrawn when its transferred queued coins
+	// become staked.
+	balances1 = suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 250000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		balances1.Add(sdk.NewInt64Coin(denom3, 750000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(intEq(sdk.NewInt(2000000), staking.Amount))
+
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500001)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// Transferring all coins deletes the staking.
+	err = suite.keeper.TransferStaking(suite.ctx, suite.addrs[0], suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500000)))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	staking, _ = suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[2])
+	suite.Require().True(intEq(sdk.NewInt(500000), staking.Amount))
+}
+
 func (suite *KeeperTestSuite) TestUnstake() {
 	for _, tc := range []struct {
 		name            string
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetNextUnbondingIdWithUpdate increments unbonding id by one and set it.
+func (k Keeper) GetNextUnbondingIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalUnbondingId(ctx) + 1
+	k.SetGlobalUnbondingId(ctx, id)
+	return id
+}
+
+// SetGlobalUnbondingId sets the global Unbonding ID counter.
+func (k Keeper) SetGlobalUnbondingId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalUnbondingIdKey, bz)
+}
+
+// GetGlobalUnbondingId returns the global Unbonding ID counter.
+func (k Keeper) GetGlobalUnbondingId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalUnbondingIdKey)
+	if bz == nil {
+		return 0
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// GetUnbonding returns an unbonding for given unbonding id.
+func (k Keeper) GetUnbonding(ctx sdk.Context, unbondingId uint64) (unbonding types.Unbonding, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingKey(unbondingId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &unbonding)
+	found = true
+	return
+}
+
+// SetUnbonding sets an unbonding along with its indexes.
+func (k Keeper) SetUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&unbonding)
+	store.Set(types.GetUnbondingKey(unbonding.Id), bz)
+	store.Set(types.GetUnbondingIndexKey(unbonding.GetFarmer(), unbonding.StakingCoinDenom, unbonding.Id), []byte{})
+	store.Set(types.GetUnbondingByTimeKey(unbonding.CompletionTime, unbonding.Id), []byte{})
+}
+
+// DeleteUnbonding deletes an unbonding along with its indexes.
+func (k Keeper) DeleteUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingKey(unbonding.Id))
+	store.Delete(types.GetUnbondingIndexKey(unbonding.GetFarmer(), unbonding.StakingCoinDenom, unbonding.Id))
+	store.Delete(types.GetUnbondingByTimeKey(unbonding.CompletionTime, unbonding.Id))
+}
+
+// IterateUnbondings iterates through all unbondings stored in the store
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondings(ctx sdk.Context, cb func(unbonding types.Unbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UnbondingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.Unbonding
+		k.cdc.MustUnmarshal(iter.Value(), &unbonding)
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// IterateUnbondingsByFarmer iterates through all unbondings by a farmer
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(unbonding types.Unbonding) (stop bool)) {
+	k.iterateUnbondingsByIndexPrefix(ctx, types.GetUnbondingsByFarmerPrefix(farmerAcc), cb)
+}
+
+// IterateUnbondingsByFarmerAndDenom iterates through all unbondings by a
+// farmer for a staking coin denom stored in the store and invokes callback
+// function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondingsByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, cb func(unbonding types.Unbonding) (stop bool)) {
+	k.iterateUnbondingsByIndexPrefix(ctx, types.GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), cb)
+}
+
+func (k Keeper) iterateUnbondingsByIndexPrefix(ctx sdk.Context, prefix []byte, cb func(unbonding types.Unbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, unbondingId := types.ParseUnbondingIndexKey(iter.Key())
+		unbonding, _ := k.GetUnbonding(ctx, unbondingId)
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// IterateMaturedUnbondings iterates through all unbondings whose completion
+// time is not after the given time and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateMaturedUnbondings(ctx sdk.Context, t time.Time, cb func(unbonding types.Unbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.UnbondingByTimeKeyPrefix, sdk.PrefixEndBytes(types.GetUnbondingsByTimePrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, unbondingId := types.ParseUnbondingByTimeKey(iter.Key())
+		unbonding, _ := k.GetUnbonding(ctx, unbondingId)
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// GetAllUnbondingCoinsByFarmer returns all coins that are unbonding by a farmer.
+func (k Keeper) GetAllUnbondingCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
+	unbondingCoins := sdk.NewCoins()
+	k.IterateUnbondingsByFarmer(ctx, farmerAcc, func(unbonding types.Unbonding) (stop bool) {
+		unbondingCoins = unbondingCoins.Add(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		return false
+	})
+	return unbondingCoins
+}
+
+// Unbond stores unstaked coins to unbondings, which are paid out to the
+// farmer after the unstaking period.
+// The coins stay in the staking reserve until then, but no longer earn
+// rewards.
+func (k Keeper) Unbond(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins, unstakingPeriod time.Duration) {
+	completionTime := ctx.BlockTime().Add(unstakingPeriod)
+	for _, coin := range amount {
+		unbonding := types.NewUnbonding(k.GetNextUnbondingIdWithUpdate(ctx), farmerAcc, coin.Denom, coin.Amount, completionTime)
+		k.SetUnbonding(ctx, unbonding)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUnbond,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyUnbondingId, strconv.FormatUint(unbonding.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, unbonding.StakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+			),
+		})
+	}
+}
+
+// ProcessMaturedUnbondings pays out the coins of unbondings whose completion
+// time has come to the farmers.
+func (k Keeper) ProcessMaturedUnbondings(ctx sdk.Context) {
+	k.IterateMaturedUnbondings(ctx, ctx.BlockTime(), func(unbonding types.Unbonding) (stop bool) {
+		farmerAcc := unbonding.GetFarmer()
+
+		coins := sdk.NewCoins(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		if err := k.ReleaseStakingCoins(ctx, farmerAcc, coins); err != nil {
+			panic(err)
+		}
+
+		k.DeleteUnbonding(ctx, unbonding)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUnbondingCompleted,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyUnbondingId, strconv.FormatUint(unbonding.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, unbonding.StakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Amount.String()),
+			),
+		})
+
+		return false
+	})
+}
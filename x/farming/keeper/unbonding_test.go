@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming"
+	farmingkeeper "github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (suite *KeeperTestSuite) setUnstakingPeriod(unstakingPeriod time.Duration) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnstakingPeriod = unstakingPeriod
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestUnstakeWithUnstakingPeriod() {
+	suite.setUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	rewards := suite.AllRewards(suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 400_000)))
+
+	// Only the rewards are withdrawn, and the unstaked coins are unbonding.
+	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 400_000)),
+		suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom2, 600_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().Equal(uint64(2), suite.keeper.GetGlobalUnbondingId(suite.ctx))
+
+	unbonding, found := suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(denom1, unbonding.StakingCoinDenom)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), unbonding.Amount))
+	suite.Require().True(types.ParseTime("2022-01-08T00:00:00Z").Equal(unbonding.CompletionTime))
+
+	// Unbonding coins do not earn rewards.
+	suite.AdvanceEpoch()
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+
+	balancesBefore = suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-07T23:59:59Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(coinsEq(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-08T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 400_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	_, found = suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUnstakeQueuedCoinsWithUnstakingPeriod() {
+	suite.setUnstakingPeriod(7 * 24 * time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 300_000), sdk.NewInt64Coin(denom2, 200_000)))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000), sdk.NewInt64Coin(denom2, 200_000)))
+
+	// The queued coins are paid out immediately, and only the coins taken
+	// from the staking are unbonding.
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom1, 300_000), sdk.NewInt64Coin(denom2, 200_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 200_000)),
+		suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 800_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().Equal(uint64(1), suite.keeper.GetGlobalUnbondingId(suite.ctx))
+
+	// Unstaking only queued coins creates no unbonding.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 100_000)))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 100_000)))
+	suite.Require().Equal(uint64(1), suite.keeper.GetGlobalUnbondingId(suite.ctx))
+
+	_, broken := farmingkeeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestUnstakeWithoutUnstakingPeriod() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	suite.Require().Equal(uint64(0), suite.keeper.GetGlobalUnbondingId(suite.ctx))
+}
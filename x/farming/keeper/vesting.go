@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetNextRewardVestingIdWithUpdate increments reward vesting id by one and set it.
+func (k Keeper) GetNextRewardVestingIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalRewardVestingId(ctx) + 1
+	k.SetGlobalRewardVestingId(ctx, id)
+	return id
+}
+
+// SetGlobalRewardVestingId sets the global RewardVesting ID counter.
+func (k Keeper) SetGlobalRewardVestingId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalRewardVestingIdKey, bz)
+}
+
+// GetGlobalRewardVestingId returns the global RewardVesting ID counter.
+func (k Keeper) GetGlobalRewardVestingId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalRewardVestingIdKey)
+	if bz == nil {
+		return 0
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// GetRewardVesting returns a reward vesting for given vesting id.
+func (k Keeper) GetRewardVesting(ctx sdk.Context, vestingId uint64) (vesting types.RewardVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardVestingKey(vestingId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &vesting)
+	found = true
+	return
+}
+
+// SetRewardVesting sets a reward vesting along with its index.
+func (k Keeper) SetRewardVesting(ctx sdk.Context, vesting types.RewardVesting) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vesting)
+	store.Set(types.GetRewardVestingKey(vesting.Id), bz)
+	store.Set(types.GetRewardVestingIndexKey(vesting.GetFarmer(), vesting.Id), []byte{})
+}
+
+// DeleteRewardVesting deletes a reward vesting along with its index.
+func (k Keeper) DeleteRewardVesting(ctx sdk.Context, vesting types.RewardVesting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardVestingKey(vesting.Id))
+	store.Delete(types.GetRewardVestingIndexKey(vesting.GetFarmer(), vesting.Id))
+}
+
+// IterateRewardVestings iterates through all reward vestings stored in the
+// store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardVestings(ctx sdk.Context, cb func(vesting types.RewardVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardVestingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.RewardVesting
+		k.cdc.MustUnmarshal(iter.Value(), &vesting)
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+// IterateRewardVestingsByFarmer iterates through all reward vestings by a
+// farmer stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateRewardVestingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(vesting types.RewardVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetRewardVestingsByFarmerPrefix(farmerAcc))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, vestingId := types.ParseRewardVestingIndexKey(iter.Key())
+		vesting, _ := k.GetRewardVesting(ctx, vestingId)
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+// GetRewardVestingsByFarmer returns all reward vestings by a farmer.
+func (k Keeper) GetRewardVestingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) []types.RewardVesting {
+	vestings := []types.RewardVesting{}
+	k.IterateRewardVestingsByFarmer(ctx, farmerAcc, func(vesting types.RewardVesting) (stop bool) {
+		vestings = append(vestings, vesting)
+		return false
+	})
+	return vestings
+}
+
+// GetPlanVestingDuration returns the vesting duration of a plan.
+// It is kept after the plan is deleted, so that the rewards allocated by the
+// plan still vest when they are withdrawn.
+func (k Keeper) GetPlanVestingDuration(ctx sdk.Context, planId uint64) (vestingDuration time.Duration, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPlanVestingDurationKey(planId))
+	if bz == nil {
+		return
+	}
+	var val gogotypes.Duration
+	k.cdc.MustUnmarshal(bz, &val)
+	vestingDuration, err := gogotypes.DurationFromProto(&val)
+	if err != nil {
+		panic(err)
+	}
+	found = true
+	return
+}
+
+// SetPlanVestingDuration sets the vesting duration of a plan.
+func (k Keeper) SetPlanVestingDuration(ctx sdk.Context, planId uint64, vestingDuration time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(gogotypes.DurationProto(vestingDuration))
+	store.Set(types.GetPlanVestingDurationKey(planId), bz)
+}
+
+// IteratePlanVestingDurations iterates through all plan vesting durations
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePlanVestingDurations(ctx sdk.Context, cb func(planId uint64, vestingDuration time.Duration) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PlanVestingDurationKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val gogotypes.Duration
+		k.cdc.MustUnmarshal(iter.Value(), &val)
+		vestingDuration, err := gogotypes.DurationFromProto(&val)
+		if err != nil {
+			panic(err)
+		}
+		if cb(types.ParsePlanVestingDurationKey(iter.Key()), vestingDuration) {
+			break
+		}
+	}
+}
+
+// vestPlanRewards moves the rewards withdrawn from plans with a vesting
+// duration to the vesting reserve, creating a reward vesting per plan.
+// If the farmer already has a reward vesting for a plan, the rewards are
+// merged into it instead, so the farmer has at most one reward vesting per
+// plan.
+// The rewards vested from each plan are capped by the remaining rewards, since
+// the rewards are truncated per plan and in total separately.
+// It returns the rest of the rewards, which are paid out immediately.
+func (k Keeper) vestPlanRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards sdk.Coins, planRewards []types.PlanRewards) (sdk.Coins, error) {
+	rewardsByPlan := map[uint64]sdk.Coins{}
+	for _, pr := range planRewards {
+		rewardsByPlan[pr.PlanId] = rewardsByPlan[pr.PlanId].Add(pr.Rewards...)
+	}
+	// Sort map keys for deterministic execution.
+	var planIds []uint64
+	for planId := range rewardsByPlan {
+		planIds = append(planIds, planId)
+	}
+	sort.Slice(planIds, func(i, j int) bool {
+		return planIds[i] < planIds[j]
+	})
+
+	vestingsByPlan := map[uint64]types.RewardVesting{}
+	k.IterateRewardVestingsByFarmer(ctx, farmerAcc, func(vesting types.RewardVesting) (stop bool) {
+		vestingsByPlan[vesting.PlanId] = vesting
+		return false
+	})
+
+	remaining := rewards
+	vested := sdk.NewCoins()
+	for _, planId := range planIds {
+		// The vesting duration is looked up from the kept record rather than
+		// the plan, since the plan may have been deleted after termination.
+		vestingDuration, found := k.GetPlanVestingDuration(ctx, planId)
+		if !found {
+			continue
+		}
+
+		amt := sdk.NewCoins()
+		for _, coin := range rewardsByPlan[planId] {
+			amt = amt.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, remaining.AmountOf(coin.Denom))))
+		}
+		if amt.IsZero() {
+			continue
+		}
+		remaining = remaining.Sub(amt)
+		vested = vested.Add(amt...)
+
+		startTime := ctx.BlockTime()
+		endTime := startTime.Add(vestingDuration)
+		vesting, found := vestingsByPlan[planId]
+		if found {
+			vesting = vesting.Rebase(amt, startTime, endTime)
+		} else {
+			vesting = types.NewRewardVesting(k.GetNextRewardVestingIdWithUpdate(ctx), farmerAcc, planId, amt, startTime, endTime)
+		}
+		k.SetRewardVesting(ctx, vesting)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRewardsVested,
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyVestingId, strconv.FormatUint(vesting.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(planId, 10)),
+				sdk.NewAttribute(types.AttributeKeyRewardCoins, amt.String()),
+				sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+			),
+		})
+	}
+
+	if !vested.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, types.VestingReserveAcc, vested); err != nil {
+			return nil, err
+		}
+	}
+
+	return remaining, nil
+}
+
+// VestedRewards returns the reward vestings of a farmer along with the
+// amount of rewards that can be claimed now and the amount that is still
+// locked.
+func (k Keeper) VestedRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (vestings []types.RewardVesting, vested, unvested sdk.Coins) {
+	vestings = k.GetRewardVestingsByFarmer(ctx, farmerAcc)
+	vested, unvested = sdk.NewCoins(), sdk.NewCoins()
+	for _, vesting := range vestings {
+		claimable := vesting.ClaimableAmount(ctx.BlockTime())
+		vested = vested.Add(claimable...)
+		unvested = unvested.Add(vesting.UnclaimedAmount().Sub(claimable)...)
+	}
+	return vestings, vested, unvested
+}
+
+// ClaimVested pays out all vested rewards of a farmer that have not been
+// claimed yet to the farmer's rewards withdraw address.
+// Reward vestings that have been claimed in full are deleted.
+func (k Keeper) ClaimVested(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
+	claimed := sdk.NewCoins()
+	for _, vesting := range k.GetRewardVestingsByFarmer(ctx, farmerAcc) {
+		claimable := vesting.ClaimableAmount(ctx.BlockTime())
+		if claimable.IsZero() {
+			continue
+		}
+		claimed = claimed.Add(claimable...)
+
+		vesting.ClaimedAmount = vesting.ClaimedAmount.Add(claimable...)
+		if vesting.UnclaimedAmount().IsZero() {
+			k.DeleteRewardVesting(ctx, vesting)
+		} else {
+			k.SetRewardVesting(ctx, vesting)
+		}
+	}
+	if claimed.IsZero() {
+		return nil, types.ErrNoVestedRewards
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.VestingReserveAcc, k.GetRewardsWithdrawAddress(ctx, farmerAcc), claimed); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVested,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, claimed.String()),
+		),
+	})
+
+	k.AfterRewardsWithdrawn(ctx, farmerAcc, claimed)
+
+	return claimed, nil
+}
+
+// ValidateVestingReservedAmount checks that the balance of the vesting
+// reserve is greater than the total amount of unclaimed rewards in all
+// reward vestings.
+func (k Keeper) ValidateVestingReservedAmount(ctx sdk.Context) error {
+	totalUnclaimed := sdk.NewCoins()
+	k.IterateRewardVestings(ctx, func(vesting types.RewardVesting) (stop bool) {
+		totalUnclaimed = totalUnclaimed.Add(vesting.UnclaimedAmount()...)
+		return false
+	})
+
+	balances := k.bankKeeper.SpendableCoins(ctx, types.VestingReserveAcc)
+	if !balances.IsAllGTE(totalUnclaimed) {
+		return types.ErrInvalidVestingReserveAmount
+	}
+
+	return nil
+}
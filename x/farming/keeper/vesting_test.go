@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (suite *KeeperTestSuite) createVestingPlan(farmingPoolAcc sdk.AccAddress, vestingDuration time.Duration) {
+	msg := types.NewMsgCreateFixedAmountPlan(
+		"vesting-plan",
+		farmingPoolAcc,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	)
+	msg.VestingDuration = vestingDuration
+	_, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, msg, farmingPoolAcc, farmingPoolAcc, types.PlanTypePublic)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestHarvestWithVesting() {
+	suite.createVestingPlan(suite.addrs[4], 10*24*time.Hour)
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 500_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	// Only the rewards from the plan without a vesting duration are paid out.
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 500_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	vestings := suite.keeper.GetRewardVestingsByFarmer(suite.ctx, suite.addrs[0])
+	suite.Require().Len(vestings, 1)
+	suite.Require().Equal(uint64(1), vestings[0].PlanId)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), vestings[0].TotalAmount))
+	suite.Require().True(types.ParseTime("2022-01-11T00:00:00Z").Equal(vestings[0].EndTime))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, types.VestingReserveAcc)))
+}
+
+func (suite *KeeperTestSuite) TestHarvestWithVesting_DeletedPlan() {
+	suite.createVestingPlan(suite.addrs[4], 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// The public plan is deleted on termination.
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+	_, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().False(found)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	// The rewards from the deleted plan still vest.
+	suite.Require().True(coinsEq(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+	vestings := suite.keeper.GetRewardVestingsByFarmer(suite.ctx, suite.addrs[0])
+	suite.Require().Len(vestings, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), vestings[0].TotalAmount))
+	suite.Require().True(types.ParseTime("2022-01-11T00:00:00Z").Equal(vestings[0].EndTime))
+}
+
+func (suite *KeeperTestSuite) TestHarvestWithVesting_Merge() {
+	suite.createVestingPlan(suite.addrs[4], 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-03T00:00:00Z"))
+	_, err := suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-05T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	// The rewards are merged into the existing reward vesting of the plan.
+	vestings := suite.keeper.GetRewardVestingsByFarmer(suite.ctx, suite.addrs[0])
+	suite.Require().Len(vestings, 1)
+	suite.Require().Equal(uint64(1), vestings[0].Id)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_800_000)), vestings[0].TotalAmount))
+	suite.Require().True(vestings[0].ClaimedAmount.IsZero())
+	suite.Require().True(types.ParseTime("2022-01-05T00:00:00Z").Equal(vestings[0].StartTime))
+	suite.Require().True(types.ParseTime("2022-01-15T00:00:00Z").Equal(vestings[0].EndTime))
+	suite.Require().Equal(uint64(1), suite.keeper.GetGlobalRewardVestingId(suite.ctx))
+
+	// The rewards that had unlocked before the merge are still claimable.
+	_, vested, unvested := suite.keeper.VestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 200_000)), vested))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_600_000)), unvested))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_800_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, types.VestingReserveAcc)))
+}
+
+func (suite *KeeperTestSuite) TestClaimVested() {
+	suite.createVestingPlan(suite.addrs[4], 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	// Nothing has vested yet.
+	_, err := suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().ErrorIs(err, types.ErrNoVestedRewards)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-04T00:00:00Z"))
+	_, vested, unvested := suite.keeper.VestedRewards(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), vested))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), unvested))
+
+	claimed, err := suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), claimed))
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 300_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	vesting, found := suite.keeper.GetRewardVesting(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 300_000)), vesting.ClaimedAmount))
+
+	// Claiming again at the same time has nothing to claim.
+	_, err = suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().ErrorIs(err, types.ErrNoVestedRewards)
+
+	// After the vesting ends, the rest is claimed and the vesting is deleted.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-11T00:00:00Z"))
+	claimed, err = suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), claimed))
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	_, found = suite.keeper.GetRewardVesting(suite.ctx, 1)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.keeper.GetRewardVestingsByFarmer(suite.ctx, suite.addrs[0]))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.VestingReserveAcc).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestClaimVestedWithdrawAddress() {
+	suite.createVestingPlan(suite.addrs[4], 24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-02T00:00:00Z"))
+	_, err = suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		balancesBefore.Add(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestClaimVestedPaused() {
+	suite.createVestingPlan(suite.addrs[4], 24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-02T00:00:00Z"))
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionHarvest, true)
+
+	_, err := suite.msgServer.ClaimVested(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimVested(suite.addrs[0]))
+	suite.Require().ErrorIs(err, types.ErrFunctionPaused)
+
+	suite.keeper.SetFunctionPaused(suite.ctx, types.PausableFunctionHarvest, false)
+
+	_, err = suite.msgServer.ClaimVested(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimVested(suite.addrs[0]))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRewardVestingsGenesis() {
+	suite.createVestingPlan(suite.addrs[4], 10*24*time.Hour)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-01T00:00:00Z"))
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2022-01-04T00:00:00Z"))
+	_, err := suite.keeper.ClaimVested(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(uint64(1), genState.GlobalRewardVestingId)
+	suite.Require().Len(genState.RewardVestings, 1)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+}
@@ -0,0 +1,83 @@
+// Package v1 contains the store keys and the key functions of the farming
+// module's store version 1, which are needed to migrate the store.
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// keys for farming store prefixes
+var (
+	GlobalPlanIdKey     = []byte("globalPlanId")
+	LastEpochTimeKey    = []byte("lastEpochTime")
+	CurrentEpochDaysKey = []byte("currentEpochDays")
+
+	PlanKeyPrefix = []byte{0x11}
+
+	StakingKeyPrefix            = []byte{0x21}
+	StakingIndexKeyPrefix       = []byte{0x22}
+	QueuedStakingKeyPrefix      = []byte{0x23}
+	QueuedStakingIndexKeyPrefix = []byte{0x24}
+	TotalStakingKeyPrefix       = []byte{0x25}
+
+	HistoricalRewardsKeyPrefix  = []byte{0x31}
+	CurrentEpochKeyPrefix       = []byte{0x32}
+	OutstandingRewardsKeyPrefix = []byte{0x33}
+)
+
+// Parameter store keys
+var (
+	KeyPrivatePlanCreationFee = []byte("PrivatePlanCreationFee")
+	KeyNextEpochDays          = []byte("NextEpochDays")
+	KeyFarmingFeeCollector    = []byte("FarmingFeeCollector")
+	KeyDelayedStakingGasFee   = []byte("DelayedStakingGasFee")
+	KeyMaxNumPrivatePlans     = []byte("MaxNumPrivatePlans")
+)
+
+// GetPlanKey returns kv indexing key of the plan
+func GetPlanKey(planID uint64) []byte {
+	return append(PlanKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
+}
+
+// GetStakingKey returns a key for staking of corresponding the id
+func GetStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
+	return append(append(StakingKeyPrefix, types.LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
+}
+
+// GetStakingIndexKey returns an indexing key for a staking.
+func GetStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(append(StakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...), []byte(stakingCoinDenom)...)
+}
+
+// GetQueuedStakingKey returns a key for a queued staking.
+func GetQueuedStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
+	return append(append(QueuedStakingKeyPrefix, types.LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
+}
+
+// GetQueuedStakingIndexKey returns an indexing key for a queuded staking.
+func GetQueuedStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(append(QueuedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...), []byte(stakingCoinDenom)...)
+}
+
+// GetTotalStakingsKey returns a key for a total stakings info.
+func GetTotalStakingsKey(stakingCoinDenom string) []byte {
+	return append(TotalStakingKeyPrefix, []byte(stakingCoinDenom)...)
+}
+
+// GetHistoricalRewardsKey returns a key for a historical rewards record.
+func GetHistoricalRewardsKey(stakingCoinDenom string, epoch uint64) []byte {
+	return append(append(HistoricalRewardsKeyPrefix, types.LengthPrefixString(stakingCoinDenom)...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetCurrentEpochKey returns a key for a current epoch info.
+func GetCurrentEpochKey(stakingCoinDenom string) []byte {
+	return append(CurrentEpochKeyPrefix, []byte(stakingCoinDenom)...)
+}
+
+// GetOutstandingRewardsKey returns a key for an outstanding rewards record.
+func GetOutstandingRewardsKey(stakingCoinDenom string) []byte {
+	return append(OutstandingRewardsKeyPrefix, []byte(stakingCoinDenom)...)
+}
@@ -33,13 +33,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &sB)
 			return fmt.Sprintf("%v\n%v", sA, sB)
 
-		case bytes.Equal(kvA.Key[:1], types.HistoricalRewardsKeyPrefix):
+		case bytes.Equal(kvA.Key[:1], types.LockKeyPrefix):
+			var lA, lB types.Lock
+			cdc.MustUnmarshal(kvA.Value, &lA)
+			cdc.MustUnmarshal(kvB.Value, &lB)
+			return fmt.Sprintf("%v\n%v", lA, lB)
+
+		case bytes.Equal(kvA.Key[:1], types.HistoricalRewardsKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PlanHistoricalRewardsKeyPrefix):
 			var rA, rB types.HistoricalRewards
 			cdc.MustUnmarshal(kvA.Value, &rA)
 			cdc.MustUnmarshal(kvB.Value, &rB)
 			return fmt.Sprintf("%v\n%v", rA, rB)
 
-		case bytes.Equal(kvA.Key[:1], types.OutstandingRewardsKeyPrefix):
+		case bytes.Equal(kvA.Key[:1], types.OutstandingRewardsKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PlanOutstandingRewardsKeyPrefix):
 			var rA, rB types.OutstandingRewards
 			cdc.MustUnmarshal(kvA.Value, &rA)
 			cdc.MustUnmarshal(kvB.Value, &rB)
@@ -20,6 +20,7 @@ func TestDecodeFarmingStore(t *testing.T) {
 	basePlan := types.BasePlan{}
 	staking := types.Staking{}
 	queuedStaking := types.QueuedStaking{}
+	lock := types.Lock{}
 	historicalRewards := types.HistoricalRewards{}
 	outstandingRewards := types.OutstandingRewards{}
 
@@ -28,8 +29,11 @@ func TestDecodeFarmingStore(t *testing.T) {
 			{Key: types.PlanKeyPrefix, Value: cdc.MustMarshal(&basePlan)},
 			{Key: types.StakingKeyPrefix, Value: cdc.MustMarshal(&staking)},
 			{Key: types.QueuedStakingKeyPrefix, Value: cdc.MustMarshal(&queuedStaking)},
+			{Key: types.LockKeyPrefix, Value: cdc.MustMarshal(&lock)},
 			{Key: types.HistoricalRewardsKeyPrefix, Value: cdc.MustMarshal(&historicalRewards)},
 			{Key: types.OutstandingRewardsKeyPrefix, Value: cdc.MustMarshal(&outstandingRewards)},
+			{Key: types.PlanHistoricalRewardsKeyPrefix, Value: cdc.MustMarshal(&historicalRewards)},
+			{Key: types.PlanOutstandingRewardsKeyPrefix, Value: cdc.MustMarshal(&outstandingRewards)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -41,8 +45,11 @@ func TestDecodeFarmingStore(t *testing.T) {
 		{"Plan", fmt.Sprintf("%v\n%v", basePlan, basePlan)},
 		{"Staking", fmt.Sprintf("%v\n%v", staking, staking)},
 		{"QueuedStaking", fmt.Sprintf("%v\n%v", queuedStaking, queuedStaking)},
+		{"Lock", fmt.Sprintf("%v\n%v", lock, lock)},
 		{"HistoricalRewardsKeyPrefix", fmt.Sprintf("%v\n%v", historicalRewards, historicalRewards)},
 		{"OutstandingRewardsKeyPrefix", fmt.Sprintf("%v\n%v", outstandingRewards, outstandingRewards)},
+		{"PlanHistoricalRewardsKeyPrefix", fmt.Sprintf("%v\n%v", historicalRewards, historicalRewards)},
+		{"PlanOutstandingRewardsKeyPrefix", fmt.Sprintf("%v\n%v", outstandingRewards, outstandingRewards)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -4,6 +4,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -15,10 +16,15 @@ import (
 // Simulation parameter constants.
 const (
 	PrivatePlanCreationFee = "private_plan_creation_fee"
-	NextEpochDays          = "next_epoch_days"
+	NextEpochDuration      = "next_epoch_duration"
 	FarmingFeeCollector    = "farming_fee_collector"
-	CurrentEpochDays       = "current_epoch_days"
+	CurrentEpochDuration   = "current_epoch_duration"
 	MaxNumPrivatePlans     = "max_num_private_plans"
+	AllocationPolicy       = "allocation_policy"
+	RewardsStreaming       = "rewards_streaming"
+	MaxCatchUpEpochs       = "max_catch_up_epochs"
+	UnstakingPeriod        = "unstaking_period"
+	AutoCompoundGasPrice   = "auto_compound_gas_price"
 )
 
 // GenPrivatePlanCreationFee return randomized private plan creation fee.
@@ -26,14 +32,16 @@ func GenPrivatePlanCreationFee(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 0, 100_000_000))))
 }
 
-// GenNextEpochDays return default next epoch days.
-func GenNextEpochDays(r *rand.Rand) uint32 {
-	return uint32(simulation.RandIntBetween(r, int(types.DefaultNextEpochDays), 10))
+// GenNextEpochDuration returns a randomized value for NextEpochDuration param,
+// which ranges from an hour to 10 days.
+func GenNextEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 240)) * time.Hour
 }
 
-// GenCurrentEpochDays returns current epoch days.
-func GenCurrentEpochDays(r *rand.Rand) uint32 {
-	return uint32(simulation.RandIntBetween(r, int(types.DefaultCurrentEpochDays), 10))
+// GenCurrentEpochDuration returns a randomized current epoch duration,
+// which ranges from an hour to 10 days.
+func GenCurrentEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 240)) * time.Hour
 }
 
 // GenFarmingFeeCollector returns default farming fee collector.
@@ -46,6 +54,33 @@ func GenMaxNumPrivatePlans(r *rand.Rand) uint32 {
 	return uint32(simulation.RandIntBetween(r, 1, 10000))
 }
 
+// GenAllocationPolicy returns a randomized value for AllocationPolicy param.
+func GenAllocationPolicy(r *rand.Rand) types.AllocationPolicy {
+	return types.AllocationPolicy(simulation.RandIntBetween(r, int(types.AllocationPolicySkipAll), int(types.AllocationPolicyPriority)+1))
+}
+
+// GenRewardsStreaming returns a randomized value for RewardsStreaming param.
+func GenRewardsStreaming(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMaxCatchUpEpochs returns a randomized value for MaxCatchUpEpochs param.
+func GenMaxCatchUpEpochs(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 0, 100))
+}
+
+// GenUnstakingPeriod returns a randomized value for UnstakingPeriod param,
+// which ranges from zero to 7 days.
+func GenUnstakingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 168)) * time.Hour
+}
+
+// GenAutoCompoundGasPrice returns a randomized value for AutoCompoundGasPrice
+// param, which ranges from 0.001 to 0.1 per gas.
+func GenAutoCompoundGasPrice(r *rand.Rand) sdk.DecCoins {
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 3)))
+}
+
 // RandomizedGenState generates a random GenesisState for farming.
 func RandomizedGenState(simState *module.SimulationState) {
 	var privatePlanCreationFee sdk.Coins
@@ -54,10 +89,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { privatePlanCreationFee = GenPrivatePlanCreationFee(r) },
 	)
 
-	var nextEpochDays uint32
+	var nextEpochDuration time.Duration
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, NextEpochDays, &nextEpochDays, simState.Rand,
-		func(r *rand.Rand) { nextEpochDays = GenNextEpochDays(r) },
+		simState.Cdc, NextEpochDuration, &nextEpochDuration, simState.Rand,
+		func(r *rand.Rand) { nextEpochDuration = GenNextEpochDuration(r) },
 	)
 
 	var feeCollector string
@@ -66,10 +101,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feeCollector = GenFarmingFeeCollector(r) },
 	)
 
-	var currentEpochDays uint32
+	var currentEpochDuration time.Duration
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, CurrentEpochDays, &currentEpochDays, simState.Rand,
-		func(r *rand.Rand) { currentEpochDays = GenCurrentEpochDays(r) },
+		simState.Cdc, CurrentEpochDuration, &currentEpochDuration, simState.Rand,
+		func(r *rand.Rand) { currentEpochDuration = GenCurrentEpochDuration(r) },
 	)
 
 	var maxNumPrivatePlans uint32
@@ -78,14 +113,49 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxNumPrivatePlans = GenMaxNumPrivatePlans(r) },
 	)
 
+	var allocationPolicy types.AllocationPolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AllocationPolicy, &allocationPolicy, simState.Rand,
+		func(r *rand.Rand) { allocationPolicy = GenAllocationPolicy(r) },
+	)
+
+	var rewardsStreaming bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardsStreaming, &rewardsStreaming, simState.Rand,
+		func(r *rand.Rand) { rewardsStreaming = GenRewardsStreaming(r) },
+	)
+
+	var maxCatchUpEpochs uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCatchUpEpochs, &maxCatchUpEpochs, simState.Rand,
+		func(r *rand.Rand) { maxCatchUpEpochs = GenMaxCatchUpEpochs(r) },
+	)
+
+	var unstakingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnstakingPeriod, &unstakingPeriod, simState.Rand,
+		func(r *rand.Rand) { unstakingPeriod = GenUnstakingPeriod(r) },
+	)
+
+	var autoCompoundGasPrice sdk.DecCoins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundGasPrice, &autoCompoundGasPrice, simState.Rand,
+		func(r *rand.Rand) { autoCompoundGasPrice = GenAutoCompoundGasPrice(r) },
+	)
+
 	farmingGenesis := types.GenesisState{
 		Params: types.Params{
 			PrivatePlanCreationFee: privatePlanCreationFee,
-			NextEpochDays:          nextEpochDays,
+			NextEpochDuration:      nextEpochDuration,
 			FarmingFeeCollector:    feeCollector,
 			MaxNumPrivatePlans:     maxNumPrivatePlans,
+			AllocationPolicy:       allocationPolicy,
+			RewardsStreaming:       rewardsStreaming,
+			MaxCatchUpEpochs:       maxCatchUpEpochs,
+			UnstakingPeriod:        unstakingPeriod,
+			AutoCompoundGasPrice:   autoCompoundGasPrice,
 		},
-		CurrentEpochDays: currentEpochDays,
+		CurrentEpochDuration: currentEpochDuration,
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&farmingGenesis)
 }
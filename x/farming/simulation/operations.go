@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,12 +19,19 @@ import (
 
 // Simulation operation weights constants.
 const (
-	OpWeightMsgCreateFixedAmountPlan = "op_weight_msg_create_fixed_amount_plan"
-	OpWeightMsgCreateRatioPlan       = "op_weight_msg_create_ratio_plan"
-	OpWeightMsgStake                 = "op_weight_msg_stake"
-	OpWeightMsgUnstake               = "op_weight_msg_unstake"
-	OpWeightMsgHarvest               = "op_weight_msg_harvest"
-	OpWeightMsgRemovePlan            = "op_weight_msg_remove_plan"
+	OpWeightMsgCreateFixedAmountPlan     = "op_weight_msg_create_fixed_amount_plan"
+	OpWeightMsgCreateRatioPlan           = "op_weight_msg_create_ratio_plan"
+	OpWeightMsgCreateDecayingAmountPlan  = "op_weight_msg_create_decaying_amount_plan"
+	OpWeightMsgStake                     = "op_weight_msg_stake"
+	OpWeightMsgStakeFor                  = "op_weight_msg_stake_for"
+	OpWeightMsgUnstake                   = "op_weight_msg_unstake"
+	OpWeightMsgTransferStaking           = "op_weight_msg_transfer_staking"
+	OpWeightMsgHarvest                   = "op_weight_msg_harvest"
+	OpWeightMsgRemovePlan                = "op_weight_msg_remove_plan"
+	OpWeightMsgModifyPrivatePlan         = "op_weight_msg_modify_private_plan"
+	OpWeightMsgSetAutoCompound           = "op_weight_msg_set_auto_compound"
+	OpWeightMsgSetRewardsWithdrawAddress = "op_weight_msg_set_rewards_withdraw_address"
+	OpWeightMsgClaimVested               = "op_weight_msg_claim_vested"
 )
 
 var (
@@ -64,6 +72,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCreateDecayingAmountPlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDecayingAmountPlan, &weightMsgCreateDecayingAmountPlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDecayingAmountPlan = params.DefaultWeightMsgCreateDecayingAmountPlan
+		},
+	)
+
 	var weightMsgStake int
 	appParams.GetOrGenerate(cdc, OpWeightMsgStake, &weightMsgStake, nil,
 		func(_ *rand.Rand) {
@@ -71,6 +86,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgStakeFor int
+	appParams.GetOrGenerate(cdc, OpWeightMsgStakeFor, &weightMsgStakeFor, nil,
+		func(_ *rand.Rand) {
+			weightMsgStakeFor = params.DefaultWeightMsgStakeFor
+		},
+	)
+
 	var weightMsgUnstake int
 	appParams.GetOrGenerate(cdc, OpWeightMsgUnstake, &weightMsgUnstake, nil,
 		func(_ *rand.Rand) {
@@ -78,6 +100,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgTransferStaking int
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferStaking, &weightMsgTransferStaking, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferStaking = params.DefaultWeightMsgTransferStaking
+		},
+	)
+
 	var weightMsgHarvest int
 	appParams.GetOrGenerate(cdc, OpWeightMsgHarvest, &weightMsgHarvest, nil,
 		func(_ *rand.Rand) {
@@ -92,6 +121,34 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgModifyPrivatePlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgModifyPrivatePlan, &weightMsgModifyPrivatePlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgModifyPrivatePlan = params.DefaultWeightMsgModifyPrivatePlan
+		},
+	)
+
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = params.DefaultWeightMsgSetAutoCompound
+		},
+	)
+
+	var weightMsgSetRewardsWithdrawAddress int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetRewardsWithdrawAddress, &weightMsgSetRewardsWithdrawAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetRewardsWithdrawAddress = params.DefaultWeightMsgSetRewardsWithdrawAddress
+		},
+	)
+
+	var weightMsgClaimVested int
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaimVested, &weightMsgClaimVested, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimVested = params.DefaultWeightMsgClaimVested
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateFixedAmountPlan,
@@ -101,14 +158,26 @@ func WeightedOperations(
 			weightMsgCreateRatioPlan,
 			SimulateMsgCreateRatioPlan(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateDecayingAmountPlan,
+			SimulateMsgCreateDecayingAmountPlan(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgStake,
 			SimulateMsgStake(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgStakeFor,
+			SimulateMsgStakeFor(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgUnstake,
 			SimulateMsgUnstake(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferStaking,
+			SimulateMsgTransferStaking(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgHarvest,
 			SimulateMsgHarvest(ak, bk, k),
@@ -117,6 +186,22 @@ func WeightedOperations(
 			weightMsgRemovePlan,
 			SimulateMsgRemovePlan(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgModifyPrivatePlan,
+			SimulateMsgModifyPrivatePlan(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetRewardsWithdrawAddress,
+			SimulateMsgSetRewardsWithdrawAddress(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimVested,
+			SimulateMsgClaimVested(ak, bk, k),
+		),
 	}
 }
 
@@ -163,6 +248,12 @@ func SimulateMsgCreateFixedAmountPlan(ak types.AccountKeeper, bk types.BankKeepe
 			endTime,
 			epochAmount,
 		)
+		if r.Intn(2) == 0 {
+			msg.VestingDuration = time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour
+		}
+		if r.Intn(2) == 0 {
+			msg.MaxStakePerFarmer = sdk.NewInt(int64(simtypes.RandIntBetween(r, 1_000_000, 100_000_000)))
+		}
 
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -244,6 +335,73 @@ func SimulateMsgCreateRatioPlan(ak types.AccountKeeper, bk types.BankKeeper, k k
 	}
 }
 
+// SimulateMsgCreateDecayingAmountPlan generates a MsgCreateDecayingAmountPlan with random values
+// nolint: interfacer
+func SimulateMsgCreateDecayingAmountPlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		params := k.GetParams(ctx)
+		if uint32(k.GetNumActivePrivatePlans(ctx)) > params.MaxNumPrivatePlans {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDecayingAmountPlan, "maximum number of private plans reached"), nil, nil
+		}
+
+		_, hasNeg := spendable.SafeSub(params.PrivatePlanCreationFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDecayingAmountPlan, "insufficient balance for plan creation fee"), nil, nil
+		}
+
+		name := "simulation-test-" + simtypes.RandStringOfLength(r, 5) // name must be unique
+		creatorAcc := account.GetAddress()
+		// mint pool coins to simulate the real-world cases
+		funds, err := fundBalances(ctx, r, bk, creatorAcc, testCoinDenoms)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDecayingAmountPlan, "unable to mint pool coins"), nil, nil
+		}
+		stakingCoinWeights := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1))
+		startTime := ctx.BlockTime()
+		endTime := startTime.AddDate(1, 0, 0)
+		epochAmount := sdk.NewCoins(
+			sdk.NewInt64Coin(funds[r.Intn(3)].Denom, int64(simtypes.RandIntBetween(r, 10_000_000, 1_000_000_000))),
+		)
+		decayFactor := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+		decayPeriod := uint32(simtypes.RandIntBetween(r, 1, 10))
+
+		msg := types.NewMsgCreateDecayingAmountPlan(
+			name,
+			creatorAcc,
+			stakingCoinWeights,
+			startTime,
+			endTime,
+			epochAmount,
+			decayFactor,
+			decayPeriod,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgStake generates a MsgStake with random values
 // nolint: interfacer
 func SimulateMsgStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -283,6 +441,45 @@ func SimulateMsgStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keep
 	}
 }
 
+// SimulateMsgStakeFor generates a MsgStakeFor with random values
+// nolint: interfacer
+func SimulateMsgStakeFor(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		farmerAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		stakingCoins := sdk.NewCoins(
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1_000_000, 1_000_000_000))),
+		)
+		if !spendable.IsAllGTE(stakingCoins) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeFor, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgStakeFor(account.GetAddress(), farmerAccount.Address, stakingCoins)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgUnstake generates a SimulateMsgUnstake with random values
 // nolint: interfacer
 func SimulateMsgUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -346,6 +543,51 @@ func SimulateMsgUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 	}
 }
 
+// SimulateMsgTransferStaking generates a MsgTransferStaking with random values
+// nolint: interfacer
+func SimulateMsgTransferStaking(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		recipientAccount, _ := simtypes.RandomAcc(r, accs)
+		if simAccount.Address.Equals(recipientAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferStaking, "recipient is the same as the farmer"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		farmer := account.GetAddress()
+		stakingCoins := sdk.NewCoins(
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1_000_000, 100_000_000))),
+		)
+
+		// sum of staked and queued coins must be greater than transferring coins
+		stakedCoins := k.GetAllStakedCoinsByFarmer(ctx, farmer).Add(k.GetAllQueuedCoinsByFarmer(ctx, farmer)...)
+		if !stakedCoins.IsAllGTE(stakingCoins) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferStaking, "insufficient staking"), nil, nil
+		}
+
+		msg := types.NewMsgTransferStaking(farmer, recipientAccount.Address, stakingCoins)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgHarvest generates a MsgHarvest with random values
 // nolint: interfacer
 func SimulateMsgHarvest(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -445,6 +687,179 @@ func SimulateMsgRemovePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 	}
 }
 
+// SimulateMsgModifyPrivatePlan generates a MsgModifyPrivatePlan with random values
+func SimulateMsgModifyPrivatePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var livePlans []types.PlanI
+		for _, plan := range k.GetPlans(ctx) {
+			if plan.GetType() != types.PlanTypePrivate || plan.IsTerminated() {
+				continue
+			}
+			if _, found := simtypes.FindAccount(accs, plan.GetTerminationAddress()); found {
+				livePlans = append(livePlans, plan)
+			}
+		}
+		if len(livePlans) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgModifyPrivatePlan, "no live private plans to modify"), nil, nil
+		}
+
+		// Select a random live private plan.
+		plan := livePlans[simtypes.RandIntBetween(r, 0, len(livePlans))]
+		simAccount, _ := simtypes.FindAccount(accs, plan.GetTerminationAddress())
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// Extend the end time of the plan, and change the epoch amount if
+		// the plan is a fixed amount plan.
+		endTime := plan.GetEndTime().AddDate(0, simtypes.RandIntBetween(r, 1, 12), 0)
+		var epochAmount sdk.Coins
+		if fixedPlan, ok := plan.(*types.FixedAmountPlan); ok {
+			for _, coin := range fixedPlan.EpochAmount {
+				epochAmount = epochAmount.Add(
+					sdk.NewInt64Coin(coin.Denom, int64(simtypes.RandIntBetween(r, 10_000_000, 1_000_000_000))))
+			}
+		}
+
+		msg := types.NewMsgModifyPrivatePlan(
+			account.GetAddress(), plan.GetId(), nil, &endTime, epochAmount, sdk.Dec{}, sdk.Dec{}, 0)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values
+func SimulateMsgSetAutoCompound(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// Toggle the current setting of the farmer.
+		enabled := !k.GetAutoCompound(ctx, simAccount.Address)
+		if enabled &&
+			k.GetAllStakedCoinsByFarmer(ctx, simAccount.Address).IsZero() &&
+			k.GetAllQueuedCoinsByFarmer(ctx, simAccount.Address).IsZero() &&
+			k.GetAllLockedCoinsByFarmer(ctx, simAccount.Address).IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "no staking position to auto-compound"), nil, nil
+		}
+
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, enabled)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetRewardsWithdrawAddress generates a MsgSetRewardsWithdrawAddress with random values
+func SimulateMsgSetRewardsWithdrawAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		withdrawAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgSetRewardsWithdrawAddress(simAccount.Address, withdrawAccount.Address)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgClaimVested generates a MsgClaimVested with random values
+// nolint: interfacer
+func SimulateMsgClaimVested(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var simAccount simtypes.Account
+
+		skip := true
+		// find vested rewards from the simulated accounts
+		for _, acc := range accs {
+			_, vested, _ := k.VestedRewards(ctx, acc.Address)
+			if !vested.IsZero() {
+				simAccount = acc
+				skip = false
+				break
+			}
+		}
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaimVested, "no account to claim vested rewards"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgClaimVested(simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // fundBalances mints random amount of coins with the provided coin denoms and
 // send them to the simulated account.
 func fundBalances(ctx sdk.Context, r *rand.Rand, bk types.BankKeeper, acc sdk.AccAddress, denoms []string) (mintCoins sdk.Coins, err error) {
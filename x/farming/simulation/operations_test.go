@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -42,10 +43,17 @@ func TestWeightedOperations(t *testing.T) {
 	}{
 		{params.DefaultWeightMsgCreateFixedAmountPlan, types.ModuleName, types.TypeMsgCreateFixedAmountPlan},
 		{params.DefaultWeightMsgCreateRatioPlan, types.ModuleName, types.TypeMsgCreateRatioPlan},
+		{params.DefaultWeightMsgCreateDecayingAmountPlan, types.ModuleName, types.TypeMsgCreateDecayingAmountPlan},
 		{params.DefaultWeightMsgStake, types.ModuleName, types.TypeMsgStake},
+		{params.DefaultWeightMsgStakeFor, types.ModuleName, types.TypeMsgStakeFor},
 		{params.DefaultWeightMsgUnstake, types.ModuleName, types.TypeMsgUnstake},
+		{params.DefaultWeightMsgTransferStaking, types.ModuleName, types.TypeMsgTransferStaking},
 		{params.DefaultWeightMsgHarvest, types.ModuleName, types.TypeMsgHarvest},
 		{params.DefaultWeightMsgRemovePlan, types.ModuleName, types.TypeMsgRemovePlan},
+		{params.DefaultWeightMsgModifyPrivatePlan, types.ModuleName, types.TypeMsgModifyPrivatePlan},
+		{params.DefaultWeightMsgSetAutoCompound, types.ModuleName, types.TypeMsgSetAutoCompound},
+		{params.DefaultWeightMsgSetRewardsWithdrawAddress, types.ModuleName, types.TypeMsgSetRewardsWithdrawAddress},
+		{params.DefaultWeightMsgClaimVested, types.ModuleName, types.TypeMsgClaimVested},
 	}
 
 	for i, w := range weightedOps {
@@ -94,6 +102,8 @@ func TestSimulateMsgCreateFixedAmountPlan(t *testing.T) {
 	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Creator)
 	require.Equal(t, "1.000000000000000000stake", msg.StakingCoinWeights.String())
 	require.Equal(t, "126410694testa", msg.EpochAmount.String())
+	require.Equal(t, 0*time.Hour, msg.VestingDuration)
+	require.Equal(t, "26455089", msg.MaxStakePerFarmer.String())
 	require.Len(t, futureOperations, 0)
 }
 
@@ -136,6 +146,46 @@ func TestSimulateMsgCreateRatioPlan(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgCreateDecayingAmountPlan tests the normal scenario of a valid message of type TypeMsgCreateDecayingAmountPlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgCreateDecayingAmountPlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup randomly generated private plan creation fees
+	feeCoins := simulation.GenPrivatePlanCreationFee(r)
+	params := app.FarmingKeeper.GetParams(ctx)
+	params.PrivatePlanCreationFee = feeCoins
+	app.FarmingKeeper.SetParams(ctx, params)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateDecayingAmountPlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCreateDecayingAmountPlan
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCreateDecayingAmountPlan, msg.Type())
+	require.Equal(t, "simulation-test-nhwJy", msg.Name)
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Creator)
+	require.Equal(t, "1.000000000000000000stake", msg.StakingCoinWeights.String())
+	require.Equal(t, "126410694testa", msg.EpochAmount.String())
+	require.Equal(t, "0.890000000000000000", msg.DecayFactor.String())
+	require.EqualValues(t, 4, msg.DecayPeriod)
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgStake tests the normal scenario of a valid message of type TypeMsgStake.
 // Abnormal scenarios, where the message are created by an errors are not tested here.
 func TestSimulateMsgStake(t *testing.T) {
@@ -170,6 +220,37 @@ func TestSimulateMsgStake(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgStakeFor tests the normal scenario of a valid message of type TypeMsgStakeFor.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgStakeFor(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup two accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 2)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgStakeFor(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgStakeFor
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgStakeFor, msg.Type())
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Payer)
+	require.Equal(t, "cosmos1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7u4x9a0", msg.Farmer)
+	require.Equal(t, "337122540stake", msg.StakingCoins.String())
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgUnstake tests the normal scenario of a valid message of type TypeMsgUnstake.
 // Abnormal scenarios, where the message are created by an errors are not tested here.
 func TestSimulateMsgUnstake(t *testing.T) {
@@ -211,6 +292,46 @@ func TestSimulateMsgUnstake(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgTransferStaking tests the normal scenario of a valid message of type TypeMsgTransferStaking.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgTransferStaking(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup two accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 2)
+
+	// staking must exist in order to simulate transfer staking
+	stakingCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+	for _, acc := range accounts {
+		err := app.FarmingKeeper.Stake(ctx, acc.Address, stakingCoins)
+		require.NoError(t, err)
+	}
+
+	// begin a new block and advance epoch
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+	err := app.FarmingKeeper.AdvanceEpoch(ctx)
+	require.NoError(t, err)
+
+	// execute operation
+	op := simulation.SimulateMsgTransferStaking(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgTransferStaking
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgTransferStaking, msg.Type())
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Farmer)
+	require.Equal(t, "cosmos1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7u4x9a0", msg.Recipient)
+	require.Equal(t, "40122540stake", msg.StakingCoins.String())
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgHarvest tests the normal scenario of a valid message of type TypeMsgHarvest.
 // Abnormal scenarios, where the message are created by an errors are not tested here.
 func TestSimulateMsgHarvest(t *testing.T) {
@@ -222,9 +343,9 @@ func TestSimulateMsgHarvest(t *testing.T) {
 
 	accounts := getTestingAccounts(t, r, app, ctx, 2)
 
-	// setup epoch days to 1 to ease the test
+	// setup epoch duration to a day to ease the test
 	params := app.FarmingKeeper.GetParams(ctx)
-	params.NextEpochDays = 1
+	params.NextEpochDuration = 24 * time.Hour
 	app.FarmingKeeper.SetParams(ctx, params)
 
 	// setup a fixed amount plan
@@ -349,6 +470,199 @@ func TestSimulateMsgRemovePlan(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgModifyPrivatePlan tests the normal scenario of a valid message of type TypeMsgModifyPrivatePlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgModifyPrivatePlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  app.LastBlockHeight() + 1,
+			Time:    types.ParseTime("2022-01-01T00:00:00Z"),
+			AppHash: app.LastCommitID().Hash,
+		},
+	})
+
+	// Create a new live private plan.
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		&types.MsgCreateFixedAmountPlan{
+			Name:    "simulation-test",
+			Creator: accounts[0].Address.String(),
+			StakingCoinWeights: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+			),
+			StartTime: types.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:   types.ParseTime("9998-01-01T00:00:00Z"),
+			EpochAmount: sdk.NewCoins(
+				sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 300_000_000),
+			),
+		},
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// execute operation
+	op := simulation.SimulateMsgModifyPrivatePlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgModifyPrivatePlan
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgModifyPrivatePlan, msg.Type())
+	require.Equal(t, accounts[0].Address.String(), msg.Creator)
+	require.Equal(t, uint64(1), msg.PlanId)
+	require.True(t, msg.EndTime.After(types.ParseTime("9998-01-01T00:00:00Z")))
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgSetAutoCompound tests the normal scenario of a valid message of type TypeMsgSetAutoCompound.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgSetAutoCompound(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// stake
+	err := app.FarmingKeeper.Stake(ctx, accounts[0].Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)))
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgSetAutoCompound(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgSetAutoCompound
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgSetAutoCompound, msg.Type())
+	require.Equal(t, accounts[0].Address.String(), msg.Farmer)
+	require.True(t, msg.Enabled)
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgSetRewardsWithdrawAddress tests the normal scenario of a valid message of type TypeMsgSetRewardsWithdrawAddress.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgSetRewardsWithdrawAddress(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup randomly generated accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 2)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgSetRewardsWithdrawAddress(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgSetRewardsWithdrawAddress
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgSetRewardsWithdrawAddress, msg.Type())
+	require.NotEmpty(t, msg.Farmer)
+	require.NotEmpty(t, msg.WithdrawAddress)
+	require.Len(t, futureOperations, 0)
+}
+
+func TestSimulateMsgClaimVested(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup randomly generated accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 2)
+
+	// setup epoch duration to a day to ease the test
+	params := app.FarmingKeeper.GetParams(ctx)
+	params.NextEpochDuration = 24 * time.Hour
+	app.FarmingKeeper.SetParams(ctx, params)
+
+	// setup a fixed amount plan whose rewards vest over two days
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		&types.MsgCreateFixedAmountPlan{
+			Name:    "simulation-test",
+			Creator: accounts[0].Address.String(),
+			StakingCoinWeights: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+			),
+			StartTime: types.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:   types.ParseTime("9999-01-01T00:00:00Z"),
+			EpochAmount: sdk.NewCoins(
+				sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 300_000_000),
+			),
+			VestingDuration: 48 * time.Hour,
+		},
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// stake and advance epochs to accumulate rewards
+	err = app.FarmingKeeper.Stake(ctx, accounts[1].Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)))
+	require.NoError(t, err)
+
+	err = app.FarmingKeeper.AdvanceEpoch(ctx)
+	require.NoError(t, err)
+	err = app.FarmingKeeper.AdvanceEpoch(ctx)
+	require.NoError(t, err)
+
+	// harvest, which starts vesting the rewards
+	err = app.FarmingKeeper.Harvest(ctx, accounts[1].Address, []string{sdk.DefaultBondDenom})
+	require.NoError(t, err)
+
+	// half of the vesting duration has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: ctx.BlockTime()}})
+
+	// execute operation
+	op := simulation.SimulateMsgClaimVested(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgClaimVested
+	err = types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgClaimVested, msg.Type())
+	require.Equal(t, "cosmos1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7u4x9a0", msg.Farmer)
+	require.Len(t, futureOperations, 0)
+
+	balances := app.BankKeeper.GetBalance(ctx, accounts[1].Address, "pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5")
+	require.Equal(t, sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 100150000000), balances)
+}
+
 func createTestApp(isCheckTx bool) (*farmingapp.FarmingApp, sdk.Context) {
 	app := farmingapp.Setup(isCheckTx)
 
@@ -3,6 +3,7 @@ package simulation
 // DONTCOVER
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -25,9 +26,9 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return string(bz)
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyNextEpochDays),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyNextEpochDuration),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%d", GenNextEpochDays(r))
+				return fmt.Sprintf("\"%d\"", GenNextEpochDuration(r))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFarmingFeeCollector),
@@ -40,5 +41,34 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GenMaxNumPrivatePlans(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAllocationPolicy),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenAllocationPolicy(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardsStreaming),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenRewardsStreaming(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxCatchUpEpochs),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCatchUpEpochs(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyUnstakingPeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenUnstakingPeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAutoCompoundGasPrice),
+			func(r *rand.Rand) string {
+				bz, err := json.Marshal(GenAutoCompoundGasPrice(r))
+				if err != nil {
+					panic(err)
+				}
+				return string(bz)
+			},
+		),
 	}
 }
@@ -20,13 +20,18 @@ func TestParamChanges(t *testing.T) {
 		subspace    string
 	}{
 		{"farming/PrivatePlanCreationFee", "PrivatePlanCreationFee", "[{\"denom\":\"stake\",\"amount\":\"98498081\"}]", "farming"},
-		{"farming/NextEpochDays", "NextEpochDays", "7", "farming"},
+		{"farming/NextEpochDuration", "NextEpochDuration", "\"259200000000000\"", "farming"},
 		{"farming/FarmingFeeCollector", "FarmingFeeCollector", "\"cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x\"", "farming"},
 		{"farming/MaxNumPrivatePlans", "MaxNumPrivatePlans", "4575", "farming"},
+		{"farming/AllocationPolicy", "AllocationPolicy", "3", "farming"},
+		{"farming/RewardsStreaming", "RewardsStreaming", "false", "farming"},
+		{"farming/MaxCatchUpEpochs", "MaxCatchUpEpochs", "18", "farming"},
+		{"farming/UnstakingPeriod", "UnstakingPeriod", "\"262800000000000\"", "farming"},
+		{"farming/AutoCompoundGasPrice", "AutoCompoundGasPrice", "[{\"denom\":\"stake\",\"amount\":\"0.041000000000000000\"}]", "farming"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 4)
+	require.Len(t, paramChanges, 9)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -15,13 +15,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PlanI)(nil), nil)
 	cdc.RegisterConcrete(&MsgCreateFixedAmountPlan{}, "farming/MsgCreateFixedAmountPlan", nil)
 	cdc.RegisterConcrete(&MsgCreateRatioPlan{}, "farming/MsgCreateRatioPlan", nil)
+	cdc.RegisterConcrete(&MsgCreateDecayingAmountPlan{}, "farming/MsgCreateDecayingAmountPlan", nil)
 	cdc.RegisterConcrete(&MsgStake{}, "farming/MsgStake", nil)
+	cdc.RegisterConcrete(&MsgStakeFor{}, "farming/MsgStakeFor", nil)
 	cdc.RegisterConcrete(&MsgUnstake{}, "farming/MsgUnstake", nil)
+	cdc.RegisterConcrete(&MsgTransferStaking{}, "farming/MsgTransferStaking", nil)
 	cdc.RegisterConcrete(&MsgHarvest{}, "farming/MsgHarvest", nil)
 	cdc.RegisterConcrete(&MsgRemovePlan{}, "farming/MsgRemovePlan", nil)
+	cdc.RegisterConcrete(&MsgModifyPrivatePlan{}, "farming/MsgModifyPrivatePlan", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "farming/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgSetRewardsWithdrawAddress{}, "farming/MsgSetRewardsWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgClaimVested{}, "farming/MsgClaimVested", nil)
 	cdc.RegisterConcrete(&FixedAmountPlan{}, "farming/FixedAmountPlan", nil)
 	cdc.RegisterConcrete(&RatioPlan{}, "farming/RatioPlan", nil)
+	cdc.RegisterConcrete(&DecayingAmountPlan{}, "farming/DecayingAmountPlan", nil)
 	cdc.RegisterConcrete(&PublicPlanProposal{}, "farming/PublicPlanProposal", nil)
+	cdc.RegisterConcrete(&PauseProposal{}, "farming/PauseProposal", nil)
+	cdc.RegisterConcrete(&UnpauseProposal{}, "farming/UnpauseProposal", nil)
 }
 
 // RegisterInterfaces registers the x/farming interfaces types with the interface registry
@@ -30,15 +40,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateFixedAmountPlan{},
 		&MsgCreateRatioPlan{},
+		&MsgCreateDecayingAmountPlan{},
 		&MsgStake{},
+		&MsgStakeFor{},
 		&MsgUnstake{},
+		&MsgTransferStaking{},
 		&MsgHarvest{},
 		&MsgRemovePlan{},
+		&MsgModifyPrivatePlan{},
+		&MsgSetAutoCompound{},
+		&MsgSetRewardsWithdrawAddress{},
+		&MsgClaimVested{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&PublicPlanProposal{},
+		&PauseProposal{},
+		&UnpauseProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -46,6 +65,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*PlanI)(nil),
 		&FixedAmountPlan{},
 		&RatioPlan{},
+		&DecayingAmountPlan{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
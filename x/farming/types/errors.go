@@ -20,4 +20,9 @@ var (
 	ErrNumMaxDenomsLimit               = sdkerrors.Register(ModuleName, 13, "number of denoms cannot exceed the limit")
 	ErrInvalidEpochAmount              = sdkerrors.Register(ModuleName, 14, "invalid epoch amount")
 	ErrRatioPlanDisabled               = sdkerrors.Register(ModuleName, 15, "creation of ratio plans is disabled")
+	ErrInvalidLockDuration             = sdkerrors.Register(ModuleName, 16, "invalid lock duration")
+	ErrFunctionPaused                  = sdkerrors.Register(ModuleName, 17, "function is paused by governance")
+	ErrNoVestedRewards                 = sdkerrors.Register(ModuleName, 18, "no vested rewards to claim")
+	ErrInvalidVestingReserveAmount     = sdkerrors.Register(ModuleName, 19, "vesting reserve amount invariant broken")
+	ErrInvalidCappedTotalStakings      = sdkerrors.Register(ModuleName, 20, "capped total stakings invariant broken")
 )
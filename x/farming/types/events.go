@@ -2,15 +2,32 @@ package types
 
 // Event types for the farming module.
 const (
-	EventTypeCreateFixedAmountPlan = "create_fixed_amount_plan"
-	EventTypeCreateRatioPlan       = "create_ratio_plan"
-	EventTypeStake                 = "stake"
-	EventTypeUnstake               = "unstake"
-	EventTypeHarvest               = "harvest"
-	EventTypeRemovePlan            = "remove_plan"
-	EventTypeRewardsWithdrawn      = "rewards_withdrawn"
-	EventTypePlanTerminated        = "plan_terminated"
-	EventTypeRewardsAllocated      = "rewards_allocated"
+	EventTypeCreateFixedAmountPlan     = "create_fixed_amount_plan"
+	EventTypeCreateRatioPlan           = "create_ratio_plan"
+	EventTypeCreateDecayingAmountPlan  = "create_decaying_amount_plan"
+	EventTypeStake                     = "stake"
+	EventTypeUnstake                   = "unstake"
+	EventTypeTransferStaking           = "transfer_staking"
+	EventTypeHarvest                   = "harvest"
+	EventTypeRemovePlan                = "remove_plan"
+	EventTypeModifyPrivatePlan         = "modify_private_plan"
+	EventTypeRewardsWithdrawn          = "rewards_withdrawn"
+	EventTypeRewardsPending            = "rewards_pending"
+	EventTypePlanTerminated            = "plan_terminated"
+	EventTypeRewardsAllocated          = "rewards_allocated"
+	EventTypePlanRewardsWithdrawn      = "plan_rewards_withdrawn"
+	EventTypeLock                      = "lock"
+	EventTypeLockMatured               = "lock_matured"
+	EventTypeUnbond                    = "unbond"
+	EventTypeUnbondingCompleted        = "unbonding_completed"
+	EventTypeSetAutoCompound           = "set_auto_compound"
+	EventTypeAutoCompound              = "auto_compound"
+	EventTypeAutoCompoundFailed        = "auto_compound_failed"
+	EventTypeSetRewardsWithdrawAddress = "set_rewards_withdraw_address"
+	EventTypePauseFunction             = "pause_function"
+	EventTypeUnpauseFunction           = "unpause_function"
+	EventTypeRewardsVested             = "rewards_vested"
+	EventTypeClaimVested               = "claim_vested"
 
 	AttributeKeyPlanId             = "plan_id" //nolint:golint
 	AttributeKeyPlanName           = "plan_name"
@@ -23,8 +40,24 @@ const (
 	AttributeKeyEndTime            = "end_time"
 	AttributeKeyEpochAmount        = "epoch_amount"
 	AttributeKeyEpochRatio         = "epoch_ratio"
+	AttributeKeyDecayFactor        = "decay_factor"
+	AttributeKeyDecayPeriod        = "decay_period"
 	AttributeKeyFarmer             = "farmer"
 	AttributeKeyAmount             = "amount"
 	AttributeKeyStakingCoinDenom   = "staking_coin_denom"
 	AttributeKeyStakingCoinDenoms  = "staking_coin_denoms"
+	AttributeKeyLockId             = "lock_id" //nolint:golint
+	AttributeKeyLockDuration       = "lock_duration"
+	AttributeKeyMultiplier         = "multiplier"
+	AttributeKeyEnabled            = "enabled"
+	AttributeKeyGasUsed            = "gas_used"
+	AttributeKeyFee                = "fee"
+	AttributeKeyError              = "error"
+	AttributeKeyWithdrawAddress    = "withdraw_address"
+	AttributeKeyFunction           = "function"
+	AttributeKeyUnbondingId        = "unbonding_id" //nolint:golint
+	AttributeKeyCompletionTime     = "completion_time"
+	AttributeKeyPayer              = "payer"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyVestingId          = "vesting_id" //nolint:golint
 )
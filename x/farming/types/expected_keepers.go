@@ -23,3 +23,13 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 }
+
+// FarmingHooks event hooks for farming module
+type FarmingHooks interface {
+	AfterStaked(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins)                                  // Must be called when coins are staked, including locked stakings
+	AfterQueuedStakingProcessed(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) // Must be called when queued coins become staked
+	BeforeUnstaked(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins)                             // Must be called before coins are unstaked
+	AfterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards sdk.Coins)                             // Must be called when rewards are withdrawn
+	AfterAllocateRewards(ctx sdk.Context)                                                                           // Must be called when rewards are allocated at the end of an epoch
+	AfterPlanTerminated(ctx sdk.Context, plan PlanI)                                                                // Must be called when a plan is terminated
+}
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -62,6 +63,94 @@ func (PlanType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5b657e0809d9de86, []int{0}
 }
 
+// AllocationPolicy enumerates the valid policies of rewards allocation from
+// an underfunded farming pool.
+type AllocationPolicy int32
+
+const (
+	// ALLOCATION_POLICY_UNSPECIFIED defines the default allocation policy.
+	AllocationPolicyNil AllocationPolicy = 0
+	// ALLOCATION_POLICY_SKIP_ALL skips all allocations from the farming pool.
+	AllocationPolicySkipAll AllocationPolicy = 1
+	// ALLOCATION_POLICY_PRO_RATA scales down all allocations from the farming
+	// pool proportionally to fit the pool's balance.
+	AllocationPolicyProRata AllocationPolicy = 2
+	// ALLOCATION_POLICY_PRIORITY allocates rewards of the plans in ascending
+	// order of plan id, skipping plans that the remaining balance cannot cover.
+	AllocationPolicyPriority AllocationPolicy = 3
+)
+
+var AllocationPolicy_name = map[int32]string{
+	0: "ALLOCATION_POLICY_UNSPECIFIED",
+	1: "ALLOCATION_POLICY_SKIP_ALL",
+	2: "ALLOCATION_POLICY_PRO_RATA",
+	3: "ALLOCATION_POLICY_PRIORITY",
+}
+
+var AllocationPolicy_value = map[string]int32{
+	"ALLOCATION_POLICY_UNSPECIFIED": 0,
+	"ALLOCATION_POLICY_SKIP_ALL":    1,
+	"ALLOCATION_POLICY_PRO_RATA":    2,
+	"ALLOCATION_POLICY_PRIORITY":    3,
+}
+
+func (x AllocationPolicy) String() string {
+	return proto.EnumName(AllocationPolicy_name, int32(x))
+}
+
+func (AllocationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{1}
+}
+
+// PausableFunction enumerates the functions of the farming module that can
+// be paused by governance.
+type PausableFunction int32
+
+const (
+	// PAUSABLE_FUNCTION_UNSPECIFIED defines the default function.
+	PausableFunctionNil PausableFunction = 0
+	// PAUSABLE_FUNCTION_STAKE defines staking coins, including staking on behalf
+	// of another farmer and transferring stakings.
+	PausableFunctionStake PausableFunction = 1
+	// PAUSABLE_FUNCTION_UNSTAKE defines unstaking coins, including transferring
+	// stakings.
+	PausableFunctionUnstake PausableFunction = 2
+	// PAUSABLE_FUNCTION_HARVEST defines harvesting rewards, including
+	// auto-compounding and claiming vested rewards.
+	PausableFunctionHarvest PausableFunction = 3
+	// PAUSABLE_FUNCTION_PLAN_CREATION defines creating and modifying private plans.
+	PausableFunctionPlanCreation PausableFunction = 4
+	// PAUSABLE_FUNCTION_REWARD_ALLOCATION defines allocating rewards at the end
+	// of epochs and streaming rewards.
+	PausableFunctionRewardAllocation PausableFunction = 5
+)
+
+var PausableFunction_name = map[int32]string{
+	0: "PAUSABLE_FUNCTION_UNSPECIFIED",
+	1: "PAUSABLE_FUNCTION_STAKE",
+	2: "PAUSABLE_FUNCTION_UNSTAKE",
+	3: "PAUSABLE_FUNCTION_HARVEST",
+	4: "PAUSABLE_FUNCTION_PLAN_CREATION",
+	5: "PAUSABLE_FUNCTION_REWARD_ALLOCATION",
+}
+
+var PausableFunction_value = map[string]int32{
+	"PAUSABLE_FUNCTION_UNSPECIFIED":       0,
+	"PAUSABLE_FUNCTION_STAKE":             1,
+	"PAUSABLE_FUNCTION_UNSTAKE":           2,
+	"PAUSABLE_FUNCTION_HARVEST":           3,
+	"PAUSABLE_FUNCTION_PLAN_CREATION":     4,
+	"PAUSABLE_FUNCTION_REWARD_ALLOCATION": 5,
+}
+
+func (x PausableFunction) String() string {
+	return proto.EnumName(PausableFunction_name, int32(x))
+}
+
+func (PausableFunction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{2}
+}
+
 // AddressType enumerates the available types of a address.
 type AddressType int32
 
@@ -87,7 +176,7 @@ func (x AddressType) String() string {
 }
 
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{1}
+	return fileDescriptor_5b657e0809d9de86, []int{3}
 }
 
 // Params defines the set of params for the farming module.
@@ -95,16 +184,42 @@ type Params struct {
 	// private_plan_creation_fee specifies the fee for plan creation
 	// this fee prevents from spamming and is collected in the community pool
 	PrivatePlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=private_plan_creation_fee,json=privatePlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"private_plan_creation_fee" yaml:"private_plan_creation_fee"`
-	// next_epoch_days is the epoch length in number of days
-	// it updates internal state called CurrentEpochDays that is used to process
+	// next_epoch_duration is the epoch length
+	// it updates internal state called CurrentEpochDuration that is used to process
 	// staking and reward distribution in end blocker
-	NextEpochDays uint32 `protobuf:"varint,2,opt,name=next_epoch_days,json=nextEpochDays,proto3" json:"next_epoch_days,omitempty" yaml:"next_epoch_days"`
+	NextEpochDuration time.Duration `protobuf:"bytes,9,opt,name=next_epoch_duration,json=nextEpochDuration,proto3,stdduration" json:"next_epoch_duration" yaml:"next_epoch_duration"`
 	// farming_fee_collector is the module account address to collect fees within the farming module
 	FarmingFeeCollector string `protobuf:"bytes,3,opt,name=farming_fee_collector,json=farmingFeeCollector,proto3" json:"farming_fee_collector,omitempty" yaml:"farming_fee_collector"`
 	// delayed_staking_gas_fee is used to impose gas fee for the delayed staking
 	DelayedStakingGasFee github_com_cosmos_cosmos_sdk_types.Gas `protobuf:"varint,4,opt,name=delayed_staking_gas_fee,json=delayedStakingGasFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"delayed_staking_gas_fee" yaml:"delayed_staking_gas_fee"`
 	// max_num_private_plans is the maximum number of active private plans
 	MaxNumPrivatePlans uint32 `protobuf:"varint,5,opt,name=max_num_private_plans,json=maxNumPrivatePlans,proto3" json:"max_num_private_plans,omitempty" yaml:"max_num_private_plans"`
+	// lock_multipliers is the table of reward multipliers for locked stakings.
+	// A staking locked for a duration gets the multiplier of the entry with
+	// the greatest duration not exceeding the lock duration.
+	LockMultipliers []LockMultiplier `protobuf:"bytes,6,rep,name=lock_multipliers,json=lockMultipliers,proto3" json:"lock_multipliers" yaml:"lock_multipliers"`
+	// allocation_policy specifies how rewards are allocated from a farming pool
+	// whose balance does not cover the total amount of its plans' allocations
+	AllocationPolicy AllocationPolicy `protobuf:"varint,7,opt,name=allocation_policy,json=allocationPolicy,proto3,enum=cosmos.farming.v1beta1.AllocationPolicy" json:"allocation_policy,omitempty" yaml:"allocation_policy"`
+	// rewards_streaming specifies whether rewards are streamed every block in
+	// proportion to the elapsed time of the epoch, instead of being allocated
+	// at the end of the epoch
+	RewardsStreaming bool `protobuf:"varint,8,opt,name=rewards_streaming,json=rewardsStreaming,proto3" json:"rewards_streaming,omitempty" yaml:"rewards_streaming"`
+	// max_catch_up_epochs is the maximum number of epochs that can be advanced
+	// in a block to catch up with the epochs missed while the chain was halted.
+	// Zero disables catching up, so that only one epoch is advanced even if
+	// several epochs have been missed.
+	MaxCatchUpEpochs uint32 `protobuf:"varint,10,opt,name=max_catch_up_epochs,json=maxCatchUpEpochs,proto3" json:"max_catch_up_epochs,omitempty" yaml:"max_catch_up_epochs"`
+	// unstaking_period is the duration for which unstaked coins are kept in
+	// the staking reserve before being paid out to the farmer.
+	// Zero pays out unstaked coins immediately.
+	UnstakingPeriod time.Duration `protobuf:"bytes,11,opt,name=unstaking_period,json=unstakingPeriod,proto3,stdduration" json:"unstaking_period" yaml:"unstaking_period"`
+	// auto_compound_gas_price is the price per gas of the fee charged to a
+	// farmer every time the farmer's rewards are auto-compounded at the end of
+	// an epoch.
+	// The fee covers the gas consumed by auto-compounding and is collected in
+	// the farming fee collector
+	AutoCompoundGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,12,rep,name=auto_compound_gas_price,json=autoCompoundGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"auto_compound_gas_price" yaml:"auto_compound_gas_price"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -139,6 +254,46 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockMultiplier defines a reward multiplier applied to the stakings locked
+// for at least the duration.
+type LockMultiplier struct {
+	Duration   time.Duration                          `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *LockMultiplier) Reset()         { *m = LockMultiplier{} }
+func (m *LockMultiplier) String() string { return proto.CompactTextString(m) }
+func (*LockMultiplier) ProtoMessage()    {}
+func (*LockMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{1}
+}
+func (m *LockMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockMultiplier.Merge(m, src)
+}
+func (m *LockMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockMultiplier proto.InternalMessageInfo
+
 // BasePlan defines a base plan type and contains the required fields
 // for basic farming plan functionality. Any custom farming plan type must
 // extend this type for additional functionality (for example, fixed amount plan, ratio
@@ -170,13 +325,23 @@ type BasePlan struct {
 	LastDistributionTime *time.Time `protobuf:"bytes,10,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time,omitempty" yaml:"last_distribution_time"`
 	// distributed_coins specifies the total coins distributed by this plan
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+	// vesting_duration specifies the duration over which the rewards from the
+	// plan unlock linearly after being withdrawn; zero means the rewards are
+	// paid out immediately
+	VestingDuration time.Duration `protobuf:"bytes,12,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// max_stake_per_farmer specifies the maximum amount of a farmer's stake
+	// counted toward the plan for each staking coin denom; zero means no limit
+	MaxStakePerFarmer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_stake_per_farmer,json=maxStakePerFarmer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake_per_farmer" yaml:"max_stake_per_farmer"`
+	// max_total_stake specifies the maximum total amount of stakes counted
+	// toward the plan for each staking coin denom; zero means no limit
+	MaxTotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=max_total_stake,json=maxTotalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_stake" yaml:"max_total_stake"`
 }
 
 func (m *BasePlan) Reset()         { *m = BasePlan{} }
 func (m *BasePlan) String() string { return proto.CompactTextString(m) }
 func (*BasePlan) ProtoMessage()    {}
 func (*BasePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{1}
+	return fileDescriptor_5b657e0809d9de86, []int{2}
 }
 func (m *BasePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,7 +382,7 @@ func (m *FixedAmountPlan) Reset()         { *m = FixedAmountPlan{} }
 func (m *FixedAmountPlan) String() string { return proto.CompactTextString(m) }
 func (*FixedAmountPlan) ProtoMessage()    {}
 func (*FixedAmountPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{2}
+	return fileDescriptor_5b657e0809d9de86, []int{3}
 }
 func (m *FixedAmountPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +423,7 @@ func (m *RatioPlan) Reset()         { *m = RatioPlan{} }
 func (m *RatioPlan) String() string { return proto.CompactTextString(m) }
 func (*RatioPlan) ProtoMessage()    {}
 func (*RatioPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{3}
+	return fileDescriptor_5b657e0809d9de86, []int{4}
 }
 func (m *RatioPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +452,57 @@ func (m *RatioPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RatioPlan proto.InternalMessageInfo
 
+// DecayingAmountPlan defines a plan that distributes an amount of coins
+// for every epoch, which decays by a decay factor for every decay period.
+// For example, a plan with decay factor 0.5 and decay period 30 halves its
+// epoch amount every 30 epochs.
+type DecayingAmountPlan struct {
+	*BasePlan `protobuf:"bytes,1,opt,name=base_plan,json=basePlan,proto3,embedded=base_plan" json:"base_plan,omitempty" yaml:"base_plan"`
+	// epoch_amount specifies the distributing amount for the first decay period
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_factor specifies the factor that the epoch amount is multiplied by
+	// for every decay period
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period specifies the number of epochs between decays
+	DecayPeriod uint32 `protobuf:"varint,4,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
+	// elapsed_epochs specifies the number of epochs that have ended while the plan
+	// is active, whether or not the plan has allocated rewards in them
+	ElapsedEpochs uint64 `protobuf:"varint,5,opt,name=elapsed_epochs,json=elapsedEpochs,proto3" json:"elapsed_epochs,omitempty" yaml:"elapsed_epochs"`
+}
+
+func (m *DecayingAmountPlan) Reset()         { *m = DecayingAmountPlan{} }
+func (m *DecayingAmountPlan) String() string { return proto.CompactTextString(m) }
+func (*DecayingAmountPlan) ProtoMessage()    {}
+func (*DecayingAmountPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{5}
+}
+func (m *DecayingAmountPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecayingAmountPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecayingAmountPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecayingAmountPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecayingAmountPlan.Merge(m, src)
+}
+func (m *DecayingAmountPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecayingAmountPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecayingAmountPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecayingAmountPlan proto.InternalMessageInfo
+
 // Staking defines a farmer's staking information.
 type Staking struct {
 	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -297,7 +513,7 @@ func (m *Staking) Reset()         { *m = Staking{} }
 func (m *Staking) String() string { return proto.CompactTextString(m) }
 func (*Staking) ProtoMessage()    {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{4}
+	return fileDescriptor_5b657e0809d9de86, []int{6}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,6 +542,148 @@ func (m *Staking) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Staking proto.InternalMessageInfo
 
+// Lock defines a farmer's staking locked until the end time.
+// The weight of a lock, which is the amount multiplied by the multiplier,
+// is used instead of the amount when calculating rewards.
+type Lock struct {
+	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer           string                                 `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string                                 `protobuf:"bytes,3,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Multiplier       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	Weight           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight"`
+	// starting_epoch is zero while the lock is waiting in a queue
+	StartingEpoch uint64    `protobuf:"varint,7,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty" yaml:"starting_epoch"`
+	EndTime       time.Time `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{7}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+// Unbonding defines unstaked coins of a farmer waiting to be paid out
+// after the unstaking period.
+type Unbonding struct {
+	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer           string                                 `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string                                 `protobuf:"bytes,3,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	CompletionTime   time.Time                              `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *Unbonding) Reset()         { *m = Unbonding{} }
+func (m *Unbonding) String() string { return proto.CompactTextString(m) }
+func (*Unbonding) ProtoMessage()    {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{8}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(m, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+// RewardVesting defines withdrawn rewards of a farmer from a plan, which
+// unlock linearly from the start time to the end time.
+// A farmer has at most one reward vesting per plan; rewards withdrawn later
+// are merged into it by re-basing its start time and amounts.
+type RewardVesting struct {
+	Id            uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer        string                                   `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	PlanId        uint64                                   `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	TotalAmount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_amount,json=totalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_amount" yaml:"total_amount"`
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=claimed_amount,json=claimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_amount" yaml:"claimed_amount"`
+	StartTime     time.Time                                `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime       time.Time                                `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// initial_vested_amount is the part of the total amount that had already
+	// unlocked at the start time, which is carried over when the vesting is
+	// re-based.
+	InitialVestedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=initial_vested_amount,json=initialVestedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_vested_amount" yaml:"initial_vested_amount"`
+}
+
+func (m *RewardVesting) Reset()         { *m = RewardVesting{} }
+func (m *RewardVesting) String() string { return proto.CompactTextString(m) }
+func (*RewardVesting) ProtoMessage()    {}
+func (*RewardVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{9}
+}
+func (m *RewardVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVesting.Merge(m, src)
+}
+func (m *RewardVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVesting proto.InternalMessageInfo
+
 // QueuedStaking defines staking that is waiting in a queue.
 type QueuedStaking struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -335,7 +693,7 @@ func (m *QueuedStaking) Reset()         { *m = QueuedStaking{} }
 func (m *QueuedStaking) String() string { return proto.CompactTextString(m) }
 func (*QueuedStaking) ProtoMessage()    {}
 func (*QueuedStaking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{5}
+	return fileDescriptor_5b657e0809d9de86, []int{10}
 }
 func (m *QueuedStaking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +731,7 @@ func (m *TotalStakings) Reset()         { *m = TotalStakings{} }
 func (m *TotalStakings) String() string { return proto.CompactTextString(m) }
 func (*TotalStakings) ProtoMessage()    {}
 func (*TotalStakings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{6}
+	return fileDescriptor_5b657e0809d9de86, []int{11}
 }
 func (m *TotalStakings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +769,7 @@ func (m *HistoricalRewards) Reset()         { *m = HistoricalRewards{} }
 func (m *HistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewards) ProtoMessage()    {}
 func (*HistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{7}
+	return fileDescriptor_5b657e0809d9de86, []int{12}
 }
 func (m *HistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,6 +798,131 @@ func (m *HistoricalRewards) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HistoricalRewards proto.InternalMessageInfo
 
+// StartingRewards defines the cumulative unit rewards for a staking coin
+// denom as of the time a farmer's positions started during an epoch in which
+// rewards had already been streamed.
+// The positions starting from the epoch count their rewards from these
+// cumulative unit rewards instead of those of the previous epoch.
+type StartingRewards struct {
+	Epoch                 uint64                                      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
+	// plan_cumulative_unit_rewards defines the cumulative unit rewards of the
+	// plans which had streamed rewards during the epoch
+	PlanCumulativeUnitRewards []PlanCumulativeUnitRewards `protobuf:"bytes,3,rep,name=plan_cumulative_unit_rewards,json=planCumulativeUnitRewards,proto3" json:"plan_cumulative_unit_rewards" yaml:"plan_cumulative_unit_rewards"`
+}
+
+func (m *StartingRewards) Reset()         { *m = StartingRewards{} }
+func (m *StartingRewards) String() string { return proto.CompactTextString(m) }
+func (*StartingRewards) ProtoMessage()    {}
+func (*StartingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{13}
+}
+func (m *StartingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartingRewards.Merge(m, src)
+}
+func (m *StartingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartingRewards proto.InternalMessageInfo
+
+// PlanCumulativeUnitRewards defines the cumulative unit rewards of a plan.
+type PlanCumulativeUnitRewards struct {
+	PlanId                uint64                                      `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
+}
+
+func (m *PlanCumulativeUnitRewards) Reset()         { *m = PlanCumulativeUnitRewards{} }
+func (m *PlanCumulativeUnitRewards) String() string { return proto.CompactTextString(m) }
+func (*PlanCumulativeUnitRewards) ProtoMessage()    {}
+func (*PlanCumulativeUnitRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{14}
+}
+func (m *PlanCumulativeUnitRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanCumulativeUnitRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanCumulativeUnitRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanCumulativeUnitRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanCumulativeUnitRewards.Merge(m, src)
+}
+func (m *PlanCumulativeUnitRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanCumulativeUnitRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanCumulativeUnitRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanCumulativeUnitRewards proto.InternalMessageInfo
+
+// PendingRewards defines the rewards withdrawn for a farmer while harvesting
+// was paused, which are kept in the rewards reserve pool until the farmer
+// harvests them after harvesting is unpaused.
+type PendingRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *PendingRewards) Reset()         { *m = PendingRewards{} }
+func (m *PendingRewards) String() string { return proto.CompactTextString(m) }
+func (*PendingRewards) ProtoMessage()    {}
+func (*PendingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{15}
+}
+func (m *PendingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewards.Merge(m, src)
+}
+func (m *PendingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewards proto.InternalMessageInfo
+
 // OutstandingRewards represents outstanding (un-withdrawn) rewards
 // for a staking coin denom.
 type OutstandingRewards struct {
@@ -450,7 +933,7 @@ func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
 func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*OutstandingRewards) ProtoMessage()    {}
 func (*OutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{8}
+	return fileDescriptor_5b657e0809d9de86, []int{16}
 }
 func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,122 +962,293 @@ func (m *OutstandingRewards) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OutstandingRewards proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("cosmos.farming.v1beta1.PlanType", PlanType_name, PlanType_value)
-	proto.RegisterEnum("cosmos.farming.v1beta1.AddressType", AddressType_name, AddressType_value)
-	proto.RegisterType((*Params)(nil), "cosmos.farming.v1beta1.Params")
-	proto.RegisterType((*BasePlan)(nil), "cosmos.farming.v1beta1.BasePlan")
-	proto.RegisterType((*FixedAmountPlan)(nil), "cosmos.farming.v1beta1.FixedAmountPlan")
-	proto.RegisterType((*RatioPlan)(nil), "cosmos.farming.v1beta1.RatioPlan")
-	proto.RegisterType((*Staking)(nil), "cosmos.farming.v1beta1.Staking")
-	proto.RegisterType((*QueuedStaking)(nil), "cosmos.farming.v1beta1.QueuedStaking")
-	proto.RegisterType((*TotalStakings)(nil), "cosmos.farming.v1beta1.TotalStakings")
-	proto.RegisterType((*HistoricalRewards)(nil), "cosmos.farming.v1beta1.HistoricalRewards")
-	proto.RegisterType((*OutstandingRewards)(nil), "cosmos.farming.v1beta1.OutstandingRewards")
+// PlanRewards represents rewards that a farmer earned from a plan
+// through staking of a staking coin denom.
+type PlanRewards struct {
+	PlanId           uint64                                   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	StakingCoinDenom string                                   `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/farming.proto", fileDescriptor_5b657e0809d9de86)
+func (m *PlanRewards) Reset()         { *m = PlanRewards{} }
+func (m *PlanRewards) String() string { return proto.CompactTextString(m) }
+func (*PlanRewards) ProtoMessage()    {}
+func (*PlanRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{17}
 }
-
-var fileDescriptor_5b657e0809d9de86 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xf7, 0x04, 0x93, 0xd8, 0xe3, 0x6f, 0x12, 0x67, 0xf2, 0x03, 0xc7, 0x80, 0xd7, 0x5a, 0xe9,
-	0x5b, 0x59, 0xa9, 0xb0, 0xc1, 0xe9, 0x29, 0xa7, 0x66, 0x63, 0x27, 0x8d, 0x84, 0x82, 0xd9, 0x38,
-	0xa5, 0x54, 0xaa, 0x56, 0x63, 0xef, 0x60, 0x56, 0xec, 0x0f, 0xcb, 0x33, 0x86, 0xf8, 0x0f, 0xa8,
-	0x40, 0x39, 0xa1, 0xaa, 0x87, 0xb6, 0x52, 0x24, 0xd4, 0xde, 0xe8, 0xb5, 0x87, 0xfe, 0x07, 0xe5,
-	0x48, 0x7b, 0xaa, 0x7a, 0x58, 0x2a, 0xf2, 0x1f, 0xf8, 0xd4, 0x63, 0x35, 0x3f, 0xd6, 0xd9, 0x82,
-	0xd3, 0x60, 0x89, 0x9e, 0xbc, 0xf3, 0xe6, 0xbd, 0xcf, 0xfb, 0xbc, 0x37, 0xfb, 0x79, 0xb3, 0x86,
-	0x25, 0x46, 0x7c, 0x9b, 0xf4, 0x3c, 0xc7, 0x67, 0x95, 0x7b, 0x98, 0xff, 0x76, 0x2a, 0x0f, 0x6f,
-	0xb4, 0x08, 0xc3, 0x37, 0xa2, 0x75, 0xb9, 0xdb, 0x0b, 0x58, 0x80, 0x56, 0xda, 0x01, 0xf5, 0x02,
-	0x5a, 0x8e, 0xac, 0xca, 0x2b, 0xbf, 0xd4, 0x09, 0x3a, 0x81, 0x70, 0xa9, 0xf0, 0x27, 0xe9, 0x9d,
-	0x5f, 0x95, 0xde, 0x96, 0xdc, 0x50, 0xa1, 0x72, 0xab, 0x20, 0x57, 0x95, 0x16, 0xa6, 0x64, 0x94,
-	0xab, 0x1d, 0x38, 0xbe, 0xda, 0xd7, 0x3a, 0x41, 0xd0, 0x71, 0x49, 0x45, 0xac, 0x5a, 0xfd, 0x7b,
-	0x15, 0xe6, 0x78, 0x84, 0x32, 0xec, 0x75, 0xa5, 0x83, 0xfe, 0x73, 0x12, 0x4e, 0x37, 0x70, 0x0f,
-	0x7b, 0x14, 0x3d, 0x07, 0x70, 0xb5, 0xdb, 0x73, 0x1e, 0x62, 0x46, 0xac, 0xae, 0x8b, 0x7d, 0xab,
-	0xdd, 0x23, 0x98, 0x39, 0x81, 0x6f, 0xdd, 0x23, 0x24, 0x07, 0x8a, 0x17, 0x4a, 0x99, 0xea, 0x6a,
-	0x59, 0xa5, 0xe7, 0x09, 0x23, 0xda, 0xe5, 0xad, 0xc0, 0xf1, 0x8d, 0xe6, 0x8b, 0x50, 0x4b, 0x0c,
-	0x43, 0xad, 0x38, 0xc0, 0x9e, 0xbb, 0xa1, 0x9f, 0x89, 0xa4, 0x3f, 0x7f, 0xa5, 0x95, 0x3a, 0x0e,
-	0xbb, 0xdf, 0x6f, 0x95, 0xdb, 0x81, 0xa7, 0xea, 0x51, 0x3f, 0xd7, 0xa8, 0xfd, 0xa0, 0xc2, 0x06,
-	0x5d, 0x42, 0x05, 0x28, 0x35, 0x57, 0x14, 0x4e, 0xc3, 0xc5, 0xfe, 0x96, 0x42, 0xd9, 0x26, 0x04,
-	0x19, 0x70, 0xde, 0x27, 0x87, 0xcc, 0x22, 0xdd, 0xa0, 0x7d, 0xdf, 0xb2, 0xf1, 0x80, 0xe6, 0xa6,
-	0x8a, 0xa0, 0x34, 0x6b, 0xe4, 0x87, 0xa1, 0xb6, 0x22, 0x29, 0xbc, 0xe1, 0xa0, 0x9b, 0xb3, 0xdc,
-	0x52, 0xe7, 0x86, 0x1a, 0x1e, 0x50, 0xd4, 0x84, 0xcb, 0xea, 0x00, 0x38, 0x2f, 0xab, 0x1d, 0xb8,
-	0x2e, 0x69, 0xb3, 0xa0, 0x97, 0xbb, 0x50, 0x04, 0xa5, 0xb4, 0x51, 0x1c, 0x86, 0xda, 0x15, 0x89,
-	0x34, 0xd6, 0x4d, 0x37, 0x17, 0x95, 0x7d, 0x9b, 0x90, 0xad, 0xc8, 0x8a, 0x1e, 0x03, 0x78, 0xc9,
-	0x26, 0x2e, 0x1e, 0x10, 0xdb, 0xa2, 0x0c, 0x3f, 0xe0, 0x71, 0x1d, 0x4c, 0x45, 0x13, 0x93, 0x45,
-	0x50, 0x4a, 0x1a, 0x0d, 0xde, 0xa9, 0x3f, 0x42, 0xed, 0x83, 0x77, 0xe8, 0xc2, 0x0e, 0xa6, 0xc3,
-	0x50, 0x2b, 0x48, 0x1a, 0x67, 0xc0, 0xea, 0xe6, 0x92, 0xda, 0xd9, 0x97, 0x1b, 0x3b, 0x98, 0xf2,
-	0x1e, 0xed, 0xc3, 0x65, 0x0f, 0x1f, 0x5a, 0x7e, 0xdf, 0xb3, 0xe2, 0xa7, 0x41, 0x73, 0x17, 0x45,
-	0xa7, 0x62, 0xf5, 0x8d, 0x75, 0xd3, 0x4d, 0xe4, 0xe1, 0xc3, 0xbd, 0xbe, 0xd7, 0x38, 0x3d, 0x02,
-	0xba, 0x91, 0x7a, 0xf2, 0x4c, 0x4b, 0x7c, 0xf3, 0x4c, 0x4b, 0xe8, 0xdf, 0xcd, 0xc0, 0x94, 0x81,
-	0xa9, 0xb0, 0xa3, 0x39, 0x38, 0xe5, 0xd8, 0x39, 0xc0, 0xeb, 0x33, 0xa7, 0x1c, 0x1b, 0x21, 0x98,
-	0xf4, 0xb1, 0x47, 0xc4, 0xa1, 0xa4, 0x4d, 0xf1, 0x8c, 0x3e, 0x82, 0x49, 0x5e, 0x94, 0x68, 0xef,
-	0x5c, 0xb5, 0x58, 0x1e, 0x2f, 0x82, 0x32, 0xc7, 0x6b, 0x0e, 0xba, 0xc4, 0x14, 0xde, 0xe8, 0x36,
-	0x5c, 0x8a, 0xda, 0xdf, 0x0d, 0x02, 0xd7, 0xc2, 0xb6, 0xdd, 0x23, 0x94, 0x8a, 0x5e, 0xa6, 0x0d,
-	0x6d, 0x18, 0x6a, 0x97, 0xff, 0x79, 0x48, 0x71, 0x2f, 0xdd, 0x44, 0xca, 0xdc, 0x08, 0x02, 0x77,
-	0x53, 0x1a, 0xd1, 0x2d, 0xb8, 0xc8, 0x84, 0x4e, 0xe5, 0x4b, 0x19, 0x21, 0x5e, 0x14, 0x88, 0x85,
-	0x61, 0xa8, 0xe5, 0x25, 0xe2, 0x18, 0x27, 0xdd, 0x44, 0x31, 0x6b, 0x04, 0xf8, 0x3d, 0x80, 0x4b,
-	0xd1, 0xa1, 0x70, 0xf5, 0x59, 0x8f, 0x88, 0xd3, 0xb9, 0xcf, 0x68, 0x6e, 0x5a, 0xa8, 0xe6, 0xca,
-	0x58, 0xd5, 0xd4, 0x48, 0x5b, 0x08, 0xc7, 0x54, 0xc2, 0x51, 0x65, 0x8c, 0xc3, 0xe1, 0x9a, 0xf9,
-	0xf0, 0x1d, 0xde, 0x16, 0x05, 0x49, 0x4d, 0xa4, 0x50, 0xf8, 0xea, 0x8e, 0xc4, 0x40, 0x9f, 0x41,
-	0x48, 0x19, 0xee, 0x31, 0x8b, 0xcf, 0x80, 0xdc, 0x4c, 0x11, 0x94, 0x32, 0xd5, 0x7c, 0x59, 0x0e,
-	0x88, 0x72, 0x34, 0x20, 0xca, 0xcd, 0x68, 0x40, 0x18, 0x57, 0x15, 0xaf, 0x85, 0x11, 0x2f, 0x15,
-	0xab, 0x3f, 0x7d, 0xa5, 0x01, 0x33, 0x2d, 0x0c, 0xdc, 0x1d, 0x99, 0x30, 0x45, 0x7c, 0x5b, 0xe2,
-	0xa6, 0xce, 0xc5, 0xbd, 0xac, 0x70, 0xe7, 0x25, 0x6e, 0x14, 0x29, 0x51, 0x67, 0x88, 0x6f, 0x0b,
-	0xcc, 0x02, 0x84, 0x51, 0xa3, 0x89, 0x9d, 0x4b, 0x17, 0x41, 0x29, 0x65, 0xc6, 0x2c, 0xe8, 0x11,
-	0x5c, 0x71, 0x31, 0x65, 0x96, 0xed, 0x50, 0xd6, 0x73, 0x5a, 0x7d, 0x71, 0x48, 0x82, 0x01, 0x3c,
-	0x97, 0xc1, 0xff, 0x87, 0xa1, 0x76, 0x55, 0x66, 0x1f, 0x8f, 0x21, 0xb9, 0x2c, 0xf1, 0xcd, 0x5a,
-	0x6c, 0x4f, 0x10, 0xfb, 0x1a, 0xc0, 0x85, 0x51, 0x00, 0xb1, 0xc5, 0x39, 0xd1, 0x5c, 0xe6, 0xbc,
-	0xf1, 0x78, 0x53, 0x55, 0x9d, 0x53, 0x52, 0x7e, 0x13, 0x61, 0xb2, 0xb1, 0x98, 0x8d, 0xc5, 0x0b,
-	0xcb, 0xc6, 0x2c, 0xd7, 0xe5, 0x6f, 0x3f, 0x5d, 0xbb, 0xc8, 0xe5, 0xb3, 0xab, 0xff, 0x05, 0xe0,
-	0xfc, 0xb6, 0x73, 0x48, 0xec, 0x4d, 0x2f, 0xe8, 0xfb, 0x4c, 0x68, 0xf4, 0x0e, 0x4c, 0x73, 0x5e,
-	0x42, 0xdd, 0x42, 0xaa, 0x99, 0xb3, 0x45, 0x18, 0x09, 0xdb, 0xc8, 0xbd, 0x0c, 0x35, 0x30, 0x0c,
-	0xb5, 0xac, 0xe4, 0x3d, 0x02, 0xd0, 0xcd, 0x54, 0x2b, 0x12, 0xff, 0x97, 0x00, 0xfe, 0x4f, 0xce,
-	0x59, 0x2c, 0xb2, 0xe5, 0xa6, 0xce, 0xeb, 0xc6, 0x8e, 0xea, 0xc6, 0xa2, 0x7a, 0x07, 0x62, 0xc1,
-	0x93, 0x35, 0x22, 0x23, 0x42, 0x65, 0x91, 0x1b, 0x49, 0xde, 0x03, 0xfd, 0x57, 0x00, 0xd3, 0x26,
-	0x97, 0xe7, 0x7f, 0x5b, 0x34, 0x81, 0x32, 0xb7, 0xd5, 0xe3, 0xb9, 0xe4, 0xa0, 0x33, 0x6a, 0x13,
-	0x8c, 0xf6, 0x1a, 0x69, 0x0f, 0x43, 0x0d, 0xc5, 0x3b, 0x20, 0xa0, 0x74, 0x13, 0x8a, 0x95, 0xa8,
-	0x41, 0xd5, 0xf4, 0x2d, 0x80, 0x33, 0x6a, 0xb8, 0xa3, 0x6d, 0x38, 0xad, 0xda, 0x0c, 0x44, 0xce,
-	0xf2, 0x04, 0x39, 0x77, 0x7d, 0x66, 0xaa, 0x68, 0xf4, 0x31, 0x9c, 0x13, 0x12, 0xe6, 0xc3, 0x46,
-	0x24, 0x14, 0x35, 0x24, 0x8d, 0xd5, 0x61, 0xa8, 0x2d, 0xc7, 0x34, 0x3f, 0xda, 0xd7, 0xcd, 0xd9,
-	0xc8, 0x20, 0x2e, 0x51, 0xc5, 0xed, 0x0b, 0x38, 0x7b, 0xbb, 0x4f, 0xfa, 0xa3, 0xdb, 0xe7, 0x7d,
-	0x11, 0x3c, 0x85, 0x6f, 0x06, 0x0c, 0xbb, 0x0a, 0x9d, 0xbe, 0x67, 0xf8, 0x5f, 0x00, 0x5c, 0xf8,
-	0xc4, 0xa1, 0x2c, 0xe8, 0x39, 0x6d, 0xec, 0x9a, 0xe4, 0x11, 0xee, 0xd9, 0x14, 0xfd, 0x08, 0xe0,
-	0xa5, 0x76, 0xdf, 0xeb, 0xbb, 0x98, 0x39, 0x0f, 0x89, 0xd5, 0xf7, 0x1d, 0x66, 0xf5, 0xe4, 0x9e,
-	0xfa, 0x12, 0xfa, 0xf7, 0x99, 0x7e, 0xa0, 0xde, 0x6f, 0x75, 0x71, 0x9f, 0x01, 0x35, 0xf1, 0x58,
-	0x5f, 0x3e, 0x05, 0x3a, 0xf0, 0x1d, 0xa6, 0xd8, 0xaa, 0x4a, 0x1e, 0x03, 0x88, 0x6e, 0xf5, 0x19,
-	0x65, 0xd8, 0xb7, 0x1d, 0xbf, 0x13, 0x95, 0xf2, 0x00, 0xce, 0x4c, 0xc2, 0x7c, 0x9d, 0x33, 0x9f,
-	0x94, 0x57, 0x94, 0x41, 0x32, 0x59, 0xfb, 0x0a, 0xc0, 0x54, 0x74, 0x8b, 0xa3, 0x35, 0xb8, 0xdc,
-	0xb8, 0xb9, 0xb9, 0x67, 0x35, 0xef, 0x36, 0xea, 0xd6, 0xc1, 0xde, 0x7e, 0xa3, 0xbe, 0xb5, 0xbb,
-	0xbd, 0x5b, 0xaf, 0x65, 0x13, 0xf9, 0xf9, 0xa3, 0xe3, 0x62, 0x26, 0x72, 0xdc, 0x73, 0x5c, 0x54,
-	0x82, 0xd9, 0x53, 0xdf, 0xc6, 0x81, 0x71, 0x73, 0x77, 0x2b, 0x0b, 0xf2, 0xe8, 0xe8, 0xb8, 0x38,
-	0x17, 0xb9, 0x35, 0xfa, 0x2d, 0xd7, 0x69, 0xa3, 0x35, 0xb8, 0x10, 0xf3, 0x34, 0x77, 0x3f, 0xdd,
-	0x6c, 0xd6, 0xb3, 0x53, 0xf9, 0xc5, 0xa3, 0xe3, 0xe2, 0xfc, 0xc8, 0x55, 0x7e, 0xb7, 0xe4, 0x93,
-	0x4f, 0x7e, 0x28, 0x24, 0xd6, 0x06, 0x30, 0xa3, 0xae, 0x6b, 0x41, 0xeb, 0x06, 0x5c, 0xde, 0xac,
-	0xd5, 0xcc, 0xfa, 0xfe, 0xbe, 0xc4, 0x58, 0xaf, 0x5a, 0xc6, 0xdd, 0x66, 0x7d, 0x3f, 0x9b, 0xc8,
-	0xaf, 0x1c, 0x1d, 0x17, 0x51, 0xcc, 0x77, 0xbd, 0x6a, 0x0c, 0x18, 0xa1, 0x6f, 0x85, 0x54, 0xaf,
-	0xab, 0x10, 0xf0, 0x56, 0x48, 0xf5, 0xba, 0x08, 0x91, 0xa9, 0x8d, 0x9d, 0x17, 0xaf, 0x0b, 0xe0,
-	0xe5, 0xeb, 0x02, 0xf8, 0xf3, 0x75, 0x01, 0x3c, 0x3d, 0x29, 0x24, 0x5e, 0x9e, 0x14, 0x12, 0xbf,
-	0x9f, 0x14, 0x12, 0x9f, 0x5f, 0x8b, 0x75, 0x79, 0xcc, 0xbf, 0x87, 0xc3, 0xd1, 0x93, 0x68, 0x78,
-	0x6b, 0x5a, 0x5c, 0x66, 0xeb, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x63, 0xa6, 0x99, 0x84, 0x6a,
-	0x0c, 0x00, 0x00,
+func (m *PlanRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PlanRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PlanRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanRewards.Merge(m, src)
+}
+func (m *PlanRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanRewards.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PlanRewards proto.InternalMessageInfo
+
+// CappedStake defines the portion of a farmer's stake counted toward a plan
+// with stake caps for a staking coin denom.
+type CappedStake struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// starting_cumulative_unit_rewards defines the cumulative unit rewards of
+	// the plan when the amount started being counted
+	StartingCumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=starting_cumulative_unit_rewards,json=startingCumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"starting_cumulative_unit_rewards" yaml:"starting_cumulative_unit_rewards"`
+	// pending_rewards defines the rewards accumulated with the previous amount
+	// which have not been withdrawn yet
+	PendingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending_rewards" yaml:"pending_rewards"`
+}
+
+func (m *CappedStake) Reset()         { *m = CappedStake{} }
+func (m *CappedStake) String() string { return proto.CompactTextString(m) }
+func (*CappedStake) ProtoMessage()    {}
+func (*CappedStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{18}
+}
+func (m *CappedStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CappedStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CappedStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CappedStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CappedStake.Merge(m, src)
+}
+func (m *CappedStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *CappedStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_CappedStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CappedStake proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.farming.v1beta1.PlanType", PlanType_name, PlanType_value)
+	proto.RegisterEnum("cosmos.farming.v1beta1.AllocationPolicy", AllocationPolicy_name, AllocationPolicy_value)
+	proto.RegisterEnum("cosmos.farming.v1beta1.PausableFunction", PausableFunction_name, PausableFunction_value)
+	proto.RegisterEnum("cosmos.farming.v1beta1.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterType((*Params)(nil), "cosmos.farming.v1beta1.Params")
+	proto.RegisterType((*LockMultiplier)(nil), "cosmos.farming.v1beta1.LockMultiplier")
+	proto.RegisterType((*BasePlan)(nil), "cosmos.farming.v1beta1.BasePlan")
+	proto.RegisterType((*FixedAmountPlan)(nil), "cosmos.farming.v1beta1.FixedAmountPlan")
+	proto.RegisterType((*RatioPlan)(nil), "cosmos.farming.v1beta1.RatioPlan")
+	proto.RegisterType((*DecayingAmountPlan)(nil), "cosmos.farming.v1beta1.DecayingAmountPlan")
+	proto.RegisterType((*Staking)(nil), "cosmos.farming.v1beta1.Staking")
+	proto.RegisterType((*Lock)(nil), "cosmos.farming.v1beta1.Lock")
+	proto.RegisterType((*Unbonding)(nil), "cosmos.farming.v1beta1.Unbonding")
+	proto.RegisterType((*RewardVesting)(nil), "cosmos.farming.v1beta1.RewardVesting")
+	proto.RegisterType((*QueuedStaking)(nil), "cosmos.farming.v1beta1.QueuedStaking")
+	proto.RegisterType((*TotalStakings)(nil), "cosmos.farming.v1beta1.TotalStakings")
+	proto.RegisterType((*HistoricalRewards)(nil), "cosmos.farming.v1beta1.HistoricalRewards")
+	proto.RegisterType((*StartingRewards)(nil), "cosmos.farming.v1beta1.StartingRewards")
+	proto.RegisterType((*PlanCumulativeUnitRewards)(nil), "cosmos.farming.v1beta1.PlanCumulativeUnitRewards")
+	proto.RegisterType((*PendingRewards)(nil), "cosmos.farming.v1beta1.PendingRewards")
+	proto.RegisterType((*OutstandingRewards)(nil), "cosmos.farming.v1beta1.OutstandingRewards")
+	proto.RegisterType((*PlanRewards)(nil), "cosmos.farming.v1beta1.PlanRewards")
+	proto.RegisterType((*CappedStake)(nil), "cosmos.farming.v1beta1.CappedStake")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/farming.proto", fileDescriptor_5b657e0809d9de86)
+}
+
+var fileDescriptor_5b657e0809d9de86 = []byte{
+	// 2454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0xd9, 0x37, 0x65, 0xd9, 0x96, 0xc7, 0xb1, 0x4c, 0x8f, 0xbf, 0x64, 0xad, 0x63, 0x11, 0xdc, 0xf7,
+	0x4d, 0x8d, 0x04, 0xb1, 0x13, 0xa7, 0xe8, 0xc1, 0x2d, 0xd0, 0x4a, 0xb2, 0x9c, 0xa8, 0x71, 0x6c,
+	0x2e, 0x25, 0x27, 0x4d, 0x81, 0x96, 0x18, 0x93, 0x13, 0x87, 0x30, 0x45, 0xb2, 0x24, 0x95, 0xd8,
+	0x87, 0xf6, 0x56, 0xec, 0xc2, 0xed, 0x61, 0x51, 0xec, 0x61, 0xb7, 0x80, 0xd1, 0x45, 0x7b, 0x28,
+	0xb0, 0xbd, 0x16, 0x28, 0xd0, 0x7b, 0xd1, 0x3d, 0xa6, 0x3d, 0x15, 0x3d, 0x68, 0x8b, 0xe4, 0x3f,
+	0x30, 0x0a, 0xb4, 0x87, 0x16, 0x28, 0xe6, 0x83, 0x12, 0x45, 0x51, 0x76, 0x94, 0x0f, 0xa0, 0x8b,
+	0x9e, 0x2c, 0xce, 0x3c, 0xcf, 0x6f, 0x9e, 0xaf, 0xf9, 0xcd, 0x33, 0xa4, 0xc1, 0x4a, 0x80, 0x6d,
+	0x03, 0x7b, 0x0d, 0xd3, 0x0e, 0xd6, 0x1e, 0x21, 0xf2, 0xf7, 0x60, 0xed, 0xc9, 0xcd, 0x7d, 0x1c,
+	0xa0, 0x9b, 0xe1, 0xf3, 0xaa, 0xeb, 0x39, 0x81, 0x03, 0xe7, 0x75, 0xc7, 0x6f, 0x38, 0xfe, 0x6a,
+	0x38, 0xca, 0xa5, 0xf2, 0xb3, 0x07, 0xce, 0x81, 0x43, 0x45, 0xd6, 0xc8, 0x2f, 0x26, 0x9d, 0x5f,
+	0x64, 0xd2, 0x1a, 0x9b, 0xe0, 0xaa, 0x6c, 0x6a, 0x99, 0x3d, 0xad, 0xed, 0x23, 0x1f, 0xb7, 0xd7,
+	0xd2, 0x1d, 0xd3, 0xe6, 0xf3, 0x85, 0x03, 0xc7, 0x39, 0xb0, 0xf0, 0x1a, 0x7d, 0xda, 0x6f, 0x3e,
+	0x5a, 0x0b, 0xcc, 0x06, 0xf6, 0x03, 0xd4, 0x70, 0x43, 0x80, 0xb8, 0x80, 0xd1, 0xf4, 0x50, 0x60,
+	0x3a, 0x1c, 0x40, 0xfe, 0x04, 0x80, 0x51, 0x05, 0x79, 0xa8, 0xe1, 0xc3, 0xcf, 0x04, 0xb0, 0xe8,
+	0x7a, 0xe6, 0x13, 0x14, 0x60, 0xcd, 0xb5, 0x90, 0xad, 0xe9, 0x1e, 0xa6, 0xa2, 0xda, 0x23, 0x8c,
+	0x73, 0x82, 0x34, 0xbc, 0x32, 0xb1, 0xbe, 0xb8, 0xca, 0xcd, 0x23, 0x06, 0x85, 0x6e, 0xad, 0x96,
+	0x1d, 0xd3, 0x2e, 0xd5, 0x3f, 0x6f, 0x15, 0x86, 0xce, 0x5a, 0x05, 0xe9, 0x18, 0x35, 0xac, 0x0d,
+	0xb9, 0x2f, 0x92, 0xfc, 0xd9, 0x17, 0x85, 0x95, 0x03, 0x33, 0x78, 0xdc, 0xdc, 0x5f, 0xd5, 0x9d,
+	0x06, 0xf7, 0x97, 0xff, 0xb9, 0xee, 0x1b, 0x87, 0x6b, 0xc1, 0xb1, 0x8b, 0x7d, 0x0a, 0xea, 0xab,
+	0xf3, 0x1c, 0x47, 0xb1, 0x90, 0x5d, 0xe6, 0x28, 0x5b, 0x18, 0xc3, 0x1f, 0x80, 0x19, 0x1b, 0x1f,
+	0x05, 0x1a, 0x76, 0x1d, 0xfd, 0xb1, 0x16, 0x3a, 0x95, 0x1b, 0x97, 0x04, 0x6a, 0x25, 0xf3, 0x7a,
+	0x35, 0xf4, 0x7a, 0x75, 0x93, 0x0b, 0x94, 0xae, 0x70, 0x2b, 0xf3, 0xcc, 0xca, 0x04, 0x0c, 0xf9,
+	0xe3, 0x2f, 0x0a, 0x82, 0x3a, 0x4d, 0x66, 0x2a, 0x64, 0x22, 0x54, 0x85, 0x75, 0x30, 0xc7, 0xf3,
+	0x49, 0xdc, 0xd0, 0x74, 0xc7, 0xb2, 0xb0, 0x1e, 0x38, 0x5e, 0x6e, 0x58, 0x12, 0x56, 0xc6, 0x4b,
+	0xd2, 0x59, 0xab, 0xb0, 0xc4, 0x50, 0x13, 0xc5, 0x64, 0x75, 0x86, 0x8f, 0x6f, 0x61, 0x5c, 0x0e,
+	0x47, 0xe1, 0xfb, 0x02, 0x58, 0x30, 0xb0, 0x85, 0x8e, 0xb1, 0xa1, 0xf9, 0x01, 0x3a, 0x24, 0x7a,
+	0x07, 0xc8, 0xa7, 0x31, 0x4f, 0x4b, 0xc2, 0x4a, 0xba, 0xa4, 0x10, 0x93, 0xff, 0xda, 0x2a, 0x5c,
+	0x79, 0x89, 0xa0, 0xdd, 0x46, 0xfe, 0x59, 0xab, 0xb0, 0xcc, 0xcc, 0xe8, 0x03, 0x2b, 0xab, 0xb3,
+	0x7c, 0xa6, 0xc6, 0x26, 0x6e, 0x23, 0x9f, 0x84, 0xb4, 0x06, 0xe6, 0x1a, 0xe8, 0x48, 0xb3, 0x9b,
+	0x0d, 0x2d, 0x9a, 0x3c, 0x3f, 0x37, 0x22, 0x09, 0x2b, 0x93, 0x51, 0xff, 0x12, 0xc5, 0x64, 0x15,
+	0x36, 0xd0, 0xd1, 0x4e, 0xb3, 0xa1, 0x74, 0x32, 0xe6, 0x43, 0x0f, 0x88, 0x96, 0xa3, 0x1f, 0x6a,
+	0x8d, 0xa6, 0x15, 0x98, 0xae, 0x65, 0x62, 0xcf, 0xcf, 0x8d, 0xd2, 0x52, 0xba, 0xb2, 0x9a, 0xbc,
+	0x49, 0x56, 0xb7, 0x1d, 0xfd, 0xf0, 0x5e, 0x5b, 0xbc, 0x54, 0xe0, 0x19, 0x5b, 0x60, 0x6b, 0xc7,
+	0xd1, 0x64, 0x75, 0xca, 0xea, 0x52, 0xf0, 0xa1, 0x0f, 0xa6, 0x91, 0x65, 0x39, 0x3a, 0x2b, 0x39,
+	0xd7, 0xb1, 0x4c, 0xfd, 0x38, 0x37, 0x26, 0x09, 0x2b, 0xd9, 0xf5, 0x95, 0x7e, 0x8b, 0x16, 0xdb,
+	0x0a, 0x0a, 0x95, 0x2f, 0x2d, 0x9d, 0xb5, 0x0a, 0x39, 0xb6, 0x64, 0x0f, 0x98, 0xac, 0x8a, 0x28,
+	0x26, 0x0f, 0xab, 0x60, 0xda, 0xc3, 0x4f, 0x91, 0x67, 0xf8, 0x9a, 0x1f, 0x78, 0x18, 0x11, 0xf4,
+	0x5c, 0x46, 0x12, 0x56, 0x32, 0x51, 0xa8, 0x1e, 0x11, 0x59, 0x15, 0xf9, 0x58, 0x2d, 0x1c, 0x82,
+	0xf7, 0xc0, 0x0c, 0x89, 0xb0, 0x8e, 0x02, 0xfd, 0xb1, 0xd6, 0x74, 0x59, 0x7d, 0xfa, 0x39, 0x40,
+	0xd3, 0xb0, 0xdc, 0x29, 0xde, 0x04, 0x21, 0x59, 0x15, 0x1b, 0xe8, 0xa8, 0x4c, 0x06, 0xf7, 0x5c,
+	0x5a, 0xbe, 0x3e, 0x34, 0x81, 0xd8, 0xb4, 0xc3, 0x1a, 0x70, 0xb1, 0x67, 0x3a, 0x46, 0x6e, 0xe2,
+	0xa2, 0x7d, 0xf2, 0x6e, 0x77, 0xd4, 0xe3, 0x00, 0x6c, 0x93, 0x4c, 0xb5, 0x87, 0x15, 0x3a, 0x4a,
+	0x28, 0x64, 0x01, 0x35, 0x03, 0x47, 0xd3, 0x9d, 0x86, 0xeb, 0x34, 0x6d, 0x83, 0xd6, 0x9c, 0xeb,
+	0x99, 0x3a, 0xce, 0x5d, 0xa2, 0x59, 0x5f, 0x4a, 0x24, 0x90, 0x4d, 0xac, 0x53, 0x0e, 0xd9, 0xe3,
+	0xab, 0xf2, 0x02, 0xee, 0x03, 0x45, 0x18, 0xe4, 0xda, 0x4b, 0x6c, 0x06, 0x8e, 0xea, 0xab, 0xb3,
+	0x04, 0xa8, 0xcc, 0x71, 0x6e, 0x23, 0x5f, 0x21, 0x28, 0x1b, 0x99, 0x0f, 0x3e, 0x2d, 0x0c, 0x7d,
+	0xfc, 0x69, 0x61, 0xe8, 0xdb, 0xe9, 0x4c, 0x4a, 0x1c, 0x56, 0xa7, 0xa2, 0x64, 0x80, 0x8e, 0x7d,
+	0xf9, 0xd7, 0x02, 0xc8, 0x76, 0x17, 0x23, 0xfc, 0x26, 0xc8, 0xb4, 0xb9, 0x46, 0xb8, 0x28, 0x86,
+	0x19, 0xe2, 0x0d, 0x0d, 0x54, 0x5b, 0x09, 0xee, 0x00, 0xd0, 0x29, 0xde, 0x5c, 0x8a, 0x32, 0xc7,
+	0xea, 0x00, 0x1b, 0x7c, 0x13, 0xeb, 0x6a, 0x04, 0x61, 0x23, 0x4d, 0x9c, 0x90, 0xff, 0x31, 0x0e,
+	0x32, 0x25, 0xe4, 0xd3, 0x3d, 0x07, 0xb3, 0x20, 0x65, 0x1a, 0xd4, 0xba, 0xb4, 0x9a, 0x32, 0x0d,
+	0x08, 0x41, 0xda, 0x46, 0x0d, 0xcc, 0x16, 0x53, 0xe9, 0x6f, 0xf8, 0x55, 0x90, 0x26, 0x78, 0x94,
+	0xba, 0xb2, 0xeb, 0x52, 0xbf, 0x5d, 0x41, 0xf0, 0xea, 0xc7, 0x2e, 0x56, 0xa9, 0x34, 0x7c, 0x0f,
+	0xcc, 0x86, 0xd4, 0xe6, 0x3a, 0x8e, 0xa5, 0x21, 0xc3, 0xf0, 0xb0, 0xef, 0x53, 0x9e, 0x1a, 0x2f,
+	0x15, 0xce, 0x5a, 0x85, 0x77, 0xba, 0x09, 0x30, 0x2a, 0x25, 0xab, 0x90, 0x0f, 0x2b, 0x8e, 0x63,
+	0x15, 0xd9, 0x20, 0xdc, 0x05, 0x33, 0x01, 0x3d, 0x52, 0xd9, 0xfe, 0x0a, 0x11, 0x47, 0x28, 0x62,
+	0xa4, 0xd6, 0x13, 0x84, 0x64, 0x15, 0x46, 0x46, 0x43, 0xc0, 0x5f, 0x0a, 0x60, 0x36, 0xac, 0x55,
+	0x72, 0x50, 0x6a, 0x4f, 0xb1, 0x79, 0xf0, 0x38, 0x08, 0x59, 0xe7, 0xfc, 0xfa, 0x53, 0x79, 0xfd,
+	0x71, 0x37, 0x92, 0x70, 0x06, 0x2e, 0x3e, 0xc8, 0x51, 0xc8, 0xd3, 0x03, 0x86, 0x01, 0xbf, 0x03,
+	0x80, 0x1f, 0x20, 0x2f, 0xd0, 0xc8, 0x71, 0x4d, 0xa9, 0x69, 0x62, 0x3d, 0xdf, 0x53, 0x48, 0xf5,
+	0xf0, 0x2c, 0x2f, 0x5d, 0xe6, 0x76, 0x4d, 0xb7, 0xed, 0xe2, 0xba, 0xf2, 0x87, 0xa4, 0xbc, 0xc6,
+	0xe9, 0x00, 0x11, 0x87, 0x2a, 0xc8, 0x60, 0xdb, 0x60, 0xb8, 0x99, 0x0b, 0x71, 0xdf, 0xe1, 0xb8,
+	0x53, 0x0c, 0x37, 0xd4, 0x64, 0xa8, 0x63, 0xd8, 0x36, 0x28, 0xe6, 0x32, 0x00, 0x61, 0xa0, 0xb1,
+	0x41, 0x8f, 0xd8, 0x8c, 0x1a, 0x19, 0x81, 0x4f, 0xc1, 0xbc, 0x85, 0xfc, 0x40, 0x33, 0x4c, 0x3f,
+	0xf0, 0xcc, 0xfd, 0x26, 0x4d, 0x12, 0xb5, 0x00, 0x5c, 0x68, 0xc1, 0xff, 0x9f, 0xb5, 0x0a, 0x97,
+	0x39, 0xb3, 0x27, 0x62, 0x30, 0x5b, 0x66, 0xc9, 0xe4, 0x66, 0x64, 0x8e, 0x1a, 0xf6, 0x91, 0x00,
+	0xa6, 0xdb, 0x0a, 0xd8, 0xa0, 0x79, 0xf2, 0x73, 0x13, 0x17, 0x75, 0x2a, 0xdb, 0xdc, 0x6b, 0xce,
+	0xc9, 0x3d, 0x08, 0x83, 0x75, 0x28, 0x62, 0x44, 0x9f, 0x8e, 0x10, 0xc2, 0x7d, 0x82, 0xfd, 0x80,
+	0x54, 0x4e, 0x9b, 0x2c, 0x2e, 0x0d, 0x48, 0xb8, 0x71, 0x00, 0x4e, 0xb8, 0x7c, 0x38, 0xd4, 0x82,
+	0x3f, 0x02, 0xb3, 0xe4, 0x14, 0x20, 0x25, 0x86, 0x09, 0x35, 0x6b, 0x64, 0x8b, 0x61, 0x2f, 0x37,
+	0x49, 0xf7, 0xcf, 0xbd, 0x01, 0x88, 0xa5, 0x6a, 0x07, 0x9d, 0xc2, 0x4f, 0xc2, 0x94, 0xd5, 0xe9,
+	0x06, 0x3a, 0x22, 0x2d, 0x03, 0x56, 0xb0, 0xb7, 0x45, 0xc7, 0xa0, 0x0b, 0xa6, 0x88, 0x6c, 0xe0,
+	0x04, 0xc8, 0x62, 0x1a, 0xb9, 0x2c, 0x5d, 0xfa, 0xce, 0xc0, 0x4b, 0xcf, 0x77, 0x96, 0x8e, 0xc0,
+	0xc9, 0xea, 0x64, 0x03, 0x1d, 0xd5, 0xc9, 0x00, 0x5d, 0x7a, 0x63, 0x92, 0x10, 0xde, 0x9f, 0x7f,
+	0x7b, 0x7d, 0x84, 0x70, 0x53, 0x55, 0xfe, 0xa7, 0x00, 0xa6, 0xb6, 0xcc, 0x23, 0x6c, 0x14, 0x1b,
+	0x4e, 0xd3, 0x0e, 0xc8, 0x20, 0x7c, 0x00, 0xc6, 0x49, 0xd2, 0x69, 0x5b, 0xc2, 0x59, 0xba, 0x2f,
+	0xc3, 0x85, 0xac, 0x59, 0xca, 0x3d, 0x6b, 0x15, 0x84, 0xb3, 0x56, 0x41, 0x64, 0x66, 0xb4, 0x01,
+	0x64, 0x35, 0xb3, 0x1f, 0x32, 0xeb, 0x8f, 0x05, 0x70, 0x89, 0x9d, 0x0f, 0x88, 0xae, 0x96, 0x4b,
+	0x5d, 0x54, 0x6a, 0xb7, 0x79, 0x56, 0x67, 0xf8, 0x06, 0x8b, 0x28, 0x0f, 0x56, 0x65, 0x13, 0x54,
+	0x95, 0x39, 0xc9, 0x49, 0xff, 0x4f, 0x02, 0x18, 0x57, 0x49, 0x19, 0xbc, 0x5d, 0xa7, 0x31, 0x60,
+	0x6b, 0x6b, 0xb4, 0xe4, 0xf8, 0x91, 0xb5, 0x39, 0xd8, 0x91, 0x75, 0xd6, 0x2a, 0xc0, 0x68, 0x04,
+	0x28, 0x94, 0xac, 0x02, 0xfa, 0x44, 0x7d, 0xe0, 0x3e, 0xbd, 0x18, 0x06, 0x70, 0x13, 0xeb, 0xe8,
+	0xd8, 0xb4, 0x0f, 0xfe, 0x87, 0x32, 0x0a, 0x1f, 0x83, 0x4b, 0x06, 0x71, 0x5b, 0x7b, 0x84, 0x22,
+	0x57, 0x8a, 0xca, 0xc0, 0x51, 0x9e, 0x09, 0x3b, 0xff, 0x0e, 0x96, 0xac, 0x4e, 0xd0, 0xc7, 0x2d,
+	0xfa, 0x04, 0x37, 0xc2, 0x95, 0x78, 0x27, 0x98, 0xa6, 0x5d, 0xe5, 0x42, 0x5c, 0x97, 0xcd, 0x86,
+	0xba, 0xbc, 0xbd, 0xfb, 0x16, 0xc8, 0x62, 0x0b, 0xb9, 0x3e, 0x36, 0xc2, 0x9e, 0x74, 0x84, 0xde,
+	0x50, 0x16, 0xcf, 0x5a, 0x85, 0x39, 0x1e, 0x8f, 0xae, 0x79, 0x59, 0x9d, 0xe4, 0x03, 0xac, 0x17,
+	0xe5, 0x59, 0xfe, 0x44, 0x00, 0x63, 0xfc, 0xee, 0x01, 0xb7, 0xc0, 0x28, 0x0f, 0xbd, 0x30, 0x70,
+	0x33, 0x54, 0xb5, 0x03, 0x95, 0x6b, 0x13, 0xdb, 0xe8, 0x29, 0x48, 0x48, 0x93, 0x2e, 0x9e, 0x4b,
+	0xc5, 0x6d, 0xeb, 0x9e, 0x97, 0xd5, 0xc9, 0x70, 0x80, 0x1a, 0x17, 0xb6, 0x52, 0xc3, 0x20, 0x4d,
+	0x9a, 0xbe, 0x9e, 0x36, 0x6a, 0x1e, 0x8c, 0x72, 0x72, 0x65, 0x8d, 0x14, 0x7f, 0x82, 0x77, 0x01,
+	0xec, 0xea, 0x13, 0x0c, 0x6c, 0x3b, 0x0d, 0x9e, 0xc0, 0xcb, 0x67, 0xad, 0xc2, 0x62, 0x42, 0x2f,
+	0x41, 0x65, 0x64, 0x55, 0x8c, 0xb4, 0x06, 0x9b, 0x64, 0x28, 0x12, 0x8d, 0xf4, 0x6b, 0x45, 0xa3,
+	0xbb, 0xcd, 0x1c, 0x79, 0xdd, 0x36, 0x93, 0xd8, 0xc5, 0xfa, 0x9f, 0xdc, 0xe8, 0xab, 0xd9, 0xc5,
+	0xb4, 0x13, 0xb2, 0x34, 0x36, 0x58, 0x96, 0xde, 0x46, 0x83, 0xc3, 0x33, 0xff, 0xbb, 0x14, 0x18,
+	0xdf, 0xb3, 0xf7, 0x1d, 0xdb, 0x20, 0x75, 0xf9, 0xa5, 0x4e, 0xff, 0x01, 0x98, 0x22, 0xd7, 0x26,
+	0x0b, 0x77, 0x5a, 0xb1, 0x91, 0x0b, 0x63, 0x25, 0xf3, 0x58, 0xf1, 0x83, 0x38, 0x06, 0xc0, 0x42,
+	0x96, 0xed, 0x8c, 0x46, 0x22, 0xf7, 0x6c, 0x04, 0x4c, 0xaa, 0xf4, 0x16, 0x7b, 0x9f, 0xf5, 0x27,
+	0x2f, 0x1d, 0xbd, 0x6b, 0x60, 0x8c, 0xbe, 0x20, 0x32, 0x0d, 0x1a, 0xb2, 0x74, 0x09, 0x9e, 0xb5,
+	0x0a, 0x59, 0xfe, 0x06, 0x89, 0x4d, 0xc8, 0xea, 0x28, 0xf9, 0x55, 0x35, 0x28, 0x59, 0xb3, 0xd6,
+	0xa0, 0x1d, 0xa4, 0xc1, 0xc8, 0x3a, 0xaa, 0x3c, 0x20, 0x59, 0x53, 0x55, 0x4e, 0xd6, 0x3f, 0x11,
+	0x40, 0x56, 0xb7, 0x90, 0xd9, 0xc0, 0x46, 0x68, 0xc9, 0xc8, 0x45, 0x96, 0x54, 0xb9, 0x25, 0xbc,
+	0xc8, 0xbb, 0xd5, 0x07, 0xb3, 0x65, 0x92, 0x2b, 0x73, 0x6b, 0xba, 0xef, 0x12, 0xa3, 0x6f, 0xe9,
+	0x2e, 0x31, 0xf6, 0x86, 0xee, 0x12, 0xbf, 0x10, 0xc0, 0x9c, 0x69, 0x9b, 0x81, 0x89, 0x2c, 0x8d,
+	0x34, 0xb3, 0x9d, 0x10, 0x66, 0x2e, 0x0a, 0xa1, 0xc2, 0x17, 0xe0, 0x2f, 0xa1, 0x12, 0x51, 0x06,
+	0x8b, 0xe4, 0x0c, 0xc7, 0xb8, 0x4f, 0x21, 0xba, 0x9a, 0xab, 0xef, 0x81, 0xc9, 0xf7, 0x9a, 0xb8,
+	0xd9, 0x7e, 0x47, 0xf6, 0xa6, 0xce, 0xa9, 0x0e, 0x7c, 0xbb, 0xa7, 0x35, 0xed, 0x03, 0xff, 0x0d,
+	0xc3, 0xff, 0x51, 0x00, 0xd3, 0x77, 0x4c, 0x3f, 0x70, 0x3c, 0x53, 0x47, 0x16, 0xdb, 0x9a, 0x3e,
+	0xfc, 0x8d, 0x00, 0x16, 0xf4, 0x66, 0xa3, 0x69, 0xa1, 0xc0, 0x7c, 0x82, 0xb5, 0xa6, 0x6d, 0x06,
+	0x1a, 0x7f, 0xf9, 0x94, 0x13, 0x5e, 0xe2, 0x76, 0x1c, 0x7b, 0x3b, 0xd3, 0x07, 0x6a, 0xe0, 0x0b,
+	0xf2, 0x5c, 0x07, 0x68, 0xcf, 0x36, 0x03, 0x6e, 0x2d, 0xf7, 0xe4, 0xdf, 0x29, 0x30, 0x55, 0xe3,
+	0x07, 0x40, 0xe8, 0xc7, 0x2c, 0x18, 0x61, 0x67, 0x07, 0xe3, 0x17, 0xf6, 0x70, 0xae, 0x77, 0xa9,
+	0xff, 0x36, 0xef, 0xc8, 0x3e, 0x58, 0x62, 0xaf, 0xc6, 0xfb, 0x98, 0x3c, 0x4c, 0x4d, 0xbe, 0x79,
+	0xde, 0x9b, 0x99, 0x72, 0x12, 0x72, 0xe9, 0x1a, 0xf7, 0xe3, 0xdd, 0x08, 0x8b, 0xf6, 0x73, 0x46,
+	0x5d, 0x74, 0xfb, 0xe1, 0xf0, 0xf8, 0xff, 0x4b, 0x00, 0x8b, 0x7d, 0xd7, 0x8a, 0xd2, 0xb7, 0x70,
+	0x21, 0x7d, 0x7f, 0xa9, 0x12, 0xc4, 0xdd, 0xff, 0x21, 0xc8, 0x2a, 0x98, 0x36, 0x04, 0xa1, 0xcb,
+	0x18, 0x8c, 0x75, 0xef, 0x99, 0x73, 0x18, 0xeb, 0x06, 0xb1, 0x78, 0x20, 0x46, 0x1a, 0xf3, 0xba,
+	0x96, 0x7f, 0x5f, 0x00, 0x70, 0xb7, 0x19, 0xf8, 0x01, 0xea, 0xb2, 0xe1, 0x30, 0x6e, 0xc3, 0xf9,
+	0x81, 0xbb, 0xc5, 0xcd, 0x18, 0x28, 0x2c, 0x31, 0x4b, 0xfe, 0x2e, 0x80, 0x09, 0x52, 0x07, 0xaf,
+	0x94, 0xf9, 0xe4, 0x1e, 0x29, 0xf5, 0x6a, 0x3d, 0x52, 0x24, 0x01, 0xc3, 0x6f, 0x3d, 0x01, 0xbf,
+	0x1f, 0x06, 0x13, 0x65, 0xe4, 0xba, 0xec, 0x1c, 0xc0, 0x6f, 0xec, 0xb6, 0xf2, 0x07, 0x01, 0x48,
+	0xed, 0x46, 0xf7, 0x75, 0x36, 0xc5, 0xf7, 0xf9, 0xa6, 0xf8, 0x4a, 0xac, 0x79, 0x7e, 0x53, 0xbb,
+	0xe3, 0x72, 0x88, 0x98, 0x4c, 0x00, 0x1f, 0x09, 0x60, 0xca, 0x65, 0x1b, 0x24, 0xc6, 0x5c, 0xe7,
+	0x9b, 0x7d, 0xaf, 0xbb, 0xd7, 0x8c, 0x41, 0x0c, 0x6c, 0x65, 0xd6, 0xed, 0xda, 0xa4, 0x2c, 0x79,
+	0x57, 0x7f, 0x26, 0x80, 0x4c, 0xf8, 0x06, 0x1b, 0x5e, 0x05, 0x73, 0xca, 0x76, 0x71, 0x47, 0xab,
+	0x3f, 0x54, 0x2a, 0xda, 0xde, 0x4e, 0x4d, 0xa9, 0x94, 0xab, 0x5b, 0xd5, 0xca, 0xa6, 0x38, 0x94,
+	0x9f, 0x3a, 0x39, 0x95, 0x26, 0x42, 0xc1, 0x1d, 0xd3, 0x82, 0x2b, 0x40, 0xec, 0xc8, 0x2a, 0x7b,
+	0xa5, 0xed, 0x6a, 0x59, 0x14, 0xf2, 0xf0, 0xe4, 0x54, 0xca, 0x86, 0x62, 0x4a, 0x73, 0xdf, 0x32,
+	0x75, 0x78, 0x15, 0x4c, 0x47, 0x24, 0xd5, 0xea, 0xfd, 0x62, 0xbd, 0x22, 0xa6, 0xf2, 0x33, 0x27,
+	0xa7, 0xd2, 0x54, 0x5b, 0x94, 0x7d, 0x0f, 0xcb, 0xa7, 0x3f, 0xf8, 0xd5, 0xf2, 0xd0, 0xd5, 0x9f,
+	0xa6, 0x80, 0x18, 0xff, 0xd8, 0x04, 0x37, 0xc0, 0xe5, 0xe2, 0xf6, 0xf6, 0x6e, 0xb9, 0x58, 0xaf,
+	0xee, 0xee, 0x68, 0xca, 0xee, 0x76, 0xb5, 0xfc, 0x30, 0x66, 0xe4, 0xc2, 0xc9, 0xa9, 0x34, 0x13,
+	0x57, 0x24, 0xc6, 0x7e, 0x1d, 0xe4, 0x7b, 0x75, 0x6b, 0x77, 0xab, 0x8a, 0x56, 0xdc, 0xde, 0x16,
+	0x85, 0xfc, 0x3b, 0x27, 0xa7, 0xd2, 0x42, 0x5c, 0xb1, 0x76, 0x68, 0xba, 0x45, 0xab, 0x8f, 0xb2,
+	0xa2, 0xee, 0x6a, 0x6a, 0xb1, 0x5e, 0x14, 0x53, 0xc9, 0xca, 0x8a, 0xe7, 0xa8, 0x28, 0x40, 0xf0,
+	0x1b, 0xc9, 0xca, 0xd5, 0x5d, 0xb5, 0x5a, 0x7f, 0x28, 0x0e, 0xe7, 0x97, 0x4e, 0x4e, 0xa5, 0x5c,
+	0xaf, 0xb2, 0xe9, 0x78, 0x66, 0x70, 0xcc, 0xc3, 0xf1, 0xf3, 0x61, 0x20, 0x2a, 0xa8, 0xe9, 0xa3,
+	0x7d, 0x0b, 0x6f, 0x35, 0x6d, 0x9d, 0x08, 0x92, 0x70, 0x28, 0xc5, 0xbd, 0x5a, 0xb1, 0xb4, 0x5d,
+	0xd1, 0xb6, 0xf6, 0x76, 0xca, 0x14, 0x3f, 0x21, 0x1c, 0x71, 0x45, 0x12, 0x8e, 0xaf, 0x81, 0x85,
+	0x5e, 0xdd, 0x5a, 0xbd, 0x78, 0xb7, 0x22, 0x0a, 0xf9, 0xc5, 0x93, 0x53, 0x69, 0x2e, 0xae, 0xc5,
+	0x76, 0xf6, 0x06, 0x58, 0x4c, 0x5c, 0x93, 0x6a, 0xf2, 0x40, 0xc4, 0x35, 0xf7, 0x6c, 0xbf, 0xbf,
+	0xee, 0x9d, 0xa2, 0x7a, 0xbf, 0x52, 0xab, 0x8b, 0xc3, 0xc9, 0xba, 0x77, 0x90, 0x47, 0x3a, 0x57,
+	0x58, 0x01, 0x85, 0x5e, 0x5d, 0x5a, 0x53, 0x65, 0xb5, 0x42, 0x23, 0x2b, 0xa6, 0xf3, 0xd2, 0xc9,
+	0xa9, 0xb4, 0x14, 0x47, 0x88, 0x7e, 0x12, 0x87, 0xf7, 0xc0, 0xbb, 0xbd, 0x30, 0x6a, 0xe5, 0x41,
+	0x51, 0xdd, 0xd4, 0x3a, 0x49, 0x12, 0x47, 0xf2, 0xff, 0x77, 0x72, 0x2a, 0x49, 0x71, 0x28, 0xb6,
+	0x6f, 0x3a, 0xa9, 0xe2, 0xc9, 0x39, 0x06, 0x13, 0xfc, 0xb3, 0x0a, 0xdd, 0x42, 0x37, 0xc1, 0x5c,
+	0x71, 0x73, 0x53, 0xad, 0xd4, 0x6a, 0xac, 0xde, 0x6f, 0xad, 0x6b, 0xa5, 0x87, 0xf5, 0x4a, 0x4d,
+	0x1c, 0xca, 0xcf, 0x9f, 0x9c, 0x4a, 0x30, 0x22, 0x7b, 0x6b, 0xbd, 0x74, 0x1c, 0x60, 0xbf, 0x47,
+	0x65, 0xfd, 0x06, 0x57, 0x11, 0x7a, 0x54, 0xd6, 0x6f, 0x50, 0x15, 0xb6, 0x74, 0xe9, 0xf6, 0xe7,
+	0xcf, 0x97, 0x85, 0x67, 0xcf, 0x97, 0x85, 0xbf, 0x3d, 0x5f, 0x16, 0x3e, 0x7c, 0xb1, 0x3c, 0xf4,
+	0xec, 0xc5, 0xf2, 0xd0, 0x5f, 0x5e, 0x2c, 0x0f, 0x7d, 0xf7, 0x7a, 0x84, 0x18, 0x12, 0xfe, 0x21,
+	0xe3, 0xa8, 0xfd, 0x8b, 0x72, 0xc4, 0xfe, 0x28, 0xbd, 0xaa, 0xdc, 0xfa, 0xcf, 0x00, 0x3e, 0x14,
+	0x8d, 0x60, 0xbd, 0x21, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -602,6 +1256,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundGasPrice) > 0 {
+		for iNdEx := len(m.AutoCompoundGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnstakingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFarming(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxCatchUpEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.MaxCatchUpEpochs))
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if m.RewardsStreaming {
+		i--
+		if m.RewardsStreaming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AllocationPolicy != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.AllocationPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LockMultipliers) > 0 {
+		for iNdEx := len(m.LockMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.MaxNumPrivatePlans != 0 {
 		i = encodeVarintFarming(dAtA, i, uint64(m.MaxNumPrivatePlans))
 		i--
@@ -619,11 +1337,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.NextEpochDays != 0 {
-		i = encodeVarintFarming(dAtA, i, uint64(m.NextEpochDays))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.PrivatePlanCreationFee) > 0 {
 		for iNdEx := len(m.PrivatePlanCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -641,6 +1354,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BasePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -661,6 +1415,34 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTotalStake.Size()
+		i -= size
+		if _, err := m.MaxTotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxStakePerFarmer.Size()
+		i -= size
+		if _, err := m.MaxStakePerFarmer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintFarming(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x62
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -676,12 +1458,12 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastDistributionTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintFarming(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintFarming(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -695,20 +1477,20 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintFarming(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x42
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n3
-	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i -= n7
+	i = encodeVarintFarming(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.StakingCoinWeights) > 0 {
@@ -853,7 +1635,7 @@ func (m *RatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Staking) Marshal() (dAtA []byte, err error) {
+func (m *DecayingAmountPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -863,35 +1645,66 @@ func (m *Staking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecayingAmountPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecayingAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StartingEpoch != 0 {
-		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+	if m.ElapsedEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.ElapsedEpochs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
+	}
+	if m.DecayPeriod != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.Amount.Size()
+		size := m.DecayFactor.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BasePlan != nil {
+		{
+			size, err := m.BasePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFarming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
+func (m *Staking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -901,16 +1714,21 @@ func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueuedStaking) MarshalTo(dAtA []byte) (int, error) {
+func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.StartingEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -924,7 +1742,7 @@ func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
+func (m *Lock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -934,16 +1752,49 @@ func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalStakings) MarshalTo(dAtA []byte) (int, error) {
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintFarming(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x42
+	if m.StartingEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -953,11 +1804,30 @@ func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -967,26 +1837,250 @@ func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFarming(dAtA, i, uint64(size))
-			}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintFarming(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitialVestedAmount) > 0 {
+		for iNdEx := len(m.InitialVestedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialVestedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintFarming(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x3a
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintFarming(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ClaimedAmount) > 0 {
+		for iNdEx := len(m.ClaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalStakings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalStakings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -994,7 +2088,7 @@ func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+func (m *StartingRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1004,229 +2098,2500 @@ func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartingRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PlanCumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.PlanCumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PlanCumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanCumulativeUnitRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanCumulativeUnitRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanCumulativeUnitRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintFarming(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFarming(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PrivatePlanCreationFee) > 0 {
-		for _, e := range m.PrivatePlanCreationFee {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
-	}
-	if m.NextEpochDays != 0 {
-		n += 1 + sovFarming(uint64(m.NextEpochDays))
-	}
-	l = len(m.FarmingFeeCollector)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if m.DelayedStakingGasFee != 0 {
-		n += 1 + sovFarming(uint64(m.DelayedStakingGasFee))
-	}
-	if m.MaxNumPrivatePlans != 0 {
-		n += 1 + sovFarming(uint64(m.MaxNumPrivatePlans))
-	}
-	return n
-}
-
-func (m *BasePlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovFarming(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovFarming(uint64(m.Type))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovFarming(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovFarming(uint64(l))
-	if m.Terminated {
-		n += 2
-	}
-	if m.LastDistributionTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime)
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if len(m.DistributedCoins) > 0 {
-		for _, e := range m.DistributedCoins {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *FixedAmountPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BasePlan != nil {
-		l = m.BasePlan.Size()
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	if len(m.EpochAmount) > 0 {
-		for _, e := range m.EpochAmount {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *RatioPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BasePlan != nil {
-		l = m.BasePlan.Size()
-		n += 1 + l + sovFarming(uint64(l))
-	}
-	l = m.EpochRatio.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
-}
-
-func (m *Staking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	if m.StartingEpoch != 0 {
-		n += 1 + sovFarming(uint64(m.StartingEpoch))
-	}
-	return n
-}
-
-func (m *QueuedStaking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
-}
-
-func (m *TotalStakings) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovFarming(uint64(l))
-	return n
-}
-
-func (m *HistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for _, e := range m.CumulativeUnitRewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CappedStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CappedStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CappedStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StartingCumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.StartingCumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StartingCumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFarming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PrivatePlanCreationFee) > 0 {
+		for _, e := range m.PrivatePlanCreationFee {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = len(m.FarmingFeeCollector)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.DelayedStakingGasFee != 0 {
+		n += 1 + sovFarming(uint64(m.DelayedStakingGasFee))
+	}
+	if m.MaxNumPrivatePlans != 0 {
+		n += 1 + sovFarming(uint64(m.MaxNumPrivatePlans))
+	}
+	if len(m.LockMultipliers) > 0 {
+		for _, e := range m.LockMultipliers {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.AllocationPolicy != 0 {
+		n += 1 + sovFarming(uint64(m.AllocationPolicy))
+	}
+	if m.RewardsStreaming {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.MaxCatchUpEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.MaxCatchUpEpochs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnstakingPeriod)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.AutoCompoundGasPrice) > 0 {
+		for _, e := range m.AutoCompoundGasPrice {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *BasePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFarming(uint64(m.Type))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.Terminated {
+		n += 2
+	}
+	if m.LastDistributionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime)
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.MaxStakePerFarmer.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.MaxTotalStake.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *FixedAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RatioPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *DecayingAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovFarming(uint64(m.DecayPeriod))
+	}
+	if m.ElapsedEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.ElapsedEpochs))
+	}
+	return n
+}
+
+func (m *Staking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovFarming(uint64(m.StartingEpoch))
+	}
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovFarming(uint64(m.StartingEpoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *RewardVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovFarming(uint64(m.PlanId))
+	}
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.ClaimedAmount) > 0 {
+		for _, e := range m.ClaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.InitialVestedAmount) > 0 {
+		for _, e := range m.InitialVestedAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *TotalStakings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *HistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StartingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovFarming(uint64(m.Epoch))
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.PlanCumulativeUnitRewards) > 0 {
+		for _, e := range m.PlanCumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanCumulativeUnitRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovFarming(uint64(m.PlanId))
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovFarming(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CappedStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if len(m.StartingCumulativeUnitRewards) > 0 {
+		for _, e := range m.StartingCumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFarming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFarming(x uint64) (n int) {
+	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
+			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedStakingGasFee", wireType)
+			}
+			m.DelayedStakingGasFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedStakingGasFee |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumPrivatePlans", wireType)
+			}
+			m.MaxNumPrivatePlans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumPrivatePlans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockMultipliers = append(m.LockMultipliers, LockMultiplier{})
+			if err := m.LockMultipliers[len(m.LockMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationPolicy", wireType)
+			}
+			m.AllocationPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocationPolicy |= AllocationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsStreaming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsStreaming = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCatchUpEpochs", wireType)
+			}
+			m.MaxCatchUpEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCatchUpEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnstakingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundGasPrice = append(m.AutoCompoundGasPrice, types.DecCoin{})
+			if err := m.AutoCompoundGasPrice[len(m.AutoCompoundGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PlanType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDistributionTime == nil {
+				m.LastDistributionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakePerFarmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakePerFarmer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecayingAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecayingAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecayingAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			m.DecayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedEpochs", wireType)
+			}
+			m.ElapsedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Staking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *OutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFarming(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFarming(x uint64) (n int) {
-	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,17 +4614,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1269,31 +4634,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
-			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			m.NextEpochDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1303,14 +4653,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEpochDays |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1338,13 +4701,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedStakingGasFee", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.DelayedStakingGasFee = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1354,16 +4717,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayedStakingGasFee |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumPrivatePlans", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			m.MaxNumPrivatePlans = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1373,11 +4751,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumPrivatePlans |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarming(dAtA[iNdEx:])
@@ -1399,7 +4791,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasePlan) Unmarshal(dAtA []byte) error {
+func (m *RewardVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,10 +4814,10 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1449,7 +4841,7 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1477,13 +4869,13 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			m.Type = 0
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1493,16 +4885,16 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PlanType(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1512,59 +4904,29 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1591,12 +4953,12 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimedAmount = append(m.ClaimedAmount, types.Coin{})
+			if err := m.ClaimedAmount[len(m.ClaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -1629,7 +4991,7 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
@@ -1662,29 +5024,9 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminated = bool(v != 0)
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialVestedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1711,18 +5053,66 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDistributionTime == nil {
-				m.LastDistributionTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.InitialVestedAmount = append(m.InitialVestedAmount, types.Coin{})
+			if err := m.InitialVestedAmount[len(m.InitialVestedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1732,23 +5122,23 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
-			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1773,7 +5163,7 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
+func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1796,17 +5186,17 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1816,31 +5206,79 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1867,8 +5305,8 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochAmount = append(m.EpochAmount, types.Coin{})
-			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1893,7 +5331,7 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+func (m *StartingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,15 +5354,34 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1951,18 +5408,16 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanCumulativeUnitRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1972,23 +5427,23 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PlanCumulativeUnitRewards = append(m.PlanCumulativeUnitRewards, PlanCumulativeUnitRewards{})
+			if err := m.PlanCumulativeUnitRewards[len(m.PlanCumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2013,7 +5468,7 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Staking) Unmarshal(dAtA []byte) error {
+func (m *PlanCumulativeUnitRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2036,17 +5491,17 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanCumulativeUnitRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanCumulativeUnitRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var stringLen uint64
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2056,31 +5511,16 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
 			}
-			m.StartingEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2090,11 +5530,26 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartingEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarming(dAtA[iNdEx:])
@@ -2116,7 +5571,7 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
+func (m *PendingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2139,17 +5594,17 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2159,23 +5614,23 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2200,7 +5655,7 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalStakings) Unmarshal(dAtA []byte) error {
+func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,17 +5678,17 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2243,23 +5698,23 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2284,7 +5739,7 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *PlanRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2307,15 +5762,66 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2342,8 +5848,8 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types.DecCoin{})
-			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2368,7 +5874,7 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *CappedStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,15 +5897,49 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: CappedStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CappedStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingCumulativeUnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2426,8 +5966,42 @@ func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StartingCumulativeUnitRewards = append(m.StartingCumulativeUnitRewards, types.DecCoin{})
+			if err := m.StartingCumulativeUnitRewards[len(m.StartingCumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.DecCoin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
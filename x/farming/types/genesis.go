@@ -12,22 +12,47 @@ func NewGenesisState(
 	params Params, globalPlanId uint64, plans []PlanRecord,
 	stakings []StakingRecord, queuedStakings []QueuedStakingRecord, totalStakings []TotalStakingsRecord,
 	historicalRewards []HistoricalRewardsRecord, outstandingRewards []OutstandingRewardsRecord,
+	planHistoricalRewards []PlanHistoricalRewardsRecord, planOutstandingRewards []PlanOutstandingRewardsRecord,
 	currentEpochs []CurrentEpochRecord, rewardPoolCoins sdk.Coins,
-	lastEpochTime *time.Time, currentEpochDays uint32,
+	lastEpochTime *time.Time, currentEpochDuration time.Duration, globalLockId uint64, locks []Lock,
+	autoCompoundFarmers []string, rewardsWithdrawAddresses []RewardsWithdrawAddressRecord,
+	lastStreamingTime *time.Time, pausedFunctions []PausableFunction,
+	globalUnbondingId uint64, unbondings []Unbonding,
+	globalRewardVestingId uint64, rewardVestings []RewardVesting,
+	cappedStakes []CappedStakeRecord, cappedTotalStakings []CappedTotalStakingsRecord,
+	startingRewards []StartingRewardsRecord, pendingRewards []PendingRewardsRecord,
+	planVestingDurations []PlanVestingDurationRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                    params,
-		GlobalPlanId:              globalPlanId,
-		PlanRecords:               plans,
-		StakingRecords:            stakings,
-		QueuedStakingRecords:      queuedStakings,
-		TotalStakingsRecords:      totalStakings,
-		HistoricalRewardsRecords:  historicalRewards,
-		OutstandingRewardsRecords: outstandingRewards,
-		CurrentEpochRecords:       currentEpochs,
-		RewardPoolCoins:           rewardPoolCoins,
-		LastEpochTime:             lastEpochTime,
-		CurrentEpochDays:          currentEpochDays,
+		Params:                        params,
+		GlobalPlanId:                  globalPlanId,
+		PlanRecords:                   plans,
+		StakingRecords:                stakings,
+		QueuedStakingRecords:          queuedStakings,
+		TotalStakingsRecords:          totalStakings,
+		HistoricalRewardsRecords:      historicalRewards,
+		OutstandingRewardsRecords:     outstandingRewards,
+		PlanHistoricalRewardsRecords:  planHistoricalRewards,
+		PlanOutstandingRewardsRecords: planOutstandingRewards,
+		CurrentEpochRecords:           currentEpochs,
+		RewardPoolCoins:               rewardPoolCoins,
+		LastEpochTime:                 lastEpochTime,
+		CurrentEpochDuration:          currentEpochDuration,
+		GlobalLockId:                  globalLockId,
+		Locks:                         locks,
+		AutoCompoundFarmers:           autoCompoundFarmers,
+		RewardsWithdrawAddressRecords: rewardsWithdrawAddresses,
+		LastStreamingTime:             lastStreamingTime,
+		PausedFunctions:               pausedFunctions,
+		GlobalUnbondingId:             globalUnbondingId,
+		Unbondings:                    unbondings,
+		GlobalRewardVestingId:         globalRewardVestingId,
+		RewardVestings:                rewardVestings,
+		CappedStakeRecords:            cappedStakes,
+		CappedTotalStakingsRecords:    cappedTotalStakings,
+		StartingRewardsRecords:        startingRewards,
+		PendingRewardsRecords:         pendingRewards,
+		PlanVestingDurationRecords:    planVestingDurations,
 	}
 }
 
@@ -42,10 +67,27 @@ func DefaultGenesisState() *GenesisState {
 		[]TotalStakingsRecord{},
 		[]HistoricalRewardsRecord{},
 		[]OutstandingRewardsRecord{},
+		[]PlanHistoricalRewardsRecord{},
+		[]PlanOutstandingRewardsRecord{},
 		[]CurrentEpochRecord{},
 		sdk.Coins{},
 		nil,
-		DefaultCurrentEpochDays,
+		DefaultCurrentEpochDuration,
+		0,
+		[]Lock{},
+		[]string{},
+		[]RewardsWithdrawAddressRecord{},
+		nil,
+		[]PausableFunction{},
+		0,
+		[]Unbonding{},
+		0,
+		[]RewardVesting{},
+		[]CappedStakeRecord{},
+		[]CappedTotalStakingsRecord{},
+		[]StartingRewardsRecord{},
+		[]PendingRewardsRecord{},
+		[]PlanVestingDurationRecord{},
 	)
 }
 
@@ -56,6 +98,7 @@ func ValidateGenesis(data GenesisState) error {
 	}
 
 	var plans []PlanI
+	planIds := map[uint64]bool{}
 	for _, record := range data.PlanRecords {
 		if err := record.Validate(); err != nil {
 			return err
@@ -64,6 +107,12 @@ func ValidateGenesis(data GenesisState) error {
 		if plan.GetId() > data.GlobalPlanId {
 			return fmt.Errorf("plan id is greater than the global last plan id")
 		}
+		// Plans are indexed by their ids, so a duplicate plan would leave
+		// stale index keys behind.
+		if planIds[plan.GetId()] {
+			return fmt.Errorf("duplicate plan id: %d", plan.GetId())
+		}
+		planIds[plan.GetId()] = true
 		plans = append(plans, plan)
 	}
 
@@ -101,18 +150,158 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, record := range data.PlanHistoricalRewardsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.PlanId > data.GlobalPlanId {
+			return fmt.Errorf("plan id is greater than the global last plan id")
+		}
+	}
+
+	for _, record := range data.PlanOutstandingRewardsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.PlanId > data.GlobalPlanId {
+			return fmt.Errorf("plan id is greater than the global last plan id")
+		}
+	}
+
 	for _, record := range data.CurrentEpochRecords {
 		if err := record.Validate(); err != nil {
 			return err
 		}
 	}
 
+	for _, lock := range data.Locks {
+		if err := lock.Validate(); err != nil {
+			return err
+		}
+		if lock.Id > data.GlobalLockId {
+			return fmt.Errorf("lock id is greater than the global last lock id")
+		}
+	}
+
+	for _, unbonding := range data.Unbondings {
+		if err := unbonding.Validate(); err != nil {
+			return err
+		}
+		if unbonding.Id > data.GlobalUnbondingId {
+			return fmt.Errorf("unbonding id is greater than the global last unbonding id")
+		}
+	}
+
+	vestingPlansByFarmer := map[string]map[uint64]bool{}
+	for _, vesting := range data.RewardVestings {
+		if err := vesting.Validate(); err != nil {
+			return err
+		}
+		if vesting.Id > data.GlobalRewardVestingId {
+			return fmt.Errorf("reward vesting id is greater than the global last reward vesting id")
+		}
+		if _, ok := vestingPlansByFarmer[vesting.Farmer]; !ok {
+			vestingPlansByFarmer[vesting.Farmer] = map[uint64]bool{}
+		}
+		if vestingPlansByFarmer[vesting.Farmer][vesting.PlanId] {
+			return fmt.Errorf("multiple reward vestings of farmer %s for plan %d", vesting.Farmer, vesting.PlanId)
+		}
+		vestingPlansByFarmer[vesting.Farmer][vesting.PlanId] = true
+	}
+
+	for _, record := range data.CappedTotalStakingsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.PlanId > data.GlobalPlanId {
+			return fmt.Errorf("plan id is greater than the global last plan id")
+		}
+	}
+
+	for _, record := range data.CappedStakeRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.PlanId > data.GlobalPlanId {
+			return fmt.Errorf("plan id is greater than the global last plan id")
+		}
+	}
+
+	for _, record := range data.StartingRewardsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		for _, planRewards := range record.StartingRewards.PlanCumulativeUnitRewards {
+			if planRewards.PlanId > data.GlobalPlanId {
+				return fmt.Errorf("plan id is greater than the global last plan id")
+			}
+		}
+	}
+
+	pendingRewardsFarmers := map[string]bool{}
+	for _, record := range data.PendingRewardsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if pendingRewardsFarmers[record.Farmer] {
+			return fmt.Errorf("duplicate pending rewards farmer: %s", record.Farmer)
+		}
+		pendingRewardsFarmers[record.Farmer] = true
+	}
+
+	planVestingDurationIds := map[uint64]bool{}
+	for _, record := range data.PlanVestingDurationRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.PlanId > data.GlobalPlanId {
+			return fmt.Errorf("plan id is greater than the global last plan id")
+		}
+		if planVestingDurationIds[record.PlanId] {
+			return fmt.Errorf("duplicate plan vesting duration: %d", record.PlanId)
+		}
+		planVestingDurationIds[record.PlanId] = true
+	}
+
+	autoCompoundFarmers := map[string]bool{}
+	for _, farmer := range data.AutoCompoundFarmers {
+		if _, err := sdk.AccAddressFromBech32(farmer); err != nil {
+			return err
+		}
+		if autoCompoundFarmers[farmer] {
+			return fmt.Errorf("duplicate auto-compound farmer: %s", farmer)
+		}
+		autoCompoundFarmers[farmer] = true
+	}
+
+	withdrawAddrFarmers := map[string]bool{}
+	for _, record := range data.RewardsWithdrawAddressRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if withdrawAddrFarmers[record.Farmer] {
+			return fmt.Errorf("duplicate rewards withdraw address record for farmer: %s", record.Farmer)
+		}
+		withdrawAddrFarmers[record.Farmer] = true
+	}
+
+	pausedFunctions := map[PausableFunction]bool{}
+	for _, function := range data.PausedFunctions {
+		if err := ValidatePausableFunction(function); err != nil {
+			return err
+		}
+		if pausedFunctions[function] {
+			return fmt.Errorf("duplicate paused function: %s", function)
+		}
+		pausedFunctions[function] = true
+	}
+
 	if err := data.RewardPoolCoins.Validate(); err != nil {
 		return err
 	}
 
-	if data.CurrentEpochDays == 0 {
-		return fmt.Errorf("current epoch days must be positive")
+	if data.CurrentEpochDuration <= 0 {
+		return fmt.Errorf("current epoch duration must be positive")
 	}
 
 	return nil
@@ -197,6 +386,115 @@ func (record OutstandingRewardsRecord) Validate() error {
 	return nil
 }
 
+// Validate validates PlanHistoricalRewardsRecord.
+func (record PlanHistoricalRewardsRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if err := record.HistoricalRewards.CumulativeUnitRewards.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates PlanOutstandingRewardsRecord.
+func (record PlanOutstandingRewardsRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if err := record.OutstandingRewards.Rewards.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates CappedStakeRecord.
+func (record CappedStakeRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if record.CappedStake.Amount.IsNil() || record.CappedStake.Amount.IsNegative() {
+		return fmt.Errorf("capped stake amount must not be negative: %s", record.CappedStake.Amount)
+	}
+	if err := record.CappedStake.StartingCumulativeUnitRewards.Validate(); err != nil {
+		return err
+	}
+	if err := record.CappedStake.PendingRewards.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates StartingRewardsRecord.
+func (record StartingRewardsRecord) Validate() error {
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if err := record.StartingRewards.CumulativeUnitRewards.Validate(); err != nil {
+		return err
+	}
+	for _, planRewards := range record.StartingRewards.PlanCumulativeUnitRewards {
+		if planRewards.PlanId == 0 {
+			return fmt.Errorf("plan id must not be 0")
+		}
+		if err := planRewards.CumulativeUnitRewards.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate validates PendingRewardsRecord.
+func (record PendingRewardsRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if err := record.PendingRewards.Rewards.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates PlanVestingDurationRecord.
+func (record PlanVestingDurationRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if record.VestingDuration <= 0 {
+		return fmt.Errorf("vesting duration must be positive: %s", record.VestingDuration)
+	}
+	return nil
+}
+
+// Validate validates CappedTotalStakingsRecord.
+func (record CappedTotalStakingsRecord) Validate() error {
+	if record.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
+		return err
+	}
+	if record.Amount.IsNil() || record.Amount.IsNegative() {
+		return fmt.Errorf("capped total staking amount must not be negative: %s", record.Amount)
+	}
+	return nil
+}
+
 // Validate validates CurrentEpochRecord.
 func (record CurrentEpochRecord) Validate() error {
 	if err := sdk.ValidateDenom(record.StakingCoinDenom); err != nil {
@@ -204,3 +502,14 @@ func (record CurrentEpochRecord) Validate() error {
 	}
 	return nil
 }
+
+// Validate validates RewardsWithdrawAddressRecord.
+func (record RewardsWithdrawAddressRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(record.WithdrawAddress); err != nil {
+		return err
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -48,9 +49,44 @@ type GenesisState struct {
 	// this param is needed for import/export validation
 	RewardPoolCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=reward_pool_coins,json=rewardPoolCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool_coins" yaml:"reward_pool_coins"`
 	// last_epoch_time specifies the last executed epoch time of the plans
-	LastEpochTime *time.Time `protobuf:"bytes,11,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty" yaml:"last_epoch_time"`
-	// current_epoch_days specifies the epoch used when allocating farming rewards in end blocker
-	CurrentEpochDays uint32 `protobuf:"varint,12,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+	LastEpochTime                 *time.Time                     `protobuf:"bytes,11,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty" yaml:"last_epoch_time"`
+	PlanHistoricalRewardsRecords  []PlanHistoricalRewardsRecord  `protobuf:"bytes,13,rep,name=plan_historical_rewards_records,json=planHistoricalRewardsRecords,proto3" json:"plan_historical_rewards_records" yaml:"plan_historical_rewards_records"`
+	PlanOutstandingRewardsRecords []PlanOutstandingRewardsRecord `protobuf:"bytes,14,rep,name=plan_outstanding_rewards_records,json=planOutstandingRewardsRecords,proto3" json:"plan_outstanding_rewards_records" yaml:"plan_outstanding_rewards_records"`
+	GlobalLockId                  uint64                         `protobuf:"varint,15,opt,name=global_lock_id,json=globalLockId,proto3" json:"global_lock_id,omitempty" yaml:"global_lock_id"`
+	// locks defines the locked stakings, including the queued ones
+	Locks []Lock `protobuf:"bytes,16,rep,name=locks,proto3" json:"locks"`
+	// auto_compound_farmers defines the farmers who enabled auto-compounding of rewards
+	AutoCompoundFarmers []string `protobuf:"bytes,17,rep,name=auto_compound_farmers,json=autoCompoundFarmers,proto3" json:"auto_compound_farmers,omitempty" yaml:"auto_compound_farmers"`
+	// rewards_withdraw_address_records defines the rewards withdraw addresses
+	// set by farmers
+	RewardsWithdrawAddressRecords []RewardsWithdrawAddressRecord `protobuf:"bytes,18,rep,name=rewards_withdraw_address_records,json=rewardsWithdrawAddressRecords,proto3" json:"rewards_withdraw_address_records" yaml:"rewards_withdraw_address_records"`
+	// last_streaming_time specifies the last time rewards were streamed
+	LastStreamingTime *time.Time `protobuf:"bytes,19,opt,name=last_streaming_time,json=lastStreamingTime,proto3,stdtime" json:"last_streaming_time,omitempty" yaml:"last_streaming_time"`
+	// current_epoch_duration specifies the epoch used when allocating farming rewards in end blocker
+	CurrentEpochDuration time.Duration `protobuf:"bytes,20,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration" yaml:"current_epoch_duration"`
+	// paused_functions defines the functions paused by governance
+	PausedFunctions   []PausableFunction `protobuf:"varint,21,rep,packed,name=paused_functions,json=pausedFunctions,proto3,enum=cosmos.farming.v1beta1.PausableFunction" json:"paused_functions,omitempty" yaml:"paused_functions"`
+	GlobalUnbondingId uint64             `protobuf:"varint,22,opt,name=global_unbonding_id,json=globalUnbondingId,proto3" json:"global_unbonding_id,omitempty" yaml:"global_unbonding_id"`
+	// unbondings defines the unstaked coins waiting to be paid out
+	Unbondings            []Unbonding `protobuf:"bytes,23,rep,name=unbondings,proto3" json:"unbondings"`
+	GlobalRewardVestingId uint64      `protobuf:"varint,24,opt,name=global_reward_vesting_id,json=globalRewardVestingId,proto3" json:"global_reward_vesting_id,omitempty" yaml:"global_reward_vesting_id"`
+	// reward_vestings defines the withdrawn rewards that are vesting
+	RewardVestings []RewardVesting `protobuf:"bytes,25,rep,name=reward_vestings,json=rewardVestings,proto3" json:"reward_vestings" yaml:"reward_vestings"`
+	// capped_stake_records defines the farmers' stakes counted toward the plans
+	// with stake caps
+	CappedStakeRecords []CappedStakeRecord `protobuf:"bytes,26,rep,name=capped_stake_records,json=cappedStakeRecords,proto3" json:"capped_stake_records" yaml:"capped_stake_records"`
+	// capped_total_stakings_records defines the total stakes counted toward
+	// the plans with stake caps
+	CappedTotalStakingsRecords []CappedTotalStakingsRecord `protobuf:"bytes,27,rep,name=capped_total_stakings_records,json=cappedTotalStakingsRecords,proto3" json:"capped_total_stakings_records" yaml:"capped_total_stakings_records"`
+	// starting_rewards_records defines the starting points of the farmers'
+	// positions which started while rewards were being streamed
+	StartingRewardsRecords []StartingRewardsRecord `protobuf:"bytes,28,rep,name=starting_rewards_records,json=startingRewardsRecords,proto3" json:"starting_rewards_records" yaml:"starting_rewards_records"`
+	// pending_rewards_records defines the rewards withdrawn for the farmers
+	// while harvesting was paused
+	PendingRewardsRecords []PendingRewardsRecord `protobuf:"bytes,29,rep,name=pending_rewards_records,json=pendingRewardsRecords,proto3" json:"pending_rewards_records" yaml:"pending_rewards_records"`
+	// plan_vesting_duration_records defines the vesting durations of the plans
+	// with a vesting duration, which are kept after the plans are deleted
+	PlanVestingDurationRecords []PlanVestingDurationRecord `protobuf:"bytes,30,rep,name=plan_vesting_duration_records,json=planVestingDurationRecords,proto3" json:"plan_vesting_duration_records" yaml:"plan_vesting_duration_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -330,6 +366,87 @@ func (m *OutstandingRewardsRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OutstandingRewardsRecord proto.InternalMessageInfo
 
+// PlanHistoricalRewardsRecord is used for import/export via genesis json.
+type PlanHistoricalRewardsRecord struct {
+	PlanId            uint64            `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	StakingCoinDenom  string            `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Epoch             uint64            `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	HistoricalRewards HistoricalRewards `protobuf:"bytes,4,opt,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards" yaml:"historical_rewards"`
+}
+
+func (m *PlanHistoricalRewardsRecord) Reset()         { *m = PlanHistoricalRewardsRecord{} }
+func (m *PlanHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*PlanHistoricalRewardsRecord) ProtoMessage()    {}
+func (*PlanHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{7}
+}
+func (m *PlanHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanHistoricalRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanHistoricalRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanHistoricalRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanHistoricalRewardsRecord.Merge(m, src)
+}
+func (m *PlanHistoricalRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanHistoricalRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanHistoricalRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanHistoricalRewardsRecord proto.InternalMessageInfo
+
+// PlanOutstandingRewardsRecord is used for import/export via genesis json.
+type PlanOutstandingRewardsRecord struct {
+	PlanId             uint64             `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	StakingCoinDenom   string             `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	OutstandingRewards OutstandingRewards `protobuf:"bytes,3,opt,name=outstanding_rewards,json=outstandingRewards,proto3" json:"outstanding_rewards" yaml:"outstanding_rewards"`
+}
+
+func (m *PlanOutstandingRewardsRecord) Reset()         { *m = PlanOutstandingRewardsRecord{} }
+func (m *PlanOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*PlanOutstandingRewardsRecord) ProtoMessage()    {}
+func (*PlanOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{8}
+}
+func (m *PlanOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanOutstandingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanOutstandingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanOutstandingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanOutstandingRewardsRecord.Merge(m, src)
+}
+func (m *PlanOutstandingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanOutstandingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanOutstandingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanOutstandingRewardsRecord proto.InternalMessageInfo
+
 // CurrentEpochRecord is used for import/export via genesis json.
 type CurrentEpochRecord struct {
 	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
@@ -340,7 +457,7 @@ func (m *CurrentEpochRecord) Reset()         { *m = CurrentEpochRecord{} }
 func (m *CurrentEpochRecord) String() string { return proto.CompactTextString(m) }
 func (*CurrentEpochRecord) ProtoMessage()    {}
 func (*CurrentEpochRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c67612b66bcd2967, []int{7}
+	return fileDescriptor_c67612b66bcd2967, []int{9}
 }
 func (m *CurrentEpochRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,6 +486,244 @@ func (m *CurrentEpochRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CurrentEpochRecord proto.InternalMessageInfo
 
+// RewardsWithdrawAddressRecord is used for import/export via genesis json.
+type RewardsWithdrawAddressRecord struct {
+	Farmer          string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *RewardsWithdrawAddressRecord) Reset()         { *m = RewardsWithdrawAddressRecord{} }
+func (m *RewardsWithdrawAddressRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardsWithdrawAddressRecord) ProtoMessage()    {}
+func (*RewardsWithdrawAddressRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{10}
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsWithdrawAddressRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsWithdrawAddressRecord.Merge(m, src)
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsWithdrawAddressRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsWithdrawAddressRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsWithdrawAddressRecord proto.InternalMessageInfo
+
+// CappedStakeRecord is used for import/export via genesis json.
+type CappedStakeRecord struct {
+	PlanId           uint64      `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	StakingCoinDenom string      `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Farmer           string      `protobuf:"bytes,3,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	CappedStake      CappedStake `protobuf:"bytes,4,opt,name=capped_stake,json=cappedStake,proto3" json:"capped_stake" yaml:"capped_stake"`
+}
+
+func (m *CappedStakeRecord) Reset()         { *m = CappedStakeRecord{} }
+func (m *CappedStakeRecord) String() string { return proto.CompactTextString(m) }
+func (*CappedStakeRecord) ProtoMessage()    {}
+func (*CappedStakeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{11}
+}
+func (m *CappedStakeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CappedStakeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CappedStakeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CappedStakeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CappedStakeRecord.Merge(m, src)
+}
+func (m *CappedStakeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CappedStakeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CappedStakeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CappedStakeRecord proto.InternalMessageInfo
+
+// CappedTotalStakingsRecord is used for import/export via genesis json.
+type CappedTotalStakingsRecord struct {
+	PlanId           uint64                                 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	StakingCoinDenom string                                 `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *CappedTotalStakingsRecord) Reset()         { *m = CappedTotalStakingsRecord{} }
+func (m *CappedTotalStakingsRecord) String() string { return proto.CompactTextString(m) }
+func (*CappedTotalStakingsRecord) ProtoMessage()    {}
+func (*CappedTotalStakingsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{12}
+}
+func (m *CappedTotalStakingsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CappedTotalStakingsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CappedTotalStakingsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CappedTotalStakingsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CappedTotalStakingsRecord.Merge(m, src)
+}
+func (m *CappedTotalStakingsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CappedTotalStakingsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CappedTotalStakingsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CappedTotalStakingsRecord proto.InternalMessageInfo
+
+// StartingRewardsRecord is used for import/export via genesis json.
+type StartingRewardsRecord struct {
+	StakingCoinDenom string          `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Farmer           string          `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StartingRewards  StartingRewards `protobuf:"bytes,3,opt,name=starting_rewards,json=startingRewards,proto3" json:"starting_rewards" yaml:"starting_rewards"`
+}
+
+func (m *StartingRewardsRecord) Reset()         { *m = StartingRewardsRecord{} }
+func (m *StartingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*StartingRewardsRecord) ProtoMessage()    {}
+func (*StartingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{13}
+}
+func (m *StartingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartingRewardsRecord.Merge(m, src)
+}
+func (m *StartingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartingRewardsRecord proto.InternalMessageInfo
+
+// PendingRewardsRecord is used for import/export via genesis json.
+type PendingRewardsRecord struct {
+	Farmer         string         `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	PendingRewards PendingRewards `protobuf:"bytes,2,opt,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards" yaml:"pending_rewards"`
+}
+
+func (m *PendingRewardsRecord) Reset()         { *m = PendingRewardsRecord{} }
+func (m *PendingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*PendingRewardsRecord) ProtoMessage()    {}
+func (*PendingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{14}
+}
+func (m *PendingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewardsRecord.Merge(m, src)
+}
+func (m *PendingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewardsRecord proto.InternalMessageInfo
+
+// PlanVestingDurationRecord is used for import/export via genesis json.
+type PlanVestingDurationRecord struct {
+	PlanId          uint64        `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	VestingDuration time.Duration `protobuf:"bytes,2,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+}
+
+func (m *PlanVestingDurationRecord) Reset()         { *m = PlanVestingDurationRecord{} }
+func (m *PlanVestingDurationRecord) String() string { return proto.CompactTextString(m) }
+func (*PlanVestingDurationRecord) ProtoMessage()    {}
+func (*PlanVestingDurationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{15}
+}
+func (m *PlanVestingDurationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanVestingDurationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanVestingDurationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanVestingDurationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanVestingDurationRecord.Merge(m, src)
+}
+func (m *PlanVestingDurationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanVestingDurationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanVestingDurationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanVestingDurationRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.farming.v1beta1.GenesisState")
 	proto.RegisterType((*PlanRecord)(nil), "cosmos.farming.v1beta1.PlanRecord")
@@ -377,7 +732,15 @@ func init() {
 	proto.RegisterType((*TotalStakingsRecord)(nil), "cosmos.farming.v1beta1.TotalStakingsRecord")
 	proto.RegisterType((*HistoricalRewardsRecord)(nil), "cosmos.farming.v1beta1.HistoricalRewardsRecord")
 	proto.RegisterType((*OutstandingRewardsRecord)(nil), "cosmos.farming.v1beta1.OutstandingRewardsRecord")
+	proto.RegisterType((*PlanHistoricalRewardsRecord)(nil), "cosmos.farming.v1beta1.PlanHistoricalRewardsRecord")
+	proto.RegisterType((*PlanOutstandingRewardsRecord)(nil), "cosmos.farming.v1beta1.PlanOutstandingRewardsRecord")
 	proto.RegisterType((*CurrentEpochRecord)(nil), "cosmos.farming.v1beta1.CurrentEpochRecord")
+	proto.RegisterType((*RewardsWithdrawAddressRecord)(nil), "cosmos.farming.v1beta1.RewardsWithdrawAddressRecord")
+	proto.RegisterType((*CappedStakeRecord)(nil), "cosmos.farming.v1beta1.CappedStakeRecord")
+	proto.RegisterType((*CappedTotalStakingsRecord)(nil), "cosmos.farming.v1beta1.CappedTotalStakingsRecord")
+	proto.RegisterType((*StartingRewardsRecord)(nil), "cosmos.farming.v1beta1.StartingRewardsRecord")
+	proto.RegisterType((*PendingRewardsRecord)(nil), "cosmos.farming.v1beta1.PendingRewardsRecord")
+	proto.RegisterType((*PlanVestingDurationRecord)(nil), "cosmos.farming.v1beta1.PlanVestingDurationRecord")
 }
 
 func init() {
@@ -385,77 +748,129 @@ func init() {
 }
 
 var fileDescriptor_c67612b66bcd2967 = []byte{
-	// 1105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xe4, 0x57, 0x9b, 0x49, 0x9c, 0xa4, 0x63, 0x27, 0xdf, 0x75, 0xfa, 0xcd, 0x6e, 0x3a,
-	0x22, 0xc8, 0x2d, 0xc4, 0xa6, 0xe5, 0x80, 0x54, 0x81, 0x2a, 0x96, 0xf2, 0xa3, 0x2a, 0x88, 0x30,
-	0xed, 0x89, 0x8b, 0x35, 0xb6, 0xa7, 0xce, 0x2a, 0xeb, 0x1d, 0x77, 0x67, 0x5c, 0xb0, 0x38, 0x70,
-	0x80, 0x43, 0x8f, 0x95, 0x90, 0x10, 0x07, 0x24, 0x7a, 0x44, 0x3d, 0x73, 0xe7, 0x5a, 0x71, 0xea,
-	0x09, 0x21, 0x0e, 0x29, 0x4a, 0x2e, 0x15, 0x37, 0xf2, 0x17, 0xa0, 0x9d, 0x19, 0xdb, 0xbb, 0xde,
-	0xdd, 0x24, 0x95, 0x22, 0x4e, 0xde, 0x99, 0x79, 0xef, 0x7d, 0x3e, 0xef, 0xcd, 0xcc, 0xe7, 0x8d,
-	0x61, 0x55, 0xb2, 0xa0, 0xcd, 0xc2, 0xae, 0x17, 0xc8, 0xfa, 0x3d, 0x1a, 0xfd, 0x76, 0xea, 0x0f,
-	0xae, 0x36, 0x99, 0xa4, 0x57, 0xeb, 0x1d, 0x16, 0x30, 0xe1, 0x89, 0x5a, 0x2f, 0xe4, 0x92, 0xa3,
-	0xb5, 0x16, 0x17, 0x5d, 0x2e, 0x6a, 0xc6, 0xaa, 0x66, 0xac, 0xd6, 0x2b, 0x1d, 0xce, 0x3b, 0x3e,
-	0xab, 0x2b, 0xab, 0x66, 0xff, 0x5e, 0x9d, 0x06, 0x03, 0xed, 0xb2, 0x5e, 0xee, 0xf0, 0x0e, 0x57,
-	0x9f, 0xf5, 0xe8, 0xcb, 0xcc, 0x56, 0x74, 0xa0, 0x86, 0x5e, 0x30, 0x51, 0xf5, 0x92, 0xad, 0x47,
-	0xf5, 0x26, 0x15, 0x6c, 0x44, 0xa3, 0xc5, 0xbd, 0xc0, 0xac, 0x1f, 0xc7, 0x76, 0xc8, 0x4b, 0x5b,
-	0x3a, 0x93, 0xac, 0xa4, 0xd7, 0x65, 0x42, 0xd2, 0x6e, 0x4f, 0x1b, 0xe0, 0xbf, 0x21, 0x5c, 0xfc,
-	0x50, 0x27, 0x78, 0x47, 0x52, 0xc9, 0xd0, 0xdb, 0x70, 0xae, 0x47, 0x43, 0xda, 0x15, 0x16, 0xd8,
-	0x04, 0xd5, 0x85, 0x6b, 0x76, 0x2d, 0x3b, 0xe1, 0xda, 0x8e, 0xb2, 0x72, 0x67, 0x9e, 0xee, 0x3b,
-	0x05, 0x62, 0x7c, 0xd0, 0x0d, 0xb8, 0xd4, 0xf1, 0x79, 0x93, 0xfa, 0x8d, 0x9e, 0x4f, 0x83, 0x86,
-	0xd7, 0xb6, 0xa6, 0x36, 0x41, 0x75, 0xc6, 0xad, 0x1c, 0xed, 0x3b, 0xab, 0x03, 0xda, 0xf5, 0xaf,
-	0xe3, 0xe4, 0x3a, 0x26, 0x8b, 0x7a, 0x62, 0xc7, 0xa7, 0xc1, 0xad, 0x36, 0x6a, 0xc2, 0x45, 0xb5,
-	0x12, 0xb2, 0x16, 0x0f, 0xdb, 0xc2, 0x9a, 0xde, 0x9c, 0xae, 0x2e, 0x5c, 0xc3, 0xb9, 0x24, 0x7c,
-	0x1a, 0x10, 0x65, 0xea, 0x5e, 0x8c, 0x88, 0x1c, 0xed, 0x3b, 0x25, 0x0d, 0x13, 0x8f, 0x82, 0xc9,
-	0x42, 0x6f, 0x64, 0x28, 0x50, 0x00, 0x97, 0x85, 0xa4, 0x7b, 0x5e, 0xd0, 0x19, 0xc1, 0xcc, 0x28,
-	0x98, 0xad, 0x3c, 0x98, 0x3b, 0xda, 0xdc, 0x20, 0xd9, 0x06, 0x69, 0x4d, 0x23, 0x4d, 0xc4, 0xc2,
-	0x64, 0x49, 0xc4, 0xcd, 0x05, 0x7a, 0x08, 0xe0, 0xda, 0xfd, 0x3e, 0xeb, 0xb3, 0x76, 0x63, 0x12,
-	0x77, 0x56, 0xe1, 0xbe, 0x96, 0x87, 0xfb, 0x99, 0xf2, 0x4a, 0xa2, 0x6f, 0x19, 0xf4, 0x0d, 0x8d,
-	0x9e, 0x1d, 0x18, 0x93, 0xf2, 0xfd, 0xb4, 0xaf, 0x40, 0x3f, 0x00, 0xb8, 0xbe, 0xeb, 0x09, 0xc9,
-	0x43, 0xaf, 0x45, 0xfd, 0x46, 0xc8, 0xbe, 0xa0, 0x61, 0x5b, 0x8c, 0xe8, 0xcc, 0x29, 0x3a, 0xf5,
-	0x3c, 0x3a, 0x1f, 0x8d, 0x3c, 0x89, 0x76, 0x34, 0x94, 0x2e, 0x1b, 0x4a, 0x97, 0x34, 0xa5, 0x7c,
-	0x00, 0x4c, 0xac, 0xdd, 0xec, 0x18, 0x02, 0xfd, 0x08, 0xe0, 0x45, 0xde, 0x97, 0x42, 0xd2, 0xa0,
-	0xad, 0x33, 0x49, 0x72, 0x3b, 0xa7, 0xb8, 0xbd, 0x91, 0xc7, 0xed, 0xd3, 0xb1, 0x6b, 0x92, 0xdc,
-	0x15, 0x43, 0x0e, 0x6b, 0x72, 0xc7, 0x40, 0x60, 0x52, 0xe1, 0x39, 0x51, 0x04, 0xfa, 0x16, 0xc0,
-	0xd5, 0x56, 0x3f, 0x0c, 0x59, 0x20, 0x1b, 0xac, 0xc7, 0x5b, 0xbb, 0x23, 0x62, 0xe7, 0x15, 0xb1,
-	0x2b, 0x79, 0xc4, 0xde, 0xd3, 0x4e, 0xef, 0x47, 0x3e, 0x86, 0xd2, 0x2b, 0x86, 0xd2, 0xff, 0x35,
-	0xa5, 0xcc, 0xb0, 0x98, 0x94, 0x5a, 0x29, 0x4f, 0x7d, 0x96, 0x24, 0x97, 0xd4, 0x1f, 0xee, 0xf8,
-	0xb8, 0x40, 0xf3, 0xc7, 0x9f, 0xa5, 0xbb, 0x91, 0x97, 0x39, 0x0e, 0x22, 0xfb, 0x2c, 0x65, 0x07,
-	0xc6, 0xa4, 0x2c, 0xd3, 0xbe, 0x02, 0x7d, 0x07, 0xe0, 0x05, 0x5d, 0xc1, 0x46, 0x8f, 0x73, 0xbf,
-	0x11, 0x09, 0x94, 0xb0, 0xa0, 0x62, 0x51, 0x19, 0xb2, 0x88, 0x24, 0x6c, 0x5c, 0x0a, 0xee, 0x05,
-	0xee, 0xc7, 0x06, 0xd3, 0xd2, 0x98, 0xa9, 0x08, 0xf8, 0xc9, 0x73, 0xa7, 0xda, 0xf1, 0xe4, 0x6e,
-	0xbf, 0x59, 0x6b, 0xf1, 0xae, 0x51, 0x46, 0xf3, 0xb3, 0x2d, 0xda, 0x7b, 0x75, 0x39, 0xe8, 0x31,
-	0xa1, 0x82, 0x09, 0xb2, 0xac, 0xfd, 0x77, 0x38, 0xf7, 0xd5, 0x04, 0x6a, 0xc2, 0x65, 0x9f, 0x8a,
-	0x61, 0x31, 0x23, 0xb9, 0xb3, 0x16, 0x94, 0x90, 0xad, 0xd7, 0xb4, 0x16, 0xd6, 0x86, 0x5a, 0x58,
-	0xbb, 0x3b, 0xd4, 0x42, 0xd7, 0x1e, 0xdf, 0xe6, 0x09, 0x67, 0xfc, 0xe8, 0xb9, 0x03, 0x48, 0x31,
-	0x9a, 0x55, 0xfb, 0x10, 0xf9, 0xa0, 0xd7, 0x21, 0x4a, 0xee, 0x59, 0x9b, 0x0e, 0x84, 0xb5, 0xb8,
-	0x09, 0xaa, 0x45, 0xb2, 0x12, 0xdf, 0xb5, 0x9b, 0x74, 0x20, 0xae, 0x9f, 0x7f, 0xf8, 0xd8, 0x29,
-	0xbc, 0x78, 0xec, 0x14, 0xf0, 0x0b, 0x00, 0xe1, 0x58, 0xb1, 0xd0, 0x5b, 0x70, 0x26, 0x92, 0x25,
-	0x23, 0xb4, 0xe5, 0x14, 0xbf, 0x77, 0x83, 0x81, 0x5b, 0x8c, 0xaa, 0xf5, 0xdb, 0x2f, 0xdb, 0xb3,
-	0x4a, 0x1f, 0x89, 0x72, 0x40, 0xdf, 0x03, 0x88, 0xcc, 0xf6, 0xc6, 0x4b, 0x3f, 0x75, 0x52, 0xe9,
-	0x3f, 0x31, 0xa5, 0xaf, 0xe8, 0x54, 0xd3, 0x21, 0x5e, 0xae, 0xf6, 0x2b, 0x26, 0xc0, 0xa8, 0xf8,
-	0xb1, 0x54, 0x7f, 0x05, 0xb0, 0x98, 0xd0, 0x1e, 0x74, 0x1b, 0xa2, 0xa1, 0x48, 0x45, 0x58, 0x8d,
-	0x36, 0x0b, 0x78, 0x57, 0xe5, 0x3e, 0xef, 0x6e, 0x8c, 0x49, 0xa5, 0x6d, 0x30, 0x59, 0x31, 0x93,
-	0x11, 0xc8, 0xcd, 0x68, 0x0a, 0xad, 0xc1, 0xb9, 0x08, 0x9c, 0x85, 0xaa, 0xbf, 0xcc, 0x13, 0x33,
-	0x42, 0x37, 0xe0, 0x39, 0x63, 0x6b, 0x4d, 0xab, 0xaa, 0x3a, 0x27, 0x48, 0xba, 0xe9, 0x5f, 0x43,
-	0xaf, 0x58, 0x06, 0xff, 0x00, 0x58, 0xca, 0xd0, 0xdf, 0xff, 0x26, 0x8f, 0x3d, 0xb8, 0x94, 0x14,
-	0x76, 0x93, 0xce, 0xd6, 0xa9, 0x3a, 0x85, 0xbb, 0x61, 0x36, 0x7a, 0x35, 0xab, 0x47, 0x60, 0x52,
-	0x4c, 0xf4, 0x86, 0x58, 0xce, 0xbf, 0x4f, 0xc1, 0x52, 0x86, 0x4e, 0x9c, 0x6d, 0xce, 0x1f, 0xc0,
-	0x39, 0xda, 0xe5, 0xfd, 0x40, 0xea, 0x9c, 0xdd, 0x5a, 0x44, 0xf6, 0xcf, 0x7d, 0xe7, 0xd5, 0x53,
-	0x1c, 0xbc, 0x5b, 0x81, 0x24, 0xc6, 0x1b, 0xfd, 0x04, 0xe0, 0xea, 0xb8, 0xed, 0x09, 0x16, 0x3e,
-	0x60, 0xe6, 0x22, 0xcc, 0x9f, 0x74, 0x11, 0x76, 0x92, 0x02, 0x9c, 0x19, 0xe5, 0xe5, 0xee, 0x42,
-	0x69, 0xd4, 0xf3, 0x55, 0x88, 0xc9, 0xeb, 0xf0, 0xcd, 0x14, 0xfc, 0x5f, 0x4e, 0xf7, 0x3c, 0xdb,
-	0xe2, 0x96, 0xe1, 0xac, 0x92, 0x24, 0xfd, 0xee, 0x22, 0x7a, 0x80, 0xbe, 0x82, 0x28, 0xdd, 0x94,
-	0xcd, 0x91, 0xba, 0x7c, 0xea, 0x6e, 0xef, 0x5e, 0x4a, 0xea, 0x47, 0x3a, 0x24, 0x26, 0x17, 0x52,
-	0xfd, 0x3d, 0x56, 0x85, 0x23, 0x00, 0xad, 0xbc, 0x3e, 0x7d, 0xb6, 0x65, 0xf8, 0x1a, 0x96, 0x32,
-	0x1a, 0xbd, 0x2a, 0xca, 0x31, 0xad, 0x3a, 0xcd, 0xcd, 0xc5, 0x26, 0xe5, 0xf5, 0xdc, 0xd7, 0x03,
-	0x26, 0x28, 0xfd, 0x6a, 0x88, 0x25, 0xfd, 0x04, 0x40, 0x94, 0x7e, 0x03, 0x9c, 0x6d, 0xba, 0xef,
-	0xc0, 0x62, 0xa2, 0x21, 0x99, 0x57, 0xb7, 0x75, 0xb4, 0xef, 0x94, 0x33, 0xde, 0x18, 0x98, 0x2c,
-	0xc6, 0xbb, 0xd4, 0x98, 0xac, 0x7b, 0xfb, 0xe7, 0x03, 0x1b, 0x3c, 0x3d, 0xb0, 0xc1, 0xb3, 0x03,
-	0x1b, 0xfc, 0x75, 0x60, 0x83, 0x47, 0x87, 0x76, 0xe1, 0xd9, 0xa1, 0x5d, 0xf8, 0xe3, 0xd0, 0x2e,
-	0x7c, 0xbe, 0x1d, 0xbb, 0x0e, 0x19, 0x7f, 0x41, 0xbe, 0x1c, 0x7d, 0xa9, 0x9b, 0xd1, 0x9c, 0x53,
-	0x9d, 0xec, 0xcd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xbb, 0x28, 0x1f, 0x5d, 0x0d, 0x00,
-	0x00,
+	// 1945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
+	0x1d, 0xcf, 0xd8, 0x69, 0xda, 0x4c, 0x3e, 0x9c, 0x8c, 0xed, 0xe4, 0xd9, 0x49, 0xfc, 0xd2, 0xe9,
+	0xb6, 0x4d, 0x77, 0xb7, 0x36, 0xed, 0x22, 0x81, 0x56, 0xa0, 0xd5, 0x7a, 0x97, 0x2e, 0x61, 0x17,
+	0x28, 0xd3, 0x02, 0x12, 0x42, 0xb2, 0x9e, 0xfd, 0x5e, 0x1d, 0x2b, 0xf6, 0x9b, 0xb7, 0x6f, 0x9e,
+	0x5b, 0x02, 0x07, 0x90, 0xe0, 0xd0, 0x03, 0x87, 0x4a, 0x20, 0xb4, 0x07, 0x24, 0x56, 0x70, 0x81,
+	0x1e, 0x38, 0xed, 0x15, 0x71, 0x5d, 0x71, 0xda, 0x13, 0x42, 0x1c, 0xbc, 0xa8, 0x3d, 0xb0, 0xe2,
+	0x46, 0xae, 0x5c, 0xd0, 0x9b, 0xf9, 0x3f, 0xfb, 0x7d, 0x27, 0xd5, 0x56, 0xcd, 0xa9, 0xf1, 0xbc,
+	0xff, 0xc7, 0x6f, 0xfe, 0xf3, 0xff, 0x98, 0xdf, 0x14, 0xef, 0x79, 0x96, 0x6d, 0x5a, 0xee, 0x68,
+	0x60, 0x7b, 0xad, 0x7b, 0x86, 0xff, 0x6f, 0xbf, 0x75, 0xff, 0x46, 0xd7, 0xf2, 0x8c, 0x1b, 0xad,
+	0xbe, 0x65, 0x5b, 0x62, 0x20, 0x9a, 0x8e, 0xcb, 0x3d, 0x4e, 0x36, 0x7a, 0x5c, 0x8c, 0xb8, 0x68,
+	0x82, 0x54, 0x13, 0xa4, 0xea, 0xb5, 0x3e, 0xe7, 0xfd, 0xa1, 0xd5, 0x92, 0x52, 0xdd, 0xf1, 0xbd,
+	0x96, 0x61, 0x1f, 0x29, 0x95, 0x7a, 0xa5, 0xcf, 0xfb, 0x5c, 0xfe, 0xd9, 0xf2, 0xff, 0x82, 0xd5,
+	0x9a, 0x32, 0xd4, 0x51, 0x1f, 0xc0, 0xaa, 0xfa, 0xd4, 0x50, 0xbf, 0x5a, 0x5d, 0x43, 0x58, 0x53,
+	0x18, 0x3d, 0x3e, 0xb0, 0xe1, 0x7b, 0x1e, 0xda, 0x00, 0x97, 0x92, 0xd4, 0xe3, 0xa8, 0xbc, 0xc1,
+	0xc8, 0x12, 0x9e, 0x31, 0x72, 0x02, 0x57, 0x71, 0x01, 0x73, 0xec, 0x1a, 0xde, 0x80, 0x83, 0x2b,
+	0xfa, 0x9f, 0x2d, 0xbc, 0xfc, 0x8e, 0x0a, 0xc0, 0x1d, 0xcf, 0xf0, 0x2c, 0xf2, 0x15, 0xbc, 0xe0,
+	0x18, 0xae, 0x31, 0x12, 0x1a, 0xda, 0x45, 0x7b, 0x4b, 0x37, 0x1b, 0xcd, 0xf4, 0x80, 0x34, 0x6f,
+	0x4b, 0xa9, 0xf6, 0xfc, 0xc7, 0x13, 0x7d, 0x8e, 0x81, 0x0e, 0x79, 0x03, 0xaf, 0xf6, 0x87, 0xbc,
+	0x6b, 0x0c, 0x3b, 0xce, 0xd0, 0xb0, 0x3b, 0x03, 0x53, 0x2b, 0xec, 0xa2, 0xbd, 0xf9, 0x76, 0xed,
+	0x78, 0xa2, 0x57, 0x8f, 0x8c, 0xd1, 0xf0, 0x75, 0x1a, 0xfd, 0x4e, 0xd9, 0xb2, 0x5a, 0xb8, 0x3d,
+	0x34, 0xec, 0x7d, 0x93, 0x74, 0xf1, 0xb2, 0xfc, 0xe2, 0x5a, 0x3d, 0xee, 0x9a, 0x42, 0x2b, 0xee,
+	0x16, 0xf7, 0x96, 0x6e, 0xd2, 0x4c, 0x10, 0x43, 0xc3, 0x66, 0x52, 0xb4, 0xbd, 0xe5, 0x03, 0x39,
+	0x9e, 0xe8, 0x65, 0xe5, 0x26, 0x6c, 0x85, 0xb2, 0x25, 0x67, 0x2a, 0x28, 0x88, 0x8d, 0x4b, 0xc2,
+	0x33, 0x0e, 0x07, 0x76, 0x7f, 0xea, 0x66, 0x5e, 0xba, 0xb9, 0x9c, 0xe5, 0xe6, 0x8e, 0x12, 0x07,
+	0x4f, 0x0d, 0xf0, 0xb4, 0xa1, 0x3c, 0xc5, 0x6c, 0x51, 0xb6, 0x2a, 0xc2, 0xe2, 0x82, 0x3c, 0x44,
+	0x78, 0xe3, 0xfd, 0xb1, 0x35, 0xb6, 0xcc, 0x4e, 0xdc, 0xef, 0x39, 0xe9, 0xf7, 0x95, 0x2c, 0xbf,
+	0xdf, 0x91, 0x5a, 0x51, 0xef, 0x97, 0xc1, 0xfb, 0x8e, 0xf2, 0x9e, 0x6e, 0x98, 0xb2, 0xca, 0xfb,
+	0x49, 0x5d, 0x41, 0x3e, 0x40, 0xb8, 0x7e, 0x30, 0x10, 0x1e, 0x77, 0x07, 0x3d, 0x63, 0xd8, 0x71,
+	0xad, 0x07, 0x86, 0x6b, 0x8a, 0x29, 0x9c, 0x05, 0x09, 0xa7, 0x95, 0x05, 0xe7, 0xeb, 0x53, 0x4d,
+	0xa6, 0x14, 0x01, 0xd2, 0x35, 0x80, 0x74, 0x51, 0x41, 0xca, 0x76, 0x40, 0x99, 0x76, 0x90, 0x6e,
+	0x43, 0x90, 0xdf, 0x22, 0xbc, 0xc5, 0xc7, 0x9e, 0xf0, 0x0c, 0xdb, 0x54, 0x3b, 0x89, 0x62, 0x3b,
+	0x2f, 0xb1, 0x7d, 0x21, 0x0b, 0xdb, 0xb7, 0x67, 0xaa, 0x51, 0x70, 0x2f, 0x03, 0x38, 0xaa, 0xc0,
+	0xe5, 0xb8, 0xa0, 0xac, 0xc6, 0x33, 0xac, 0x08, 0xf2, 0x0b, 0x84, 0xab, 0xbd, 0xb1, 0xeb, 0x5a,
+	0xb6, 0xd7, 0xb1, 0x1c, 0xde, 0x3b, 0x98, 0x02, 0xbb, 0x20, 0x81, 0xbd, 0x9c, 0x05, 0xec, 0x2d,
+	0xa5, 0xf4, 0x35, 0x5f, 0x07, 0x20, 0xbd, 0x04, 0x90, 0xb6, 0x15, 0xa4, 0x54, 0xb3, 0x94, 0x95,
+	0x7b, 0x09, 0x4d, 0x95, 0x4b, 0x1e, 0xf7, 0x8c, 0x61, 0x70, 0xe2, 0xb3, 0x00, 0x2d, 0xe6, 0xe7,
+	0xd2, 0x5d, 0x5f, 0x0b, 0xd2, 0x41, 0xa4, 0xe7, 0x52, 0xba, 0x61, 0xca, 0x2a, 0x5e, 0x52, 0x57,
+	0x90, 0x5f, 0x21, 0xbc, 0xae, 0x22, 0xd8, 0x71, 0x38, 0x1f, 0x76, 0xfc, 0x06, 0x26, 0x34, 0x2c,
+	0x51, 0xd4, 0x02, 0x14, 0x7e, 0x8b, 0x9b, 0x85, 0x82, 0x0f, 0xec, 0xf6, 0x7b, 0xe0, 0x53, 0x53,
+	0x3e, 0x13, 0x16, 0xe8, 0xe3, 0x4f, 0xf5, 0xbd, 0xfe, 0xc0, 0x3b, 0x18, 0x77, 0x9b, 0x3d, 0x3e,
+	0x82, 0xce, 0x09, 0xff, 0x5c, 0x17, 0xe6, 0x61, 0xcb, 0x3b, 0x72, 0x2c, 0x21, 0x8d, 0x09, 0x56,
+	0x52, 0xfa, 0xb7, 0x39, 0x1f, 0xca, 0x05, 0xd2, 0xc5, 0xa5, 0xa1, 0x21, 0x82, 0x60, 0xfa, 0xed,
+	0x50, 0x5b, 0x92, 0x8d, 0xac, 0xde, 0x54, 0xad, 0xb0, 0x19, 0xb4, 0xc2, 0xe6, 0xdd, 0xa0, 0x57,
+	0xb6, 0x1b, 0xb3, 0x6a, 0x8e, 0x29, 0xd3, 0x47, 0x9f, 0xea, 0x88, 0xad, 0xf8, 0xab, 0xf2, 0x1c,
+	0x7c, 0x1d, 0xf2, 0x18, 0x61, 0x5d, 0xf6, 0x97, 0x9c, 0x52, 0x5a, 0x91, 0x71, 0x78, 0x2d, 0xaf,
+	0x71, 0x65, 0x95, 0x53, 0x13, 0x22, 0x74, 0x25, 0xd4, 0xc9, 0xf2, 0x6a, 0x6a, 0xdb, 0xc9, 0x36,
+	0x26, 0xc8, 0x9f, 0x11, 0xde, 0x95, 0x26, 0xf2, 0x8a, 0x6b, 0x55, 0xa2, 0xfd, 0x62, 0x1e, 0xda,
+	0xcc, 0x02, 0x6b, 0x01, 0xdc, 0xab, 0x21, 0xb8, 0xb9, 0x55, 0xb6, 0xe3, 0xe4, 0x98, 0x0b, 0xcf,
+	0x90, 0x21, 0xef, 0x1d, 0xfa, 0x33, 0xa4, 0x94, 0x31, 0x43, 0xe0, 0xfb, 0x74, 0x86, 0xbc, 0xc7,
+	0x7b, 0x87, 0xfb, 0x26, 0xf9, 0x32, 0x3e, 0xe7, 0x7f, 0x11, 0xda, 0x9a, 0xdc, 0xd5, 0x76, 0xd6,
+	0xae, 0x7c, 0x71, 0x98, 0x5f, 0x4a, 0x81, 0xdc, 0xc5, 0x55, 0x63, 0xec, 0xf1, 0x4e, 0x8f, 0x8f,
+	0x1c, 0x3e, 0xb6, 0xcd, 0x8e, 0xaf, 0x62, 0xb9, 0x42, 0x5b, 0xdf, 0x2d, 0xee, 0x2d, 0xb6, 0x77,
+	0x67, 0x35, 0x9b, 0x2a, 0x46, 0x59, 0xd9, 0x5f, 0x7f, 0x0b, 0x96, 0x6f, 0xa9, 0x55, 0x79, 0x02,
+	0x41, 0x10, 0x1e, 0x0c, 0xbc, 0x03, 0xd3, 0x35, 0x1e, 0x74, 0x0c, 0xd3, 0x74, 0x2d, 0x31, 0x3b,
+	0x01, 0x92, 0x7f, 0x02, 0x10, 0xa3, 0xef, 0x83, 0xfa, 0x9b, 0x4a, 0x3b, 0xfd, 0x04, 0x4e, 0xf2,
+	0x45, 0xd9, 0x8e, 0x9b, 0x63, 0xce, 0x1f, 0x90, 0x65, 0x59, 0x06, 0xc2, 0x73, 0x2d, 0xc3, 0x87,
+	0xa1, 0xea, 0xa8, 0x7c, 0x62, 0x1d, 0xd1, 0xe3, 0x89, 0x5e, 0x0f, 0xd5, 0x51, 0xd4, 0x80, 0xaa,
+	0xa5, 0x75, 0xff, 0xcb, 0x9d, 0xe0, 0x83, 0xac, 0xa7, 0x1f, 0xe3, 0x8d, 0x68, 0x0f, 0x0c, 0x2e,
+	0x29, 0x5a, 0x45, 0xba, 0xac, 0x25, 0x5c, 0xbe, 0x0d, 0x02, 0xed, 0x6b, 0xd1, 0x0e, 0x96, 0x6e,
+	0x86, 0x7e, 0xe0, 0x3b, 0xae, 0x84, 0xfb, 0x69, 0x60, 0x80, 0x38, 0x78, 0xcd, 0x31, 0xc6, 0xc2,
+	0x32, 0x3b, 0xf7, 0xc6, 0x76, 0xcf, 0x5f, 0x12, 0x5a, 0x75, 0xb7, 0xb8, 0xb7, 0x7a, 0x73, 0x2f,
+	0xfb, 0xe6, 0x33, 0x16, 0x46, 0x77, 0x68, 0xdd, 0x02, 0x85, 0xf6, 0xd6, 0xf1, 0x44, 0xdf, 0x84,
+	0xec, 0x8f, 0xd9, 0xa2, 0xac, 0xa4, 0x96, 0x02, 0x61, 0x41, 0xbe, 0x85, 0xcb, 0x90, 0xbf, 0x63,
+	0xbb, 0xcb, 0x55, 0x8d, 0x0c, 0x4c, 0x6d, 0x43, 0x26, 0x79, 0x63, 0x16, 0xc1, 0x14, 0x21, 0xca,
+	0xd6, 0xd5, 0xea, 0x77, 0x83, 0xc5, 0x7d, 0x93, 0xbc, 0x83, 0xf1, 0x54, 0x46, 0x68, 0x9b, 0x32,
+	0x8f, 0x2e, 0x66, 0x61, 0x9f, 0x2a, 0x42, 0xe2, 0x87, 0x54, 0xc9, 0x0f, 0xb1, 0x06, 0x3e, 0xa1,
+	0x29, 0xdf, 0xb7, 0x84, 0x07, 0xe8, 0x34, 0x89, 0xee, 0xd2, 0xf1, 0x44, 0xd7, 0x23, 0xe8, 0x12,
+	0x92, 0x94, 0x55, 0xd5, 0x27, 0x95, 0xb1, 0xdf, 0x53, 0x1f, 0xf6, 0x4d, 0xff, 0xd6, 0x15, 0x15,
+	0x16, 0x5a, 0x2d, 0xff, 0xd6, 0x15, 0xb1, 0x10, 0xbf, 0x75, 0xc5, 0x6c, 0x51, 0xb6, 0xea, 0x86,
+	0xc5, 0x05, 0xf9, 0x19, 0xc2, 0x95, 0x9e, 0xe1, 0x38, 0x70, 0x39, 0xb2, 0xa6, 0x95, 0x56, 0x97,
+	0x5e, 0xaf, 0x65, 0xce, 0x6b, 0xa9, 0xe3, 0x0f, 0x3b, 0x0b, 0xca, 0xeb, 0x12, 0x78, 0xde, 0x82,
+	0x1c, 0x4b, 0x31, 0x4a, 0x19, 0xe9, 0xc5, 0xf5, 0x04, 0xf9, 0x3d, 0xc2, 0x3b, 0x20, 0x9d, 0x31,
+	0xb3, 0xb7, 0x24, 0x96, 0x1b, 0xf9, 0x58, 0xd2, 0x26, 0xf7, 0xab, 0x80, 0xe9, 0xa5, 0x08, 0xa6,
+	0xac, 0x01, 0x5e, 0xef, 0x65, 0x19, 0x12, 0xe4, 0x11, 0xc2, 0x9a, 0xf0, 0x0c, 0xd7, 0x4b, 0x9b,
+	0x0b, 0xdb, 0x12, 0xdf, 0xf5, 0x9c, 0x7b, 0xb1, 0xd4, 0x8b, 0x0e, 0x84, 0xab, 0x80, 0x4d, 0x9f,
+	0xde, 0x8f, 0x53, 0x8d, 0x53, 0xb6, 0x21, 0xd2, 0xf4, 0x05, 0xf9, 0x25, 0xc2, 0x9b, 0x8e, 0x95,
+	0x3e, 0xa9, 0x76, 0x24, 0xa2, 0x57, 0x33, 0x6b, 0xd3, 0x4a, 0x99, 0x50, 0x57, 0x00, 0x50, 0x03,
+	0x6a, 0xd4, 0xca, 0x18, 0x4c, 0x55, 0xc7, 0x4a, 0x1b, 0x48, 0xfe, 0x31, 0xca, 0xa9, 0x16, 0x64,
+	0x79, 0xd0, 0x57, 0xa6, 0xa0, 0x1a, 0xf9, 0xc7, 0xe8, 0x8f, 0x4f, 0xc8, 0xcb, 0xa0, 0xef, 0xa4,
+	0x1f, 0x63, 0xae, 0x17, 0xca, 0xea, 0x4e, 0x96, 0x21, 0xf1, 0xfa, 0x85, 0x87, 0x1f, 0xea, 0x73,
+	0x9f, 0x7d, 0xa8, 0xcf, 0x7d, 0x63, 0xfe, 0xc2, 0xf2, 0xda, 0x0a, 0x23, 0xb1, 0x56, 0x68, 0x1c,
+	0x09, 0xfa, 0x19, 0xc2, 0x78, 0xc6, 0x98, 0xc8, 0x97, 0xf0, 0xbc, 0x6f, 0x10, 0x88, 0x5e, 0x25,
+	0xd1, 0x64, 0xdf, 0xb4, 0x8f, 0xda, 0x2b, 0x3e, 0xc0, 0xbf, 0x7d, 0x74, 0xfd, 0x9c, 0xe4, 0x67,
+	0x4c, 0x2a, 0x90, 0xdf, 0x20, 0x4c, 0x60, 0x8f, 0xe1, 0xab, 0x5f, 0xe1, 0xa4, 0xab, 0xdf, 0x37,
+	0x61, 0xb7, 0x35, 0xb5, 0xdb, 0xa4, 0x89, 0x67, 0xbb, 0xfb, 0xad, 0x81, 0x81, 0xe9, 0xe5, 0x6f,
+	0x16, 0x04, 0xfa, 0x57, 0x84, 0x57, 0x22, 0xdc, 0x87, 0xbc, 0x8b, 0x49, 0x40, 0x92, 0x7c, 0x5f,
+	0x1d, 0xd3, 0xb2, 0xf9, 0x48, 0xee, 0x7d, 0xb1, 0xbd, 0x33, 0x03, 0x95, 0x94, 0xa1, 0x6c, 0x0d,
+	0x16, 0x7d, 0x27, 0x6f, 0xfb, 0x4b, 0x64, 0x03, 0x2f, 0xa8, 0x99, 0x2f, 0xf9, 0xed, 0x22, 0x83,
+	0x5f, 0xe4, 0x0d, 0x7c, 0x1e, 0x64, 0xb5, 0xa2, 0x8c, 0xaa, 0x7e, 0x02, 0xa5, 0x84, 0x36, 0x1c,
+	0x68, 0x85, 0x76, 0xf0, 0x5f, 0x84, 0xcb, 0x29, 0xfc, 0xef, 0xc5, 0xec, 0xe3, 0x10, 0xaf, 0x46,
+	0x89, 0x25, 0x6c, 0xe7, 0xf2, 0xa9, 0x98, 0x6a, 0x7b, 0x07, 0x0e, 0xba, 0x9a, 0xc6, 0x51, 0x29,
+	0x5b, 0x89, 0x70, 0xd3, 0xd0, 0x9e, 0xff, 0x5e, 0xc0, 0xe5, 0x94, 0x26, 0xf5, 0x7c, 0xf7, 0x7c,
+	0x0b, 0x2f, 0x18, 0x23, 0x3e, 0xb6, 0x3d, 0xb5, 0x67, 0x75, 0xdd, 0xfe, 0xe7, 0x44, 0xbf, 0x72,
+	0x8a, 0xc4, 0xdb, 0xb7, 0x3d, 0x06, 0xda, 0xe4, 0x77, 0x08, 0x57, 0x67, 0xb4, 0x5b, 0x58, 0xee,
+	0x7d, 0x0b, 0x0a, 0x61, 0xf1, 0xa4, 0x42, 0xb8, 0x1d, 0x25, 0x80, 0xa9, 0x56, 0x9e, 0xad, 0x16,
+	0xca, 0xd3, 0x37, 0x07, 0x69, 0x22, 0x5e, 0x0e, 0x3f, 0x2f, 0xe0, 0xcd, 0x0c, 0x86, 0xf0, 0x7c,
+	0x83, 0x5b, 0xc1, 0xe7, 0x64, 0xc3, 0x51, 0xef, 0x3e, 0x4c, 0xfd, 0x20, 0x3f, 0xc1, 0x24, 0x49,
+	0x60, 0x20, 0xa5, 0xae, 0x9d, 0xfa, 0xb5, 0xa1, 0x7d, 0x31, 0xda, 0x3f, 0x92, 0x26, 0x29, 0x5b,
+	0x4f, 0xbc, 0x2f, 0x84, 0xa2, 0x70, 0x8c, 0xb0, 0x96, 0xc5, 0x3b, 0x9e, 0x6f, 0x18, 0x7e, 0x8a,
+	0xcb, 0x29, 0x14, 0x48, 0x06, 0x25, 0xe7, 0xa9, 0x20, 0x89, 0xad, 0x4d, 0x61, 0xcb, 0xf5, 0xcc,
+	0xd7, 0x0b, 0xca, 0x48, 0xf2, 0xd5, 0x22, 0xb4, 0xe9, 0x8f, 0x0a, 0x78, 0x2b, 0x87, 0x6d, 0x92,
+	0x57, 0xf0, 0xf9, 0xe0, 0xad, 0x0e, 0xc9, 0x4b, 0x1e, 0x39, 0x9e, 0xe8, 0xab, 0xa1, 0x79, 0xe4,
+	0xdf, 0xe9, 0x16, 0x1c, 0xf5, 0x3c, 0x97, 0x1e, 0xa4, 0xc2, 0xe7, 0xcc, 0x95, 0xe2, 0xc9, 0xb9,
+	0x32, 0xff, 0xa2, 0x73, 0xe5, 0x0f, 0x05, 0xbc, 0x9d, 0x47, 0x7b, 0xcf, 0x30, 0x6e, 0x19, 0xc9,
+	0x55, 0x3c, 0x83, 0xe4, 0x7a, 0x8c, 0x30, 0x49, 0x3e, 0x70, 0x3d, 0xdf, 0x5a, 0xfa, 0x2a, 0x5e,
+	0x89, 0xdc, 0x65, 0xe0, 0x49, 0x59, 0x3b, 0x9e, 0xe8, 0x95, 0x14, 0xd6, 0x47, 0xd9, 0x72, 0x98,
+	0xe8, 0x85, 0xc0, 0x3e, 0x44, 0x78, 0x3b, 0x8f, 0x47, 0x87, 0xa6, 0x21, 0x8a, 0x4c, 0xc3, 0x5b,
+	0x78, 0x2d, 0xce, 0xa5, 0xe1, 0xec, 0x42, 0xcc, 0x2f, 0x2e, 0x41, 0x59, 0xe9, 0x41, 0xd4, 0x4b,
+	0x08, 0xca, 0xaf, 0x0b, 0x78, 0x3d, 0x41, 0x34, 0xce, 0x30, 0xa5, 0x66, 0x3b, 0x2f, 0x46, 0x76,
+	0xde, 0xc3, 0xcb, 0x61, 0xba, 0x03, 0x65, 0x78, 0xe9, 0x14, 0xdc, 0x29, 0xfe, 0x1e, 0x1f, 0x36,
+	0x43, 0xd9, 0x52, 0x88, 0x2d, 0x85, 0xc2, 0xf2, 0x6f, 0x84, 0x6b, 0x99, 0x9c, 0xe7, 0x0c, 0xc3,
+	0x33, 0xbb, 0x32, 0x14, 0x3f, 0xcf, 0x95, 0x21, 0xb4, 0xd3, 0xff, 0x21, 0x5c, 0x4d, 0x65, 0x4f,
+	0x2f, 0xe6, 0x7e, 0x27, 0xf0, 0x5a, 0x9c, 0x96, 0x41, 0xff, 0xb8, 0x7a, 0x4a, 0xae, 0xd7, 0xd6,
+	0xe1, 0x7c, 0x37, 0xd3, 0x59, 0x1e, 0x65, 0xa5, 0x18, 0xbb, 0x0b, 0xed, 0xfe, 0x4f, 0x08, 0x57,
+	0xd2, 0x98, 0x5a, 0x66, 0x05, 0x72, 0x5c, 0x8a, 0xb1, 0x36, 0x98, 0xa5, 0x57, 0x4e, 0x47, 0x04,
+	0xe3, 0xaf, 0x07, 0x31, 0x63, 0x94, 0xad, 0x46, 0xa9, 0x5f, 0x08, 0xeb, 0x5f, 0x10, 0xae, 0x65,
+	0x12, 0xb8, 0x67, 0xcb, 0xc9, 0x01, 0x5e, 0x8b, 0x93, 0x3b, 0xad, 0x70, 0xd2, 0x0b, 0xd7, 0xa5,
+	0x68, 0x9c, 0xe3, 0x06, 0xd4, 0xdb, 0x56, 0xe9, 0x7e, 0x14, 0xdd, 0x0c, 0x7f, 0xfb, 0xdd, 0x3f,
+	0x3e, 0x69, 0xa0, 0x8f, 0x9f, 0x34, 0xd0, 0x27, 0x4f, 0x1a, 0xe8, 0x5f, 0x4f, 0x1a, 0xe8, 0xd1,
+	0xd3, 0xc6, 0xdc, 0x27, 0x4f, 0x1b, 0x73, 0xff, 0x78, 0xda, 0x98, 0xfb, 0xc1, 0xf5, 0x50, 0x06,
+	0xa7, 0xfc, 0xaf, 0xe3, 0x8f, 0xa6, 0x7f, 0xc9, 0x64, 0xee, 0x2e, 0x48, 0x7c, 0xaf, 0xfd, 0x7f,
+	0x00, 0x7d, 0xde, 0x85, 0x33, 0x50, 0x1d, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -478,25 +893,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.CurrentEpochDays != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochDays))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.LastEpochTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
-		if err1 != nil {
-			return 0, err1
+	if len(m.PlanVestingDurationRecords) > 0 {
+		for iNdEx := len(m.PlanVestingDurationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanVestingDurationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
-		i -= n1
-		i = encodeVarintGenesis(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x5a
 	}
-	if len(m.RewardPoolCoins) > 0 {
-		for iNdEx := len(m.RewardPoolCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingRewardsRecords) > 0 {
+		for iNdEx := len(m.PendingRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RewardPoolCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -504,13 +920,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.TotalStakingsRecords) > 0 {
-		for iNdEx := len(m.TotalStakingsRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StartingRewardsRecords) > 0 {
+		for iNdEx := len(m.StartingRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalStakingsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StartingRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -518,13 +936,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.CurrentEpochRecords) > 0 {
-		for iNdEx := len(m.CurrentEpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CappedTotalStakingsRecords) > 0 {
+		for iNdEx := len(m.CappedTotalStakingsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CurrentEpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CappedTotalStakingsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -532,13 +952,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.OutstandingRewardsRecords) > 0 {
-		for iNdEx := len(m.OutstandingRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CappedStakeRecords) > 0 {
+		for iNdEx := len(m.CappedStakeRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OutstandingRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CappedStakeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -546,13 +968,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.HistoricalRewardsRecords) > 0 {
-		for iNdEx := len(m.HistoricalRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardVestings) > 0 {
+		for iNdEx := len(m.RewardVestings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.HistoricalRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -560,13 +984,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.QueuedStakingRecords) > 0 {
-		for iNdEx := len(m.QueuedStakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if m.GlobalRewardVestingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalRewardVestingId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.QueuedStakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -574,13 +1007,64 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.StakingRecords) > 0 {
-		for iNdEx := len(m.StakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if m.GlobalUnbondingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalUnbondingId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.PausedFunctions) > 0 {
+		dAtA2 := make([]byte, len(m.PausedFunctions)*10)
+		var j1 int
+		for _, num := range m.PausedFunctions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.LastStreamingTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStreamingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStreamingTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGenesis(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RewardsWithdrawAddressRecords) > 0 {
+		for iNdEx := len(m.RewardsWithdrawAddressRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardsWithdrawAddressRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -588,13 +1072,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.PlanRecords) > 0 {
-		for iNdEx := len(m.PlanRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoCompoundFarmers) > 0 {
+		for iNdEx := len(m.AutoCompoundFarmers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundFarmers[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundFarmers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundFarmers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PlanRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -602,21 +1099,178 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if m.GlobalPlanId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalPlanId))
+	if m.GlobalLockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalLockId))
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		dAtA[i] = 0x78
+	}
+	if len(m.PlanOutstandingRewardsRecords) > 0 {
+		for iNdEx := len(m.PlanOutstandingRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanOutstandingRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PlanHistoricalRewardsRecords) > 0 {
+		for iNdEx := len(m.PlanHistoricalRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanHistoricalRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastEpochTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGenesis(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RewardPoolCoins) > 0 {
+		for iNdEx := len(m.RewardPoolCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPoolCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TotalStakingsRecords) > 0 {
+		for iNdEx := len(m.TotalStakingsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalStakingsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CurrentEpochRecords) > 0 {
+		for iNdEx := len(m.CurrentEpochRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OutstandingRewardsRecords) > 0 {
+		for iNdEx := len(m.OutstandingRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HistoricalRewardsRecords) > 0 {
+		for iNdEx := len(m.HistoricalRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.QueuedStakingRecords) > 0 {
+		for iNdEx := len(m.QueuedStakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedStakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StakingRecords) > 0 {
+		for iNdEx := len(m.StakingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PlanRecords) > 0 {
+		for iNdEx := len(m.PlanRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GlobalPlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalPlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
@@ -903,7 +1557,7 @@ func (m *OutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrentEpochRecord) Marshal() (dAtA []byte, err error) {
+func (m *PlanHistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -913,243 +1567,2252 @@ func (m *CurrentEpochRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrentEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *PlanHistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PlanHistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CurrentEpoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpoch))
+	{
+		size, err := m.HistoricalRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if len(m.StakingCoinDenom) > 0 {
 		i -= len(m.StakingCoinDenom)
 		copy(dAtA[i:], m.StakingCoinDenom)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PlanOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PlanOutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanOutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.GlobalPlanId != 0 {
-		n += 1 + sovGenesis(uint64(m.GlobalPlanId))
-	}
-	if len(m.PlanRecords) > 0 {
-		for _, e := range m.PlanRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.StakingRecords) > 0 {
-		for _, e := range m.StakingRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.OutstandingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.QueuedStakingRecords) > 0 {
-		for _, e := range m.QueuedStakingRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.HistoricalRewardsRecords) > 0 {
-		for _, e := range m.HistoricalRewardsRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.OutstandingRewardsRecords) > 0 {
-		for _, e := range m.OutstandingRewardsRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentEpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CurrentEpochRecords) > 0 {
-		for _, e := range m.CurrentEpochRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TotalStakingsRecords) > 0 {
-		for _, e := range m.TotalStakingsRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.RewardPoolCoins) > 0 {
-		for _, e := range m.RewardPoolCoins {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.LastEpochTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.CurrentEpochDays != 0 {
-		n += 1 + sovGenesis(uint64(m.CurrentEpochDays))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PlanRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CurrentEpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Plan.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.FarmingPoolCoins) > 0 {
-		for _, e := range m.FarmingPoolCoins {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StakingRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardsWithdrawAddressRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsWithdrawAddressRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsWithdrawAddressRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Staking.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueuedStakingRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CappedStakeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CappedStakeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CappedStakeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.CappedStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.QueuedStaking.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalStakingsRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CappedTotalStakingsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CappedTotalStakingsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CappedTotalStakingsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.StakingReserveCoins) > 0 {
-		for _, e := range m.StakingReserveCoins {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StartingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StartingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.StartingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovGenesis(uint64(m.Epoch))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.HistoricalRewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.PendingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanVestingDurationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanVestingDurationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanVestingDurationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintGenesis(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.PlanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GlobalPlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.GlobalPlanId))
+	}
+	if len(m.PlanRecords) > 0 {
+		for _, e := range m.PlanRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakingRecords) > 0 {
+		for _, e := range m.StakingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedStakingRecords) > 0 {
+		for _, e := range m.QueuedStakingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HistoricalRewardsRecords) > 0 {
+		for _, e := range m.HistoricalRewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutstandingRewardsRecords) > 0 {
+		for _, e := range m.OutstandingRewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CurrentEpochRecords) > 0 {
+		for _, e := range m.CurrentEpochRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalStakingsRecords) > 0 {
+		for _, e := range m.TotalStakingsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPoolCoins) > 0 {
+		for _, e := range m.RewardPoolCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PlanHistoricalRewardsRecords) > 0 {
+		for _, e := range m.PlanHistoricalRewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PlanOutstandingRewardsRecords) > 0 {
+		for _, e := range m.PlanOutstandingRewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GlobalLockId != 0 {
+		n += 1 + sovGenesis(uint64(m.GlobalLockId))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundFarmers) > 0 {
+		for _, s := range m.AutoCompoundFarmers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardsWithdrawAddressRecords) > 0 {
+		for _, e := range m.RewardsWithdrawAddressRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastStreamingTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStreamingTime)
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.PausedFunctions) > 0 {
+		l = 0
+		for _, e := range m.PausedFunctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if m.GlobalUnbondingId != 0 {
+		n += 2 + sovGenesis(uint64(m.GlobalUnbondingId))
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GlobalRewardVestingId != 0 {
+		n += 2 + sovGenesis(uint64(m.GlobalRewardVestingId))
+	}
+	if len(m.RewardVestings) > 0 {
+		for _, e := range m.RewardVestings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CappedStakeRecords) > 0 {
+		for _, e := range m.CappedStakeRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CappedTotalStakingsRecords) > 0 {
+		for _, e := range m.CappedTotalStakingsRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StartingRewardsRecords) > 0 {
+		for _, e := range m.StartingRewardsRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRewardsRecords) > 0 {
+		for _, e := range m.PendingRewardsRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PlanVestingDurationRecords) > 0 {
+		for _, e := range m.PlanVestingDurationRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FarmingPoolCoins) > 0 {
+		for _, e := range m.FarmingPoolCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Staking.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *QueuedStakingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.QueuedStaking.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TotalStakingsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StakingReserveCoins) > 0 {
+		for _, e := range m.StakingReserveCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.HistoricalRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.OutstandingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PlanHistoricalRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.HistoricalRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PlanOutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.OutstandingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CurrentEpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *RewardsWithdrawAddressRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *CappedStakeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CappedStake.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CappedTotalStakingsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StartingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.StartingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PendingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PendingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PlanVestingDurationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovGenesis(uint64(m.PlanId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalPlanId", wireType)
+			}
+			m.GlobalPlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalPlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanRecords = append(m.PlanRecords, PlanRecord{})
+			if err := m.PlanRecords[len(m.PlanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingRecords = append(m.StakingRecords, StakingRecord{})
+			if err := m.StakingRecords[len(m.StakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedStakingRecords = append(m.QueuedStakingRecords, QueuedStakingRecord{})
+			if err := m.QueuedStakingRecords[len(m.QueuedStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRewardsRecords = append(m.HistoricalRewardsRecords, HistoricalRewardsRecord{})
+			if err := m.HistoricalRewardsRecords[len(m.HistoricalRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewardsRecords = append(m.OutstandingRewardsRecords, OutstandingRewardsRecord{})
+			if err := m.OutstandingRewardsRecords[len(m.OutstandingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochRecords = append(m.CurrentEpochRecords, CurrentEpochRecord{})
+			if err := m.CurrentEpochRecords[len(m.CurrentEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakingsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalStakingsRecords = append(m.TotalStakingsRecords, TotalStakingsRecord{})
+			if err := m.TotalStakingsRecords[len(m.TotalStakingsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPoolCoins = append(m.RewardPoolCoins, types.Coin{})
+			if err := m.RewardPoolCoins[len(m.RewardPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastEpochTime == nil {
+				m.LastEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanHistoricalRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanHistoricalRewardsRecords = append(m.PlanHistoricalRewardsRecords, PlanHistoricalRewardsRecord{})
+			if err := m.PlanHistoricalRewardsRecords[len(m.PlanHistoricalRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanOutstandingRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanOutstandingRewardsRecords = append(m.PlanOutstandingRewardsRecords, PlanOutstandingRewardsRecord{})
+			if err := m.PlanOutstandingRewardsRecords[len(m.PlanOutstandingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLockId", wireType)
+			}
+			m.GlobalLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFarmers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundFarmers = append(m.AutoCompoundFarmers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsWithdrawAddressRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsWithdrawAddressRecords = append(m.RewardsWithdrawAddressRecords, RewardsWithdrawAddressRecord{})
+			if err := m.RewardsWithdrawAddressRecords[len(m.RewardsWithdrawAddressRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStreamingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStreamingTime == nil {
+				m.LastStreamingTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastStreamingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType == 0 {
+				var v PausableFunction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PausableFunction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedFunctions = append(m.PausedFunctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedFunctions) == 0 {
+					m.PausedFunctions = make([]PausableFunction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PausableFunction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PausableFunction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedFunctions = append(m.PausedFunctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedFunctions", wireType)
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalUnbondingId", wireType)
+			}
+			m.GlobalUnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalUnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRewardVestingId", wireType)
+			}
+			m.GlobalRewardVestingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalRewardVestingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestings = append(m.RewardVestings, RewardVesting{})
+			if err := m.RewardVestings[len(m.RewardVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedStakeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CappedStakeRecords = append(m.CappedStakeRecords, CappedStakeRecord{})
+			if err := m.CappedStakeRecords[len(m.CappedStakeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedTotalStakingsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CappedTotalStakingsRecords = append(m.CappedTotalStakingsRecords, CappedTotalStakingsRecord{})
+			if err := m.CappedTotalStakingsRecords[len(m.CappedTotalStakingsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartingRewardsRecords = append(m.StartingRewardsRecords, StartingRewardsRecord{})
+			if err := m.StartingRewardsRecords[len(m.StartingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewardsRecords = append(m.PendingRewardsRecords, PendingRewardsRecord{})
+			if err := m.PendingRewardsRecords[len(m.PendingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanVestingDurationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanVestingDurationRecords = append(m.PlanVestingDurationRecords, PlanVestingDurationRecord{})
+			if err := m.PlanVestingDurationRecords[len(m.PlanVestingDurationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolCoins = append(m.FarmingPoolCoins, types.Coin{})
+			if err := m.FarmingPoolCoins[len(m.FarmingPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStakingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStaking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.OutstandingRewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *CurrentEpochRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovGenesis(uint64(m.CurrentEpoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,15 +3835,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalStakingsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalStakingsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingReserveCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1207,15 +3936,98 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakingReserveCoins = append(m.StakingReserveCoins, types.Coin{})
+			if err := m.StakingReserveCoins[len(m.StakingReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalPlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.GlobalPlanId = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,16 +4037,99 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalPlanId |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HistoricalRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutstandingRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1244,29 +4139,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanRecords = append(m.PlanRecords, PlanRecord{})
-			if err := m.PlanRecords[len(m.PlanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,16 +4186,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingRecords = append(m.StakingRecords, StakingRecord{})
-			if err := m.StakingRecords[len(m.StakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutstandingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakingRecords", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanHistoricalRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1312,31 +4254,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QueuedStakingRecords = append(m.QueuedStakingRecords, QueuedStakingRecord{})
-			if err := m.QueuedStakingRecords[len(m.QueuedStakingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1346,31 +4273,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalRewardsRecords = append(m.HistoricalRewardsRecords, HistoricalRewardsRecord{})
-			if err := m.HistoricalRewardsRecords[len(m.HistoricalRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsRecords", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1380,29 +4305,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutstandingRewardsRecords = append(m.OutstandingRewardsRecords, OutstandingRewardsRecord{})
-			if err := m.OutstandingRewardsRecords[len(m.OutstandingRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1429,16 +4339,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentEpochRecords = append(m.CurrentEpochRecords, CurrentEpochRecord{})
-			if err := m.CurrentEpochRecords[len(m.CurrentEpochRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HistoricalRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakingsRecords", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanOutstandingRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1448,31 +4407,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalStakingsRecords = append(m.TotalStakingsRecords, TotalStakingsRecord{})
-			if err := m.TotalStakingsRecords[len(m.TotalStakingsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1482,29 +4426,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardPoolCoins = append(m.RewardPoolCoins, types.Coin{})
-			if err := m.RewardPoolCoins[len(m.RewardPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1531,32 +4473,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastEpochTime == nil {
-				m.LastEpochTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutstandingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
-			}
-			m.CurrentEpochDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentEpochDays |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1578,7 +4498,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlanRecord) Unmarshal(dAtA []byte) error {
+func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1601,17 +4521,17 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlanRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentEpochRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1621,30 +4541,29 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolCoins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
 			}
-			var msglen int
+			m.CurrentEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1654,26 +4573,11 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FarmingPoolCoins = append(m.FarmingPoolCoins, types.Coin{})
-			if err := m.FarmingPoolCoins[len(m.FarmingPoolCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1695,7 +4599,7 @@ func (m *PlanRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakingRecord) Unmarshal(dAtA []byte) error {
+func (m *RewardsWithdrawAddressRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,15 +4622,15 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsWithdrawAddressRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsWithdrawAddressRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1754,11 +4658,11 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1786,40 +4690,7 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1842,7 +4713,7 @@ func (m *StakingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
+func (m *CappedStakeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1865,13 +4736,32 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedStakingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CappedStakeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedStakingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CappedStakeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
@@ -1903,7 +4793,7 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
@@ -1935,9 +4825,9 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStaking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedStake", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1964,7 +4854,7 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QueuedStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CappedStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1989,7 +4879,7 @@ func (m *QueuedStakingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
+func (m *CappedTotalStakingsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2012,17 +4902,17 @@ func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalStakingsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CappedTotalStakingsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalStakingsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CappedTotalStakingsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var stringLen uint64
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2032,27 +4922,14 @@ func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2080,15 +4957,13 @@ func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingReserveCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2098,23 +4973,23 @@ func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingReserveCoins = append(m.StakingReserveCoins, types.Coin{})
-			if err := m.StakingReserveCoins[len(m.StakingReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2139,7 +5014,7 @@ func (m *TotalStakingsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *StartingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,10 +5037,10 @@ func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartingRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2201,10 +5076,10 @@ func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			m.Epoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2214,14 +5089,27 @@ func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2248,7 +5136,7 @@ func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HistoricalRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2273,7 +5161,7 @@ func (m *HistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *PendingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,15 +5184,15 @@ func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutstandingRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2332,11 +5220,11 @@ func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2363,7 +5251,7 @@ func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutstandingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2388,7 +5276,7 @@ func (m *OutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
+func (m *PlanVestingDurationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2411,17 +5299,17 @@ func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentEpochRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanVestingDurationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentEpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanVestingDurationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var stringLen uint64
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2431,29 +5319,16 @@ func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
 			}
-			m.CurrentEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2463,11 +5338,25 @@ func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
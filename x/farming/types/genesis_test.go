@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,13 +59,13 @@ func TestValidateGenesis(t *testing.T) {
 			"",
 		},
 		{
-			"invalid NextEpochDays case",
+			"invalid NextEpochDuration case",
 			func(genState *types.GenesisState) {
 				params := types.DefaultParams()
-				params.NextEpochDays = 0
+				params.NextEpochDuration = 0
 				genState.Params = params
 			},
-			"next epoch days must be positive: 0",
+			"next epoch duration must be positive: 0s",
 		},
 		{
 			"invalid plan",
@@ -119,6 +121,24 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"coin 0denom3 amount is not positive",
 		},
+		{
+			"duplicate plan id",
+			func(genState *types.GenesisState) {
+				planAny, _ := types.PackPlan(validPlan)
+				genState.PlanRecords = []types.PlanRecord{
+					{
+						Plan:             *planAny,
+						FarmingPoolCoins: sdk.NewCoins(),
+					},
+					{
+						Plan:             *planAny,
+						FarmingPoolCoins: sdk.NewCoins(),
+					},
+				}
+				genState.GlobalPlanId = 1
+			},
+			"duplicate plan id: 1",
+		},
 		{
 			"plan id greater than the global last plan id",
 			func(genState *types.GenesisState) {
@@ -378,6 +398,79 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"coin 0.000000000000000000denom3 amount is not positive",
 		},
+		{
+			"invalid plan historical rewards records - invalid plan id",
+			func(genState *types.GenesisState) {
+				genState.PlanHistoricalRewardsRecords = []types.PlanHistoricalRewardsRecord{
+					{
+						PlanId:            0,
+						StakingCoinDenom:  validStakingCoinDenom,
+						Epoch:             1,
+						HistoricalRewards: validHistoricalRewards,
+					},
+				}
+			},
+			"plan id must not be 0",
+		},
+		{
+			"invalid plan historical rewards records - invalid staking coin denom",
+			func(genState *types.GenesisState) {
+				genState.GlobalPlanId = 1
+				genState.PlanHistoricalRewardsRecords = []types.PlanHistoricalRewardsRecord{
+					{
+						PlanId:            1,
+						StakingCoinDenom:  "!",
+						Epoch:             1,
+						HistoricalRewards: validHistoricalRewards,
+					},
+				}
+			},
+			"invalid denom: !",
+		},
+		{
+			"invalid plan historical rewards records - plan id greater than global plan id",
+			func(genState *types.GenesisState) {
+				genState.GlobalPlanId = 1
+				genState.PlanHistoricalRewardsRecords = []types.PlanHistoricalRewardsRecord{
+					{
+						PlanId:            2,
+						StakingCoinDenom:  validStakingCoinDenom,
+						Epoch:             1,
+						HistoricalRewards: validHistoricalRewards,
+					},
+				}
+			},
+			"plan id is greater than the global last plan id",
+		},
+		{
+			"invalid plan outstanding rewards records - invalid outstanding rewards",
+			func(genState *types.GenesisState) {
+				genState.GlobalPlanId = 1
+				genState.PlanOutstandingRewardsRecords = []types.PlanOutstandingRewardsRecord{
+					{
+						PlanId:           1,
+						StakingCoinDenom: validStakingCoinDenom,
+						OutstandingRewards: types.OutstandingRewards{
+							Rewards: sdk.DecCoins{sdk.NewInt64DecCoin("denom3", 0)},
+						},
+					},
+				}
+			},
+			"coin 0.000000000000000000denom3 amount is not positive",
+		},
+		{
+			"invalid plan outstanding rewards records - plan id greater than global plan id",
+			func(genState *types.GenesisState) {
+				genState.PlanOutstandingRewardsRecords = []types.PlanOutstandingRewardsRecord{
+					{
+						PlanId:             1,
+						StakingCoinDenom:   validStakingCoinDenom,
+						OutstandingRewards: validOutstandingRewards,
+					},
+				}
+			},
+			"plan id is greater than the global last plan id",
+		},
 		{
 			"invalid current epoch records - invalid staking coin denom",
 			func(genState *types.GenesisState) {
@@ -398,11 +491,213 @@ func TestValidateGenesis(t *testing.T) {
 			"coin 0denom1 amount is not positive",
 		},
 		{
-			"invalid current epoch days",
+			"invalid current epoch duration",
+			func(genState *types.GenesisState) {
+				genState.CurrentEpochDuration = 0
+			},
+			"current epoch duration must be positive",
+		},
+		{
+			"invalid auto-compound farmers - invalid address",
+			func(genState *types.GenesisState) {
+				genState.AutoCompoundFarmers = []string{"invalid"}
+			},
+			"decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"invalid auto-compound farmers - duplicate farmer",
+			func(genState *types.GenesisState) {
+				genState.AutoCompoundFarmers = []string{validAcc.String(), validAcc.String()}
+			},
+			fmt.Sprintf("duplicate auto-compound farmer: %s", validAcc.String()),
+		},
+		{
+			"invalid rewards withdraw address records - invalid withdraw address",
+			func(genState *types.GenesisState) {
+				genState.RewardsWithdrawAddressRecords = []types.RewardsWithdrawAddressRecord{
+					{Farmer: validAcc.String(), WithdrawAddress: "invalid"},
+				}
+			},
+			"decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"invalid rewards withdraw address records - duplicate farmer",
+			func(genState *types.GenesisState) {
+				genState.RewardsWithdrawAddressRecords = []types.RewardsWithdrawAddressRecord{
+					{Farmer: validAcc.String(), WithdrawAddress: validAcc.String()},
+					{Farmer: validAcc.String(), WithdrawAddress: validAcc.String()},
+				}
+			},
+			fmt.Sprintf("duplicate rewards withdraw address record for farmer: %s", validAcc.String()),
+		},
+		{
+			"invalid unbondings - invalid amount",
+			func(genState *types.GenesisState) {
+				genState.GlobalUnbondingId = 1
+				genState.Unbondings = []types.Unbonding{
+					types.NewUnbonding(1, validAcc, validStakingCoinDenom, sdk.ZeroInt(), types.ParseTime("2022-01-01T00:00:00Z")),
+				}
+			},
+			"unbonding amount must be positive: 0",
+		},
+		{
+			"invalid unbondings - id greater than the global last unbonding id",
+			func(genState *types.GenesisState) {
+				genState.Unbondings = []types.Unbonding{
+					types.NewUnbonding(1, validAcc, validStakingCoinDenom, sdk.NewInt(1_000_000), types.ParseTime("2022-01-01T00:00:00Z")),
+				}
+			},
+			"unbonding id is greater than the global last unbonding id",
+		},
+		{
+			"invalid reward vestings - claimed amount exceeds total amount",
+			func(genState *types.GenesisState) {
+				genState.GlobalRewardVestingId = 1
+				vesting := types.NewRewardVesting(
+					1, validAcc, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)),
+					types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z"))
+				vesting.ClaimedAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_000_000))
+				genState.RewardVestings = []types.RewardVesting{vesting}
+			},
+			"claimed amount 2000000stake must not exceed total amount 1000000stake",
+		},
+		{
+			"invalid reward vestings - id greater than the global last reward vesting id",
+			func(genState *types.GenesisState) {
+				genState.RewardVestings = []types.RewardVesting{
+					types.NewRewardVesting(
+						1, validAcc, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)),
+						types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z")),
+				}
+			},
+			"reward vesting id is greater than the global last reward vesting id",
+		},
+		{
+			"invalid reward vestings - multiple reward vestings for the same plan",
+			func(genState *types.GenesisState) {
+				genState.GlobalRewardVestingId = 2
+				genState.RewardVestings = []types.RewardVesting{
+					types.NewRewardVesting(
+						1, validAcc, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)),
+						types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z")),
+					types.NewRewardVesting(
+						2, validAcc, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)),
+						types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z")),
+				}
+			},
+			fmt.Sprintf("multiple reward vestings of farmer %s for plan 1", validAcc),
+		},
+		{
+			"invalid capped stakes - negative amount",
+			func(genState *types.GenesisState) {
+				genState.GlobalPlanId = 1
+				genState.CappedStakeRecords = []types.CappedStakeRecord{
+					{
+						PlanId:           1,
+						StakingCoinDenom: validStakingCoinDenom,
+						Farmer:           validAcc.String(),
+						CappedStake: types.CappedStake{
+							Amount:                        sdk.NewInt(-1),
+							StartingCumulativeUnitRewards: sdk.DecCoins{},
+							PendingRewards:                sdk.DecCoins{},
+						},
+					},
+				}
+			},
+			"capped stake amount must not be negative: -1",
+		},
+		{
+			"invalid capped total stakings - plan id greater than the global last plan id",
+			func(genState *types.GenesisState) {
+				genState.CappedTotalStakingsRecords = []types.CappedTotalStakingsRecord{
+					{
+						PlanId:           1,
+						StakingCoinDenom: validStakingCoinDenom,
+						Amount:           sdk.NewInt(1_000_000),
+					},
+				}
+			},
+			"plan id is greater than the global last plan id",
+		},
+		{
+			"invalid starting rewards - plan id greater than the global last plan id",
+			func(genState *types.GenesisState) {
+				genState.StartingRewardsRecords = []types.StartingRewardsRecord{
+					{
+						StakingCoinDenom: validStakingCoinDenom,
+						Farmer:           validAcc.String(),
+						StartingRewards: types.StartingRewards{
+							Epoch:                 1,
+							CumulativeUnitRewards: sdk.DecCoins{},
+							PlanCumulativeUnitRewards: []types.PlanCumulativeUnitRewards{
+								{PlanId: 1, CumulativeUnitRewards: sdk.DecCoins{}},
+							},
+						},
+					},
+				}
+			},
+			"plan id is greater than the global last plan id",
+		},
+		{
+			"invalid pending rewards - invalid rewards",
+			func(genState *types.GenesisState) {
+				genState.PendingRewardsRecords = []types.PendingRewardsRecord{
+					{
+						Farmer:         validAcc.String(),
+						PendingRewards: types.PendingRewards{Rewards: sdk.Coins{sdk.NewInt64Coin(validStakingCoinDenom, 0)}},
+					},
+				}
+			},
+			"coin 0denom1 amount is not positive",
+		},
+		{
+			"invalid pending rewards - duplicate farmer",
+			func(genState *types.GenesisState) {
+				genState.PendingRewardsRecords = []types.PendingRewardsRecord{
+					{
+						Farmer:         validAcc.String(),
+						PendingRewards: types.PendingRewards{Rewards: sdk.NewCoins(sdk.NewInt64Coin(validStakingCoinDenom, 1))},
+					},
+					{
+						Farmer:         validAcc.String(),
+						PendingRewards: types.PendingRewards{Rewards: sdk.NewCoins(sdk.NewInt64Coin(validStakingCoinDenom, 1))},
+					},
+				}
+			},
+			fmt.Sprintf("duplicate pending rewards farmer: %s", validAcc),
+		},
+		{
+			"invalid plan vesting duration - non-positive vesting duration",
+			func(genState *types.GenesisState) {
+				genState.GlobalPlanId = 1
+				genState.PlanVestingDurationRecords = []types.PlanVestingDurationRecord{
+					{PlanId: 1, VestingDuration: 0},
+				}
+			},
+			"vesting duration must be positive: 0s",
+		},
+		{
+			"invalid plan vesting duration - plan id greater than the global last plan id",
+			func(genState *types.GenesisState) {
+				genState.PlanVestingDurationRecords = []types.PlanVestingDurationRecord{
+					{PlanId: 1, VestingDuration: time.Hour},
+				}
+			},
+			"plan id is greater than the global last plan id",
+		},
+		{
+			"invalid paused functions - invalid function",
+			func(genState *types.GenesisState) {
+				genState.PausedFunctions = []types.PausableFunction{types.PausableFunctionNil}
+			},
+			"invalid function: PAUSABLE_FUNCTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid paused functions - duplicate function",
 			func(genState *types.GenesisState) {
-				genState.CurrentEpochDays = 0
+				genState.PausedFunctions = []types.PausableFunction{types.PausableFunctionStake, types.PausableFunctionStake}
 			},
-			"current epoch days must be positive",
+			"duplicate paused function: PAUSABLE_FUNCTION_STAKE",
 		},
 	}
 	for _, tc := range testCases {
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ FarmingHooks = MultiFarmingHooks{}
+
+// MultiFarmingHooks combines multiple farming hooks, all hook functions are run in array sequence
+type MultiFarmingHooks []FarmingHooks
+
+// NewMultiFarmingHooks returns a new MultiFarmingHooks.
+func NewMultiFarmingHooks(hooks ...FarmingHooks) MultiFarmingHooks {
+	return hooks
+}
+
+func (h MultiFarmingHooks) AfterStaked(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterStaked(ctx, farmerAcc, stakingCoins)
+	}
+}
+
+func (h MultiFarmingHooks) AfterQueuedStakingProcessed(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	for i := range h {
+		h[i].AfterQueuedStakingProcessed(ctx, farmerAcc, stakingCoinDenom, amount)
+	}
+}
+
+func (h MultiFarmingHooks) BeforeUnstaked(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].BeforeUnstaked(ctx, farmerAcc, unstakingCoins)
+	}
+}
+
+func (h MultiFarmingHooks) AfterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, rewards sdk.Coins) {
+	for i := range h {
+		h[i].AfterRewardsWithdrawn(ctx, farmerAcc, rewards)
+	}
+}
+
+func (h MultiFarmingHooks) AfterAllocateRewards(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterAllocateRewards(ctx)
+	}
+}
+
+func (h MultiFarmingHooks) AfterPlanTerminated(ctx sdk.Context, plan PlanI) {
+	for i := range h {
+		h[i].AfterPlanTerminated(ctx, plan)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -23,21 +24,52 @@ const (
 
 // keys for farming store prefixes
 var (
-	GlobalPlanIdKey     = []byte("globalPlanId")
-	LastEpochTimeKey    = []byte("lastEpochTime")
-	CurrentEpochDaysKey = []byte("currentEpochDays")
+	GlobalPlanIdKey          = []byte("globalPlanId")
+	LastEpochTimeKey         = []byte("lastEpochTime")
+	CurrentEpochDurationKey  = []byte("currentEpochDuration")
+	GlobalLockIdKey          = []byte("globalLockId")
+	LastStreamingTimeKey     = []byte("lastStreamingTime")
+	GlobalUnbondingIdKey     = []byte("globalUnbondingId")
+	GlobalRewardVestingIdKey = []byte("globalRewardVestingId")
 
-	PlanKeyPrefix = []byte{0x11}
+	PlanKeyPrefix                        = []byte{0x11}
+	PlanByFarmingPoolIndexKeyPrefix      = []byte{0x12}
+	PlanByStakingCoinDenomIndexKeyPrefix = []byte{0x13}
+	ActivePlanIndexKeyPrefix             = []byte{0x14}
+	TerminatedPlanIndexKeyPrefix         = []byte{0x15}
 
 	StakingKeyPrefix            = []byte{0x21}
 	StakingIndexKeyPrefix       = []byte{0x22}
 	QueuedStakingKeyPrefix      = []byte{0x23}
 	QueuedStakingIndexKeyPrefix = []byte{0x24}
 	TotalStakingKeyPrefix       = []byte{0x25}
+	LockKeyPrefix               = []byte{0x26}
+	LockIndexKeyPrefix          = []byte{0x27}
+	LockByEndTimeKeyPrefix      = []byte{0x28}
+	QueuedLockKeyPrefix         = []byte{0x29}
+	UnbondingKeyPrefix          = []byte{0x2a}
+	UnbondingIndexKeyPrefix     = []byte{0x2b}
+	UnbondingByTimeKeyPrefix    = []byte{0x2c}
+	LockByDenomIndexKeyPrefix   = []byte{0x2d}
 
 	HistoricalRewardsKeyPrefix  = []byte{0x31}
 	CurrentEpochKeyPrefix       = []byte{0x32}
 	OutstandingRewardsKeyPrefix = []byte{0x33}
+
+	PlanHistoricalRewardsKeyPrefix  = []byte{0x34}
+	PlanOutstandingRewardsKeyPrefix = []byte{0x35}
+	RewardVestingKeyPrefix          = []byte{0x36}
+	RewardVestingIndexKeyPrefix     = []byte{0x37}
+	CappedTotalStakingsKeyPrefix    = []byte{0x38}
+	CappedStakeKeyPrefix            = []byte{0x39}
+	StartingRewardsKeyPrefix        = []byte{0x3a}
+	PendingRewardsKeyPrefix         = []byte{0x3b}
+	PlanVestingDurationKeyPrefix    = []byte{0x3c}
+
+	AutoCompoundKeyPrefix           = []byte{0x41}
+	RewardsWithdrawAddressKeyPrefix = []byte{0x42}
+
+	PausedFunctionKeyPrefix = []byte{0x51}
 )
 
 // GetPlanKey returns kv indexing key of the plan
@@ -45,11 +77,51 @@ func GetPlanKey(planID uint64) []byte {
 	return append(PlanKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
 }
 
+// GetPlanByFarmingPoolIndexKey returns an indexing key for a plan by its
+// farming pool address.
+func GetPlanByFarmingPoolIndexKey(farmingPoolAcc sdk.AccAddress, planId uint64) []byte {
+	return append(GetPlansByFarmingPoolPrefix(farmingPoolAcc), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetPlansByFarmingPoolPrefix returns a key prefix used to iterate
+// plans by a farming pool address.
+func GetPlansByFarmingPoolPrefix(farmingPoolAcc sdk.AccAddress) []byte {
+	return append(PlanByFarmingPoolIndexKeyPrefix, address.MustLengthPrefix(farmingPoolAcc)...)
+}
+
+// GetPlanByStakingCoinDenomIndexKey returns an indexing key for a plan by
+// a staking coin denom of the plan.
+func GetPlanByStakingCoinDenomIndexKey(stakingCoinDenom string, planId uint64) []byte {
+	return append(GetPlansByStakingCoinDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetPlansByStakingCoinDenomPrefix returns a key prefix used to iterate
+// plans by a staking coin denom.
+func GetPlansByStakingCoinDenomPrefix(stakingCoinDenom string) []byte {
+	return append(PlanByStakingCoinDenomIndexKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetActivePlanIndexKey returns an indexing key for an active(non-terminated) plan.
+func GetActivePlanIndexKey(planId uint64) []byte {
+	return append(ActivePlanIndexKeyPrefix, sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetTerminatedPlanIndexKey returns an indexing key for a terminated plan.
+func GetTerminatedPlanIndexKey(planId uint64) []byte {
+	return append(TerminatedPlanIndexKeyPrefix, sdk.Uint64ToBigEndian(planId)...)
+}
+
 // GetStakingKey returns a key for staking of corresponding the id
 func GetStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
 	return append(append(StakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
 }
 
+// GetStakingsByStakingCoinDenomPrefix returns a key prefix used to iterate
+// stakings by a staking coin denom.
+func GetStakingsByStakingCoinDenomPrefix(stakingCoinDenom string) []byte {
+	return append(StakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
 // GetStakingIndexKey returns an indexing key for a staking.
 func GetStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
 	return append(append(StakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...), []byte(stakingCoinDenom)...)
@@ -66,6 +138,12 @@ func GetQueuedStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []by
 	return append(append(QueuedStakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
 }
 
+// GetQueuedStakingsByStakingCoinDenomPrefix returns a key prefix used to iterate
+// queued stakings by a staking coin denom.
+func GetQueuedStakingsByStakingCoinDenomPrefix(stakingCoinDenom string) []byte {
+	return append(QueuedStakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
 // GetQueuedStakingIndexKey returns an indexing key for a queuded staking.
 func GetQueuedStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
 	return append(append(QueuedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...), []byte(stakingCoinDenom)...)
@@ -82,6 +160,106 @@ func GetTotalStakingsKey(stakingCoinDenom string) []byte {
 	return append(TotalStakingKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetLockKey returns a key for a lock.
+func GetLockKey(lockId uint64) []byte {
+	return append(LockKeyPrefix, sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GetLockIndexKey returns an indexing key for a lock.
+func GetLockIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, lockId uint64) []byte {
+	return append(GetLocksByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GetLocksByFarmerPrefix returns a key prefix used to iterate
+// locks by a farmer.
+func GetLocksByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(LockIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetLocksByFarmerAndDenomPrefix returns a key prefix used to iterate
+// locks by a farmer and a staking coin denom.
+func GetLocksByFarmerAndDenomPrefix(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(GetLocksByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetLockByEndTimeKey returns an indexing key for a lock by its end time.
+func GetLockByEndTimeKey(endTime time.Time, lockId uint64) []byte {
+	return append(GetLocksByEndTimePrefix(endTime), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GetLocksByEndTimePrefix returns a key prefix used to iterate
+// locks by an end time.
+func GetLocksByEndTimePrefix(endTime time.Time) []byte {
+	return append(LockByEndTimeKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetLockByDenomIndexKey returns an indexing key for a lock by its staking
+// coin denom.
+func GetLockByDenomIndexKey(stakingCoinDenom string, lockId uint64) []byte {
+	return append(GetLocksByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GetLocksByDenomPrefix returns a key prefix used to iterate
+// locks by a staking coin denom.
+func GetLocksByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(LockByDenomIndexKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetQueuedLockKey returns a key for a queued lock.
+func GetQueuedLockKey(lockId uint64) []byte {
+	return append(QueuedLockKeyPrefix, sdk.Uint64ToBigEndian(lockId)...)
+}
+
+// GetUnbondingKey returns a key for an unbonding.
+func GetUnbondingKey(unbondingId uint64) []byte {
+	return append(UnbondingKeyPrefix, sdk.Uint64ToBigEndian(unbondingId)...)
+}
+
+// GetUnbondingIndexKey returns an indexing key for an unbonding.
+func GetUnbondingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, unbondingId uint64) []byte {
+	return append(GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), sdk.Uint64ToBigEndian(unbondingId)...)
+}
+
+// GetUnbondingsByFarmerPrefix returns a key prefix used to iterate
+// unbondings by a farmer.
+func GetUnbondingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(UnbondingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetUnbondingsByFarmerAndDenomPrefix returns a key prefix used to iterate
+// unbondings by a farmer and a staking coin denom.
+func GetUnbondingsByFarmerAndDenomPrefix(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(GetUnbondingsByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetUnbondingByTimeKey returns an indexing key for an unbonding by its
+// completion time.
+func GetUnbondingByTimeKey(completionTime time.Time, unbondingId uint64) []byte {
+	return append(GetUnbondingsByTimePrefix(completionTime), sdk.Uint64ToBigEndian(unbondingId)...)
+}
+
+// GetUnbondingsByTimePrefix returns a key prefix used to iterate
+// unbondings by a completion time.
+func GetUnbondingsByTimePrefix(completionTime time.Time) []byte {
+	return append(UnbondingByTimeKeyPrefix, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetRewardVestingKey returns a key for a reward vesting.
+func GetRewardVestingKey(vestingId uint64) []byte {
+	return append(RewardVestingKeyPrefix, sdk.Uint64ToBigEndian(vestingId)...)
+}
+
+// GetRewardVestingIndexKey returns an indexing key for a reward vesting.
+func GetRewardVestingIndexKey(farmerAcc sdk.AccAddress, vestingId uint64) []byte {
+	return append(GetRewardVestingsByFarmerPrefix(farmerAcc), sdk.Uint64ToBigEndian(vestingId)...)
+}
+
+// GetRewardVestingsByFarmerPrefix returns a key prefix used to iterate
+// reward vestings by a farmer.
+func GetRewardVestingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(RewardVestingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
 // GetHistoricalRewardsKey returns a key for a historical rewards record.
 func GetHistoricalRewardsKey(stakingCoinDenom string, epoch uint64) []byte {
 	return append(append(HistoricalRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...), sdk.Uint64ToBigEndian(epoch)...)
@@ -103,6 +281,114 @@ func GetOutstandingRewardsKey(stakingCoinDenom string) []byte {
 	return append(OutstandingRewardsKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetPlanHistoricalRewardsKey returns a key for a plan historical rewards record.
+func GetPlanHistoricalRewardsKey(stakingCoinDenom string, planId uint64, epoch uint64) []byte {
+	return append(GetPlanHistoricalRewardsPrefix(stakingCoinDenom, planId), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetPlanHistoricalRewardsPrefix returns a key prefix used to iterate
+// historical rewards by a staking coin denom and a plan id.
+func GetPlanHistoricalRewardsPrefix(stakingCoinDenom string, planId uint64) []byte {
+	return append(GetPlanHistoricalRewardsByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetPlanHistoricalRewardsByDenomPrefix returns a key prefix used to iterate
+// plan historical rewards by a staking coin denom.
+func GetPlanHistoricalRewardsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(PlanHistoricalRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetPlanOutstandingRewardsKey returns a key for a plan outstanding rewards record.
+func GetPlanOutstandingRewardsKey(stakingCoinDenom string, planId uint64) []byte {
+	return append(GetPlanOutstandingRewardsByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetPlanOutstandingRewardsByDenomPrefix returns a key prefix used to iterate
+// plan outstanding rewards by a staking coin denom.
+func GetPlanOutstandingRewardsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(PlanOutstandingRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetCappedTotalStakingsKey returns a key for the total amount of stakes
+// counted toward a plan with stake caps.
+func GetCappedTotalStakingsKey(stakingCoinDenom string, planId uint64) []byte {
+	return append(GetCappedTotalStakingsByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetCappedTotalStakingsByDenomPrefix returns a key prefix used to iterate
+// plans with stake caps by a staking coin denom.
+func GetCappedTotalStakingsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(CappedTotalStakingsKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetCappedStakeKey returns a key for the portion of a farmer's stake
+// counted toward a plan with stake caps.
+func GetCappedStakeKey(stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64) []byte {
+	return append(GetCappedStakesByFarmerPrefix(stakingCoinDenom, farmerAcc), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetCappedStakesByFarmerPrefix returns a key prefix used to iterate
+// capped stakes by a staking coin denom and a farmer.
+func GetCappedStakesByFarmerPrefix(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
+	return append(GetCappedStakesByDenomPrefix(stakingCoinDenom), address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetCappedStakesByDenomPrefix returns a key prefix used to iterate
+// capped stakes by a staking coin denom.
+func GetCappedStakesByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(CappedStakeKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetStartingRewardsKey returns a key for the starting rewards of a farmer's
+// positions for a staking coin denom.
+func GetStartingRewardsKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
+	return append(GetStartingRewardsByDenomPrefix(stakingCoinDenom), address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetStartingRewardsByDenomPrefix returns a key prefix used to iterate
+// starting rewards by a staking coin denom.
+func GetStartingRewardsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(StartingRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetPendingRewardsKey returns a key for the pending rewards of a farmer.
+func GetPendingRewardsKey(farmerAcc sdk.AccAddress) []byte {
+	return append(PendingRewardsKeyPrefix, farmerAcc...)
+}
+
+// GetPlanVestingDurationKey returns a key for the vesting duration of a plan.
+func GetPlanVestingDurationKey(planId uint64) []byte {
+	return append(PlanVestingDurationKeyPrefix, sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetAutoCompoundKey returns a key for the auto-compounding setting of a farmer.
+func GetAutoCompoundKey(farmerAcc sdk.AccAddress) []byte {
+	return append(AutoCompoundKeyPrefix, farmerAcc...)
+}
+
+// GetRewardsWithdrawAddressKey returns a key for the rewards withdraw address of a farmer.
+func GetRewardsWithdrawAddressKey(farmerAcc sdk.AccAddress) []byte {
+	return append(RewardsWithdrawAddressKeyPrefix, farmerAcc...)
+}
+
+// GetPausedFunctionKey returns a key for a paused function.
+func GetPausedFunctionKey(function PausableFunction) []byte {
+	return append(PausedFunctionKeyPrefix, byte(function))
+}
+
+// ParsePlanIndexKey parses a plan index key and returns the plan id, which is
+// the last part of all plan index keys.
+func ParsePlanIndexKey(key []byte) (planId uint64) {
+	if !bytes.HasPrefix(key, PlanByFarmingPoolIndexKeyPrefix) &&
+		!bytes.HasPrefix(key, PlanByStakingCoinDenomIndexKeyPrefix) &&
+		!bytes.HasPrefix(key, ActivePlanIndexKeyPrefix) &&
+		!bytes.HasPrefix(key, TerminatedPlanIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planId = sdk.BigEndianToUint64(key[len(key)-8:])
+	return
+}
+
 // ParseStakingKey parses a staking key.
 func ParseStakingKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress) {
 	if !bytes.HasPrefix(key, StakingKeyPrefix) {
@@ -156,6 +442,91 @@ func ParseTotalStakingsKey(key []byte) (stakingCoinDenom string) {
 	return
 }
 
+// ParseLockIndexKey parses a lock index key.
+func ParseLockIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, lockId uint64) {
+	if !bytes.HasPrefix(key, LockIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	lockId = sdk.BigEndianToUint64(key[3+addrLen+denomLen:])
+	return
+}
+
+// ParseLockByEndTimeKey parses a lock by end time index key.
+func ParseLockByEndTimeKey(key []byte) (endTime time.Time, lockId uint64) {
+	if !bytes.HasPrefix(key, LockByEndTimeKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	lockId = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
+// ParseLockByDenomIndexKey parses a lock by staking coin denom index key.
+func ParseLockByDenomIndexKey(key []byte) (stakingCoinDenom string, lockId uint64) {
+	if !bytes.HasPrefix(key, LockByDenomIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	lockId = sdk.BigEndianToUint64(key[2+denomLen:])
+	return
+}
+
+// ParseQueuedLockKey parses a queued lock key.
+func ParseQueuedLockKey(key []byte) (lockId uint64) {
+	if !bytes.HasPrefix(key, QueuedLockKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	lockId = sdk.BigEndianToUint64(key[1:])
+	return
+}
+
+// ParseUnbondingIndexKey parses an unbonding index key.
+func ParseUnbondingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, unbondingId uint64) {
+	if !bytes.HasPrefix(key, UnbondingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	unbondingId = sdk.BigEndianToUint64(key[3+addrLen+denomLen:])
+	return
+}
+
+// ParseUnbondingByTimeKey parses an unbonding by completion time index key.
+func ParseUnbondingByTimeKey(key []byte) (completionTime time.Time, unbondingId uint64) {
+	if !bytes.HasPrefix(key, UnbondingByTimeKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	completionTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	unbondingId = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
+// ParseRewardVestingIndexKey parses a reward vesting index key.
+func ParseRewardVestingIndexKey(key []byte) (farmerAcc sdk.AccAddress, vestingId uint64) {
+	if !bytes.HasPrefix(key, RewardVestingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	vestingId = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
 // ParseHistoricalRewardsKey parses a historical rewards key.
 func ParseHistoricalRewardsKey(key []byte) (stakingCoinDenom string, epoch uint64) {
 	if !bytes.HasPrefix(key, HistoricalRewardsKeyPrefix) {
@@ -185,6 +556,74 @@ func ParseOutstandingRewardsKey(key []byte) (stakingCoinDenom string) {
 	return
 }
 
+// ParsePlanHistoricalRewardsKey parses a plan historical rewards key.
+func ParsePlanHistoricalRewardsKey(key []byte) (stakingCoinDenom string, planId uint64, epoch uint64) {
+	if !bytes.HasPrefix(key, PlanHistoricalRewardsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	planId = sdk.BigEndianToUint64(key[2+denomLen : 10+denomLen])
+	epoch = sdk.BigEndianToUint64(key[10+denomLen:])
+	return
+}
+
+// ParsePlanOutstandingRewardsKey parses a plan outstanding rewards key.
+func ParsePlanOutstandingRewardsKey(key []byte) (stakingCoinDenom string, planId uint64) {
+	if !bytes.HasPrefix(key, PlanOutstandingRewardsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	planId = sdk.BigEndianToUint64(key[2+denomLen:])
+	return
+}
+
+// ParseAutoCompoundKey parses an auto-compounding setting key.
+func ParseAutoCompoundKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, AutoCompoundKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+// ParseRewardsWithdrawAddressKey parses a rewards withdraw address key.
+func ParseRewardsWithdrawAddressKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, RewardsWithdrawAddressKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+// ParsePendingRewardsKey parses a pending rewards key.
+func ParsePendingRewardsKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, PendingRewardsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	farmerAcc = key[1:]
+	return
+}
+
+// ParsePlanVestingDurationKey parses a plan vesting duration key.
+func ParsePlanVestingDurationKey(key []byte) (planId uint64) {
+	if !bytes.HasPrefix(key, PlanVestingDurationKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planId = sdk.BigEndianToUint64(key[1:])
+	return
+}
+
+// ParsePausedFunctionKey parses a paused function key.
+func ParsePausedFunctionKey(key []byte) (function PausableFunction) {
+	if !bytes.HasPrefix(key, PausedFunctionKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	function = PausableFunction(key[1])
+	return
+}
+
 // LengthPrefixString returns length-prefixed bytes representation
 // of a string.
 func LengthPrefixString(s string) []byte {
@@ -195,3 +634,39 @@ func LengthPrefixString(s string) []byte {
 	}
 	return append([]byte{byte(bzLen)}, bz...)
 }
+
+// ParseCappedTotalStakingsKey parses a capped total stakings key.
+func ParseCappedTotalStakingsKey(key []byte) (stakingCoinDenom string, planId uint64) {
+	if !bytes.HasPrefix(key, CappedTotalStakingsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	planId = sdk.BigEndianToUint64(key[2+denomLen:])
+	return
+}
+
+// ParseCappedStakeKey parses a capped stake key.
+func ParseCappedStakeKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress, planId uint64) {
+	if !bytes.HasPrefix(key, CappedStakeKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	addrLen := key[2+denomLen]
+	farmerAcc = key[3+denomLen : 3+denomLen+addrLen]
+	planId = sdk.BigEndianToUint64(key[3+denomLen+addrLen:])
+	return
+}
+
+// ParseStartingRewardsKey parses a starting rewards key.
+func ParseStartingRewardsKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, StartingRewardsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	addrLen := key[2+denomLen]
+	farmerAcc = key[3+denomLen : 3+denomLen+addrLen]
+	return
+}
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -25,6 +27,31 @@ func (s *keysTestSuite) TestGetPlanKey() {
 	s.Require().Equal([]byte{0x11, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPlanKey(10))
 }
 
+func (s *keysTestSuite) TestGetPlanIndexKeys() {
+	farmingPoolAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmingPool1")))
+
+	key := types.GetPlanByFarmingPoolIndexKey(farmingPoolAcc, 1)
+	s.Require().Equal([]byte{0x12, 0x14, 0x4d, 0x12, 0xbe, 0x23, 0x64, 0x3d, 0x83, 0x3b, 0x54, 0x52, 0xc8, 0xff,
+		0x18, 0xca, 0xe7, 0x13, 0xd, 0xf7, 0x5b, 0x2f, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetPlansByFarmingPoolPrefix(farmingPoolAcc)))
+	s.Require().Equal(uint64(1), types.ParsePlanIndexKey(key))
+
+	key = types.GetPlanByStakingCoinDenomIndexKey(sdk.DefaultBondDenom, 1)
+	s.Require().Equal([]byte{0x13, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetPlansByStakingCoinDenomPrefix(sdk.DefaultBondDenom)))
+	s.Require().Equal(uint64(1), types.ParsePlanIndexKey(key))
+
+	key = types.GetActivePlanIndexKey(1)
+	s.Require().Equal([]byte{0x14, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().Equal(uint64(1), types.ParsePlanIndexKey(key))
+
+	key = types.GetTerminatedPlanIndexKey(1)
+	s.Require().Equal([]byte{0x15, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().Equal(uint64(1), types.ParsePlanIndexKey(key))
+
+	s.Require().Panics(func() { types.ParsePlanIndexKey(types.GetPlanKey(1)) })
+}
+
 func (s *keysTestSuite) TestGetStakingKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -113,6 +140,14 @@ func (s *keysTestSuite) TestGetStakingsByFarmerPrefix() {
 		0x63, 0x4a, 0xfe, 0xeb, 0x8, 0xc0, 0x4a, 0x53, 0x25, 0x2c, 0x9f}, types.GetStakingsByFarmerPrefix(farmer3))
 }
 
+func (s *keysTestSuite) TestGetStakingsByStakingCoinDenomPrefix() {
+	s.Require().Equal([]byte{0x21, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65}, types.GetStakingsByStakingCoinDenomPrefix(sdk.DefaultBondDenom))
+	s.Require().Equal([]byte{0x23, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65}, types.GetQueuedStakingsByStakingCoinDenomPrefix(sdk.DefaultBondDenom))
+	s.Require().True(bytes.HasPrefix(
+		types.GetStakingKey(sdk.DefaultBondDenom, sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))),
+		types.GetStakingsByStakingCoinDenomPrefix(sdk.DefaultBondDenom)))
+}
+
 func (s *keysTestSuite) TestGetQueuedStakingKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -226,6 +261,112 @@ func (s *keysTestSuite) TestGetTotalStakingsKey() {
 	}
 }
 
+func (s *keysTestSuite) TestGetLockIndexKey() {
+	testCases := []struct {
+		farmerAcc        sdk.AccAddress
+		stakingCoinDenom string
+		lockId           uint64
+		expected         []byte
+	}{
+		{
+			sdk.AccAddress(crypto.AddressHash([]byte("farmer1"))),
+			sdk.DefaultBondDenom,
+			1,
+			[]byte{0x27, 0x14, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5, 0x36, 0xcf,
+				0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65,
+				0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+		},
+		{
+			sdk.AccAddress(crypto.AddressHash([]byte("farmer2"))),
+			"denom1",
+			10,
+			[]byte{0x27, 0x14, 0x15, 0x1, 0x20, 0x25, 0x5a, 0x5d, 0xe8, 0x6b, 0xa1, 0xed, 0xfb,
+				0x6f, 0x45, 0x48, 0xcb, 0xfb, 0x6f, 0x28, 0x66, 0xf3, 0x6, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31,
+				0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa},
+		},
+	}
+
+	for _, tc := range testCases {
+		key := types.GetLockIndexKey(tc.farmerAcc, tc.stakingCoinDenom, tc.lockId)
+		s.Require().Equal(tc.expected, key)
+
+		farmerAcc, stakingCoinDenom, lockId := types.ParseLockIndexKey(key)
+		s.Require().Equal(tc.farmerAcc, farmerAcc)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.lockId, lockId)
+	}
+}
+
+func (s *keysTestSuite) TestGetLockByEndTimeKey() {
+	for _, tc := range []struct {
+		endTime time.Time
+		lockId  uint64
+	}{
+		{types.ParseTime("2022-01-01T00:00:00Z"), 1},
+		{types.ParseTime("2022-01-01T12:34:56.789Z"), 100},
+	} {
+		key := types.GetLockByEndTimeKey(tc.endTime, tc.lockId)
+		s.Require().True(bytes.HasPrefix(key, types.GetLocksByEndTimePrefix(tc.endTime)))
+
+		endTime, lockId := types.ParseLockByEndTimeKey(key)
+		s.Require().True(tc.endTime.Equal(endTime))
+		s.Require().Equal(tc.lockId, lockId)
+	}
+}
+
+func (s *keysTestSuite) TestGetUnbondingIndexKey() {
+	for _, tc := range []struct {
+		farmerAcc        sdk.AccAddress
+		stakingCoinDenom string
+		unbondingId      uint64
+	}{
+		{sdk.AccAddress(crypto.AddressHash([]byte("farmer1"))), sdk.DefaultBondDenom, 1},
+		{sdk.AccAddress(crypto.AddressHash([]byte("farmer2"))), "denom1", 10},
+	} {
+		key := types.GetUnbondingIndexKey(tc.farmerAcc, tc.stakingCoinDenom, tc.unbondingId)
+		s.Require().True(bytes.HasPrefix(key, types.GetUnbondingsByFarmerAndDenomPrefix(tc.farmerAcc, tc.stakingCoinDenom)))
+
+		farmerAcc, stakingCoinDenom, unbondingId := types.ParseUnbondingIndexKey(key)
+		s.Require().Equal(tc.farmerAcc, farmerAcc)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.unbondingId, unbondingId)
+	}
+}
+
+func (s *keysTestSuite) TestGetUnbondingByTimeKey() {
+	for _, tc := range []struct {
+		completionTime time.Time
+		unbondingId    uint64
+	}{
+		{types.ParseTime("2022-01-01T00:00:00Z"), 1},
+		{types.ParseTime("2022-01-01T12:34:56.789Z"), 100},
+	} {
+		key := types.GetUnbondingByTimeKey(tc.completionTime, tc.unbondingId)
+		s.Require().True(bytes.HasPrefix(key, types.GetUnbondingsByTimePrefix(tc.completionTime)))
+
+		completionTime, unbondingId := types.ParseUnbondingByTimeKey(key)
+		s.Require().True(tc.completionTime.Equal(completionTime))
+		s.Require().Equal(tc.unbondingId, unbondingId)
+	}
+}
+
+func (s *keysTestSuite) TestGetRewardVestingIndexKey() {
+	for _, tc := range []struct {
+		farmerAcc sdk.AccAddress
+		vestingId uint64
+	}{
+		{sdk.AccAddress(crypto.AddressHash([]byte("farmer1"))), 1},
+		{sdk.AccAddress(crypto.AddressHash([]byte("farmer2"))), 10},
+	} {
+		key := types.GetRewardVestingIndexKey(tc.farmerAcc, tc.vestingId)
+		s.Require().True(bytes.HasPrefix(key, types.GetRewardVestingsByFarmerPrefix(tc.farmerAcc)))
+
+		farmerAcc, vestingId := types.ParseRewardVestingIndexKey(key)
+		s.Require().Equal(tc.farmerAcc, farmerAcc)
+		s.Require().Equal(tc.vestingId, vestingId)
+	}
+}
+
 func (s *keysTestSuite) TestGetHistoricalRewardsKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -254,6 +395,133 @@ func (s *keysTestSuite) TestGetHistoricalRewardsKey() {
 	}
 }
 
+func (s *keysTestSuite) TestGetPlanHistoricalRewardsKey() {
+	testCases := []struct {
+		stakingCoinDenom string
+		planId           uint64
+		epoch            uint64
+		expected         []byte
+	}{
+		{
+			sdk.DefaultBondDenom,
+			1,
+			1,
+			[]byte{0x34, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+				0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+		},
+		{
+			sdk.DefaultBondDenom,
+			10,
+			2,
+			[]byte{0x34, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa,
+				0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2},
+		},
+	}
+
+	for _, tc := range testCases {
+		key := types.GetPlanHistoricalRewardsKey(tc.stakingCoinDenom, tc.planId, tc.epoch)
+		s.Require().Equal(tc.expected, key)
+
+		stakingCoinDenom, planId, epoch := types.ParsePlanHistoricalRewardsKey(key)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.planId, planId)
+		s.Require().Equal(tc.epoch, epoch)
+	}
+}
+
+func (s *keysTestSuite) TestGetPlanOutstandingRewardsKey() {
+	testCases := []struct {
+		stakingCoinDenom string
+		planId           uint64
+		expected         []byte
+	}{
+		{
+			sdk.DefaultBondDenom,
+			1,
+			[]byte{0x35, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+		},
+		{
+			"denom1",
+			10,
+			[]byte{0x35, 0x6, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa},
+		},
+	}
+
+	for _, tc := range testCases {
+		key := types.GetPlanOutstandingRewardsKey(tc.stakingCoinDenom, tc.planId)
+		s.Require().Equal(tc.expected, key)
+
+		stakingCoinDenom, planId := types.ParsePlanOutstandingRewardsKey(key)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.planId, planId)
+	}
+}
+
+func (s *keysTestSuite) TestGetCappedTotalStakingsKey() {
+	testCases := []struct {
+		stakingCoinDenom string
+		planId           uint64
+		expected         []byte
+	}{
+		{
+			sdk.DefaultBondDenom,
+			1,
+			[]byte{0x38, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1},
+		},
+		{
+			"denom1",
+			10,
+			[]byte{0x38, 0x6, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa},
+		},
+	}
+
+	for _, tc := range testCases {
+		key := types.GetCappedTotalStakingsKey(tc.stakingCoinDenom, tc.planId)
+		s.Require().Equal(tc.expected, key)
+		s.Require().True(bytes.HasPrefix(key, types.GetCappedTotalStakingsByDenomPrefix(tc.stakingCoinDenom)))
+
+		stakingCoinDenom, planId := types.ParseCappedTotalStakingsKey(key)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.planId, planId)
+	}
+}
+
+func (s *keysTestSuite) TestGetCappedStakeKey() {
+	for _, tc := range []struct {
+		stakingCoinDenom string
+		farmerAcc        sdk.AccAddress
+		planId           uint64
+	}{
+		{sdk.DefaultBondDenom, sdk.AccAddress(crypto.AddressHash([]byte("farmer1"))), 1},
+		{"denom1", sdk.AccAddress(crypto.AddressHash([]byte("farmer2"))), 10},
+	} {
+		key := types.GetCappedStakeKey(tc.stakingCoinDenom, tc.farmerAcc, tc.planId)
+		s.Require().True(bytes.HasPrefix(key, types.GetCappedStakesByFarmerPrefix(tc.stakingCoinDenom, tc.farmerAcc)))
+
+		stakingCoinDenom, farmerAcc, planId := types.ParseCappedStakeKey(key)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.farmerAcc, farmerAcc)
+		s.Require().Equal(tc.planId, planId)
+	}
+}
+
+func (s *keysTestSuite) TestGetStartingRewardsKey() {
+	for _, tc := range []struct {
+		stakingCoinDenom string
+		farmerAcc        sdk.AccAddress
+	}{
+		{sdk.DefaultBondDenom, sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))},
+		{"denom1", sdk.AccAddress(crypto.AddressHash([]byte("farmer2")))},
+	} {
+		key := types.GetStartingRewardsKey(tc.stakingCoinDenom, tc.farmerAcc)
+		s.Require().True(bytes.HasPrefix(key, types.GetStartingRewardsByDenomPrefix(tc.stakingCoinDenom)))
+
+		stakingCoinDenom, farmerAcc := types.ParseStartingRewardsKey(key)
+		s.Require().Equal(tc.stakingCoinDenom, stakingCoinDenom)
+		s.Require().Equal(tc.farmerAcc, farmerAcc)
+	}
+}
+
 func (s *keysTestSuite) TestGetCurrentEpochKey() {
 	// key0
 	stakingCoinDenom0 := ""
@@ -272,6 +540,44 @@ func (s *keysTestSuite) TestGetCurrentEpochKey() {
 	s.Require().Equal(stakingCoinDenom, stakingCoinDenom1)
 }
 
+func (s *keysTestSuite) TestGetAutoCompoundKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	key := types.GetAutoCompoundKey(farmerAcc)
+	s.Require().Equal([]byte{0x41, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5, 0x36, 0xcf,
+		0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d}, key)
+	s.Require().Equal(farmerAcc, types.ParseAutoCompoundKey(key))
+}
+
+func (s *keysTestSuite) TestGetLockByDenomIndexKey() {
+	key := types.GetLockByDenomIndexKey(sdk.DefaultBondDenom, 1)
+	s.Require().Equal([]byte{0x2d, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	stakingCoinDenom, lockId := types.ParseLockByDenomIndexKey(key)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(1), lockId)
+}
+
+func (s *keysTestSuite) TestGetPendingRewardsKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	key := types.GetPendingRewardsKey(farmerAcc)
+	s.Require().Equal([]byte{0x3b, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5, 0x36, 0xcf,
+		0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d}, key)
+	s.Require().Equal(farmerAcc, types.ParsePendingRewardsKey(key))
+}
+
+func (s *keysTestSuite) TestGetPlanVestingDurationKey() {
+	key := types.GetPlanVestingDurationKey(1)
+	s.Require().Equal([]byte{0x3c, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, key)
+	s.Require().Equal(uint64(1), types.ParsePlanVestingDurationKey(key))
+}
+
+func (s *keysTestSuite) TestGetRewardsWithdrawAddressKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	key := types.GetRewardsWithdrawAddressKey(farmerAcc)
+	s.Require().Equal([]byte{0x42, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5, 0x36, 0xcf,
+		0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d}, key)
+	s.Require().Equal(farmerAcc, types.ParseRewardsWithdrawAddressKey(key))
+}
+
 func (s *keysTestSuite) TestLengthPrefix() {
 	denom0 := sdk.DefaultBondDenom
 	denom1 := "uatom"
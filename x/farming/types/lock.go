@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLock returns a new queued Lock.
+// The weight of the lock is the amount multiplied by the multiplier, truncated.
+func NewLock(id uint64, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int, multiplier sdk.Dec, endTime time.Time) Lock {
+	return Lock{
+		Id:               id,
+		Farmer:           farmerAcc.String(),
+		StakingCoinDenom: stakingCoinDenom,
+		Amount:           amount,
+		Multiplier:       multiplier,
+		Weight:           amount.ToDec().MulTruncate(multiplier).TruncateInt(),
+		EndTime:          endTime,
+	}
+}
+
+// GetFarmer returns the farmer address of the lock.
+func (lock Lock) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(lock.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IsQueued returns true if the lock is waiting in a queue, which means
+// the lock's weight is not yet counted in total stakings.
+func (lock Lock) IsQueued() bool {
+	return lock.StartingEpoch == 0
+}
+
+// Validate validates Lock.
+func (lock Lock) Validate() error {
+	if lock.Id == 0 {
+		return fmt.Errorf("lock id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(lock.Farmer); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(lock.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !lock.Amount.IsPositive() {
+		return fmt.Errorf("lock amount must be positive: %s", lock.Amount)
+	}
+	if lock.Multiplier.IsNil() || lock.Multiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("lock multiplier must not be less than 1: %s", lock.Multiplier)
+	}
+	if !lock.Weight.Equal(lock.Amount.ToDec().MulTruncate(lock.Multiplier).TruncateInt()) {
+		return fmt.Errorf("lock weight must be the amount multiplied by the multiplier: %s", lock.Weight)
+	}
+	return nil
+}
@@ -10,22 +10,36 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateFixedAmountPlan)(nil)
 	_ sdk.Msg = (*MsgCreateRatioPlan)(nil)
+	_ sdk.Msg = (*MsgCreateDecayingAmountPlan)(nil)
 	_ sdk.Msg = (*MsgStake)(nil)
+	_ sdk.Msg = (*MsgStakeFor)(nil)
 	_ sdk.Msg = (*MsgUnstake)(nil)
+	_ sdk.Msg = (*MsgTransferStaking)(nil)
 	_ sdk.Msg = (*MsgHarvest)(nil)
 	_ sdk.Msg = (*MsgRemovePlan)(nil)
+	_ sdk.Msg = (*MsgModifyPrivatePlan)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgSetRewardsWithdrawAddress)(nil)
+	_ sdk.Msg = (*MsgClaimVested)(nil)
 	_ sdk.Msg = (*MsgAdvanceEpoch)(nil)
 )
 
 // Message types for the farming module
 const (
-	TypeMsgCreateFixedAmountPlan = "create_fixed_amount_plan"
-	TypeMsgCreateRatioPlan       = "create_ratio_plan"
-	TypeMsgStake                 = "stake"
-	TypeMsgUnstake               = "unstake"
-	TypeMsgHarvest               = "harvest"
-	TypeMsgRemovePlan            = "remove_plan"
-	TypeMsgAdvanceEpoch          = "advance_epoch"
+	TypeMsgCreateFixedAmountPlan     = "create_fixed_amount_plan"
+	TypeMsgCreateRatioPlan           = "create_ratio_plan"
+	TypeMsgCreateDecayingAmountPlan  = "create_decaying_amount_plan"
+	TypeMsgStake                     = "stake"
+	TypeMsgStakeFor                  = "stake_for"
+	TypeMsgUnstake                   = "unstake"
+	TypeMsgTransferStaking           = "transfer_staking"
+	TypeMsgHarvest                   = "harvest"
+	TypeMsgRemovePlan                = "remove_plan"
+	TypeMsgModifyPrivatePlan         = "modify_private_plan"
+	TypeMsgSetAutoCompound           = "set_auto_compound"
+	TypeMsgSetRewardsWithdrawAddress = "set_rewards_withdraw_address"
+	TypeMsgClaimVested               = "claim_vested"
+	TypeMsgAdvanceEpoch              = "advance_epoch"
 )
 
 // NewMsgCreateFixedAmountPlan creates a new MsgCreateFixedAmountPlan.
@@ -70,6 +84,12 @@ func (msg MsgCreateFixedAmountPlan) ValidateBasic() error {
 	if err := ValidateEpochAmount(msg.EpochAmount); err != nil {
 		return err
 	}
+	if err := ValidateVestingDuration(msg.VestingDuration); err != nil {
+		return err
+	}
+	if err := ValidateStakeCaps(msg.MaxStakePerFarmer, msg.MaxTotalStake); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -132,6 +152,12 @@ func (msg MsgCreateRatioPlan) ValidateBasic() error {
 	if err := ValidateEpochRatio(msg.EpochRatio); err != nil {
 		return err
 	}
+	if err := ValidateVestingDuration(msg.VestingDuration); err != nil {
+		return err
+	}
+	if err := ValidateStakeCaps(msg.MaxStakePerFarmer, msg.MaxTotalStake); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -155,6 +181,87 @@ func (msg MsgCreateRatioPlan) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateDecayingAmountPlan creates a new MsgCreateDecayingAmountPlan.
+func NewMsgCreateDecayingAmountPlan(
+	name string,
+	creatorAcc sdk.AccAddress,
+	stakingCoinWeights sdk.DecCoins,
+	startTime time.Time,
+	endTime time.Time,
+	epochAmount sdk.Coins,
+	decayFactor sdk.Dec,
+	decayPeriod uint32,
+) *MsgCreateDecayingAmountPlan {
+	return &MsgCreateDecayingAmountPlan{
+		Name:               name,
+		Creator:            creatorAcc.String(),
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		DecayFactor:        decayFactor,
+		DecayPeriod:        decayPeriod,
+	}
+}
+
+func (msg MsgCreateDecayingAmountPlan) Route() string { return RouterKey }
+
+func (msg MsgCreateDecayingAmountPlan) Type() string { return TypeMsgCreateDecayingAmountPlan }
+
+func (msg MsgCreateDecayingAmountPlan) ValidateBasic() error {
+	if err := ValidatePlanName(msg.Name); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPlanName, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", msg.EndTime.Format(time.RFC3339), msg.StartTime.Format(time.RFC3339))
+	}
+	if err := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); err != nil {
+		return err
+	}
+	if msg.EpochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must not be empty")
+	}
+	if err := ValidateEpochAmount(msg.EpochAmount); err != nil {
+		return err
+	}
+	if err := ValidateDecayFactor(msg.DecayFactor); err != nil {
+		return err
+	}
+	if err := ValidateDecayPeriod(msg.DecayPeriod); err != nil {
+		return err
+	}
+	if err := ValidateVestingDuration(msg.VestingDuration); err != nil {
+		return err
+	}
+	if err := ValidateStakeCaps(msg.MaxStakePerFarmer, msg.MaxTotalStake); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCreateDecayingAmountPlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateDecayingAmountPlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateDecayingAmountPlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgStake creates a new MsgStake.
 func NewMsgStake(
 	farmer sdk.AccAddress,
@@ -166,6 +273,20 @@ func NewMsgStake(
 	}
 }
 
+// NewMsgStakeWithLock creates a new MsgStake which locks the staking coins
+// for the lock duration.
+func NewMsgStakeWithLock(
+	farmer sdk.AccAddress,
+	stakingCoins sdk.Coins,
+	lockDuration time.Duration,
+) *MsgStake {
+	return &MsgStake{
+		Farmer:       farmer.String(),
+		StakingCoins: stakingCoins,
+		LockDuration: lockDuration,
+	}
+}
+
 func (msg MsgStake) Route() string { return RouterKey }
 
 func (msg MsgStake) Type() string { return TypeMsgStake }
@@ -180,6 +301,9 @@ func (msg MsgStake) ValidateBasic() error {
 	if err := msg.StakingCoins.Validate(); err != nil {
 		return err
 	}
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration must not be negative: %s", msg.LockDuration)
+	}
 	return nil
 }
 
@@ -203,6 +327,67 @@ func (msg MsgStake) GetFarmer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgStakeFor creates a new MsgStakeFor.
+func NewMsgStakeFor(
+	payer sdk.AccAddress,
+	farmer sdk.AccAddress,
+	stakingCoins sdk.Coins,
+) *MsgStakeFor {
+	return &MsgStakeFor{
+		Payer:        payer.String(),
+		Farmer:       farmer.String(),
+		StakingCoins: stakingCoins,
+	}
+}
+
+func (msg MsgStakeFor) Route() string { return RouterKey }
+
+func (msg MsgStakeFor) Type() string { return TypeMsgStakeFor }
+
+func (msg MsgStakeFor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Payer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address %q: %v", msg.Payer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if ok := msg.StakingCoins.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coins must not be zero")
+	}
+	if err := msg.StakingCoins.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgStakeFor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStakeFor) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgStakeFor) GetPayer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgStakeFor) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgUnstake creates a new MsgUnstake.
 func NewMsgUnstake(
 	farmer sdk.AccAddress,
@@ -251,6 +436,70 @@ func (msg MsgUnstake) GetFarmer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgTransferStaking creates a new MsgTransferStaking.
+func NewMsgTransferStaking(
+	farmer sdk.AccAddress,
+	recipient sdk.AccAddress,
+	stakingCoins sdk.Coins,
+) *MsgTransferStaking {
+	return &MsgTransferStaking{
+		Farmer:       farmer.String(),
+		Recipient:    recipient.String(),
+		StakingCoins: stakingCoins,
+	}
+}
+
+func (msg MsgTransferStaking) Route() string { return RouterKey }
+
+func (msg MsgTransferStaking) Type() string { return TypeMsgTransferStaking }
+
+func (msg MsgTransferStaking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %q: %v", msg.Recipient, err)
+	}
+	if msg.Farmer == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient must be different from the farmer")
+	}
+	if ok := msg.StakingCoins.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coins must not be zero")
+	}
+	if err := msg.StakingCoins.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgTransferStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferStaking) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTransferStaking) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgTransferStaking) GetRecipient() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgHarvest creates a new MsgHarvest.
 func NewMsgHarvest(
 	farmer sdk.AccAddress,
@@ -346,6 +595,207 @@ func (msg MsgRemovePlan) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgModifyPrivatePlan creates a new MsgModifyPrivatePlan.
+func NewMsgModifyPrivatePlan(
+	creator sdk.AccAddress,
+	planId uint64,
+	stakingCoinWeights sdk.DecCoins,
+	endTime *time.Time,
+	epochAmount sdk.Coins,
+	epochRatio sdk.Dec,
+	decayFactor sdk.Dec,
+	decayPeriod uint32,
+) *MsgModifyPrivatePlan {
+	return &MsgModifyPrivatePlan{
+		Creator:            creator.String(),
+		PlanId:             planId,
+		StakingCoinWeights: stakingCoinWeights,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		EpochRatio:         epochRatio,
+		DecayFactor:        decayFactor,
+		DecayPeriod:        decayPeriod,
+	}
+}
+
+func (msg MsgModifyPrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgModifyPrivatePlan) Type() string { return TypeMsgModifyPrivatePlan }
+
+func (msg MsgModifyPrivatePlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	req := msg.ModifyPlanRequest()
+	if req.StakingCoinWeights == nil && req.EndTime == nil && req.EpochAmount.Empty() &&
+		!req.IsForRatioPlan() && !req.hasDecayFactor() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to modify")
+	}
+	return req.Validate()
+}
+
+func (msg MsgModifyPrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgModifyPrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgModifyPrivatePlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ModifyPlanRequest returns the ModifyPlanRequest that has the same fields
+// with the message, so that they can share the same validation and
+// modification logic.
+func (msg MsgModifyPrivatePlan) ModifyPlanRequest() ModifyPlanRequest {
+	return ModifyPlanRequest{
+		PlanId:             msg.PlanId,
+		StakingCoinWeights: msg.StakingCoinWeights,
+		EndTime:            msg.EndTime,
+		EpochAmount:        msg.EpochAmount,
+		EpochRatio:         msg.EpochRatio,
+		DecayFactor:        msg.DecayFactor,
+		DecayPeriod:        msg.DecayPeriod,
+	}
+}
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(farmer sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Farmer:  farmer.String(),
+		Enabled: enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	return nil
+}
+
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetAutoCompound) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetRewardsWithdrawAddress creates a new MsgSetRewardsWithdrawAddress.
+func NewMsgSetRewardsWithdrawAddress(farmer, withdrawAddr sdk.AccAddress) *MsgSetRewardsWithdrawAddress {
+	return &MsgSetRewardsWithdrawAddress{
+		Farmer:          farmer.String(),
+		WithdrawAddress: withdrawAddr.String(),
+	}
+}
+
+func (msg MsgSetRewardsWithdrawAddress) Route() string { return RouterKey }
+
+func (msg MsgSetRewardsWithdrawAddress) Type() string { return TypeMsgSetRewardsWithdrawAddress }
+
+func (msg MsgSetRewardsWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address %q: %v", msg.WithdrawAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetWithdrawAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaimVested creates a new MsgClaimVested.
+func NewMsgClaimVested(farmer sdk.AccAddress) *MsgClaimVested {
+	return &MsgClaimVested{
+		Farmer: farmer.String(),
+	}
+}
+
+func (msg MsgClaimVested) Route() string { return RouterKey }
+
+func (msg MsgClaimVested) Type() string { return TypeMsgClaimVested }
+
+func (msg MsgClaimVested) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	return nil
+}
+
+func (msg MsgClaimVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimVested) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimVested) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgAdvanceEpoch creates a new MsgAdvanceEpoch.
 func NewMsgAdvanceEpoch(requesterAcc sdk.AccAddress) *MsgAdvanceEpoch {
 	return &MsgAdvanceEpoch{
@@ -58,6 +58,39 @@ func TestMsgCreateFixedAmountPlan(t *testing.T) {
 				startTime, endTime, sdk.Coins{},
 			),
 		},
+		{
+			"vesting duration must not be negative: -1h0m0s: invalid request",
+			func() *types.MsgCreateFixedAmountPlan {
+				msg := types.NewMsgCreateFixedAmountPlan(
+					name, creatorAddr, stakingCoinWeights,
+					startTime, endTime, sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1))},
+				)
+				msg.VestingDuration = -time.Hour
+				return msg
+			}(),
+		},
+		{
+			"max stake per farmer must not be negative: -1: invalid request",
+			func() *types.MsgCreateFixedAmountPlan {
+				msg := types.NewMsgCreateFixedAmountPlan(
+					name, creatorAddr, stakingCoinWeights,
+					startTime, endTime, sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1))},
+				)
+				msg.MaxStakePerFarmer = sdk.NewInt(-1)
+				return msg
+			}(),
+		},
+		{
+			"max total stake must not be negative: -1: invalid request",
+			func() *types.MsgCreateFixedAmountPlan {
+				msg := types.NewMsgCreateFixedAmountPlan(
+					name, creatorAddr, stakingCoinWeights,
+					startTime, endTime, sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1))},
+				)
+				msg.MaxTotalStake = sdk.NewInt(-1)
+				return msg
+			}(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,6 +177,87 @@ func TestMsgCreateRatioPlan(t *testing.T) {
 	}
 }
 
+func TestMsgCreateDecayingAmountPlan(t *testing.T) {
+	name := "test"
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorPoolAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	startTime, _ := time.Parse(time.RFC3339, "2021-11-01T22:08:41+00:00") // needs to be deterministic for test
+	endTime := startTime.AddDate(1, 0, 0)
+	epochAmount := sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1000000))}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateDecayingAmountPlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, sdk.AccAddress{}, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"end time 2020-11-01T22:08:41Z must be greater than start time 2021-11-01T22:08:41Z: invalid plan end time",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, startTime.AddDate(-1, 0, 0), epochAmount, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"epoch amount must not be empty: invalid request",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, sdk.Coins{}, sdk.NewDecWithPrec(5, 1), 10,
+			),
+		},
+		{
+			"decay factor must be positive: 0.000000000000000000: invalid request",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.ZeroDec(), 10,
+			),
+		},
+		{
+			"decay factor must be less than 1: 1.000000000000000000: invalid request",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.OneDec(), 10,
+			),
+		},
+		{
+			"decay period must be positive: invalid request",
+			types.NewMsgCreateDecayingAmountPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(5, 1), 0,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateDecayingAmountPlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateDecayingAmountPlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgStake(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
 	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
@@ -164,6 +278,14 @@ func TestMsgStake(t *testing.T) {
 			"staking coins must not be zero: invalid request",
 			types.NewMsgStake(farmingPoolAddr, sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(0)))),
 		},
+		{
+			"",
+			types.NewMsgStakeWithLock(farmingPoolAddr, stakingCoins, 7*24*time.Hour),
+		},
+		{
+			"lock duration must not be negative: -1h0m0s: invalid request",
+			types.NewMsgStakeWithLock(farmingPoolAddr, stakingCoins, -time.Hour),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -184,6 +306,51 @@ func TestMsgStake(t *testing.T) {
 	}
 }
 
+func TestMsgStakeFor(t *testing.T) {
+	payerAddr := sdk.AccAddress(crypto.AddressHash([]byte("payerAddr")))
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmerAddr")))
+	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgStakeFor
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgStakeFor(payerAddr, farmerAddr, stakingCoins),
+		},
+		{
+			"invalid payer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgStakeFor(sdk.AccAddress{}, farmerAddr, stakingCoins),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgStakeFor(payerAddr, sdk.AccAddress{}, stakingCoins),
+		},
+		{
+			"staking coins must not be zero: invalid request",
+			types.NewMsgStakeFor(payerAddr, farmerAddr, sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(0)))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgStakeFor{}, tc.msg)
+		require.Equal(t, types.TypeMsgStakeFor, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetPayer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgUnstake(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
 	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
@@ -224,6 +391,55 @@ func TestMsgUnstake(t *testing.T) {
 	}
 }
 
+func TestMsgTransferStaking(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmerAddr")))
+	recipientAddr := sdk.AccAddress(crypto.AddressHash([]byte("recipientAddr")))
+	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgTransferStaking
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, stakingCoins),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTransferStaking(sdk.AccAddress{}, recipientAddr, stakingCoins),
+		},
+		{
+			"invalid recipient address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTransferStaking(farmerAddr, sdk.AccAddress{}, stakingCoins),
+		},
+		{
+			"recipient must be different from the farmer: invalid request",
+			types.NewMsgTransferStaking(farmerAddr, farmerAddr, stakingCoins),
+		},
+		{
+			"staking coins must not be zero: invalid request",
+			types.NewMsgTransferStaking(farmerAddr, recipientAddr, sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(0)))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgTransferStaking{}, tc.msg)
+		require.Equal(t, types.TypeMsgTransferStaking, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgHarvest(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
 	stakingCoinDenoms := []string{"uatom", "uiris", "ukava"}
@@ -263,3 +479,186 @@ func TestMsgHarvest(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgModifyPrivatePlan(t *testing.T) {
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorPoolAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	endTime, _ := time.Parse(time.RFC3339, "2022-11-01T22:08:41+00:00") // needs to be deterministic for test
+	epochAmount := sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1000000))}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgModifyPrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, nil, &endTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, stakingCoinWeights, nil, epochAmount, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, nil, nil, epochAmount, sdk.Dec{}, sdk.NewDecWithPrec(5, 1), 10),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgModifyPrivatePlan(sdk.AccAddress{}, 1, nil, &endTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 0, nil, &endTime, nil, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"nothing to modify: invalid request",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, nil, nil, nil, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"total weight must be 1: invalid staking coin weights",
+			types.NewMsgModifyPrivatePlan(
+				creatorAddr, 1, sdk.NewDecCoins(sdk.NewDecCoinFromDec("farmingCoinDenom", sdk.NewDecWithPrec(5, 1))),
+				nil, nil, sdk.Dec{}, sdk.Dec{}, 0),
+		},
+		{
+			"at most one of epoch amount or epoch ratio must be provided: invalid request",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, nil, nil, epochAmount, sdk.NewDecWithPrec(1, 1), sdk.Dec{}, 0),
+		},
+		{
+			"decay factor must be provided with epoch amount: invalid request",
+			types.NewMsgModifyPrivatePlan(creatorAddr, 1, nil, nil, nil, sdk.Dec{}, sdk.NewDecWithPrec(5, 1), 10),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgModifyPrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgModifyPrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetAutoCompound
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetAutoCompound(farmerAddr, true),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgSetAutoCompound(farmerAddr, false),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetAutoCompound(sdk.AccAddress{}, true),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetAutoCompound{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetAutoCompound, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetRewardsWithdrawAddress(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdraw")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetRewardsWithdrawAddress
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetRewardsWithdrawAddress(farmerAddr, withdrawAddr),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardsWithdrawAddress(sdk.AccAddress{}, withdrawAddr),
+		},
+		{
+			"invalid withdraw address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardsWithdrawAddress(farmerAddr, sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetRewardsWithdrawAddress{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetRewardsWithdrawAddress, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+			require.Equal(t, withdrawAddr, tc.msg.GetWithdrawAddress())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimVested(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimVested
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimVested(farmerAddr),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimVested(sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimVested{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimVested, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
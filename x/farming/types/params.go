@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -17,22 +18,41 @@ const (
 	// PublicPlanMaxNumDenoms is the maximum number of denoms in a public plan's
 	// staking coin weights and epoch amount.
 	PublicPlanMaxNumDenoms = 500
+	// AutoCompoundGasLimit is the maximum amount of gas that can be consumed
+	// while auto-compounding rewards of a farmer at the end of an epoch.
+	AutoCompoundGasLimit = 1_000_000
 )
 
 // Parameter store keys
 var (
 	KeyPrivatePlanCreationFee = []byte("PrivatePlanCreationFee")
-	KeyNextEpochDays          = []byte("NextEpochDays")
+	KeyNextEpochDuration      = []byte("NextEpochDuration")
 	KeyFarmingFeeCollector    = []byte("FarmingFeeCollector")
 	KeyDelayedStakingGasFee   = []byte("DelayedStakingGasFee")
 	KeyMaxNumPrivatePlans     = []byte("MaxNumPrivatePlans")
+	KeyLockMultipliers        = []byte("LockMultipliers")
+	KeyAllocationPolicy       = []byte("AllocationPolicy")
+	KeyRewardsStreaming       = []byte("RewardsStreaming")
+	KeyMaxCatchUpEpochs       = []byte("MaxCatchUpEpochs")
+	KeyUnstakingPeriod        = []byte("UnstakingPeriod")
+	KeyAutoCompoundGasPrice   = []byte("AutoCompoundGasPrice")
 
 	DefaultPrivatePlanCreationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000_000)))
-	DefaultCurrentEpochDays       = uint32(1)
-	DefaultNextEpochDays          = uint32(1)
+	DefaultCurrentEpochDuration   = 24 * time.Hour
+	DefaultNextEpochDuration      = 24 * time.Hour
 	DefaultFarmingFeeCollector    = sdk.AccAddress(address.Module(ModuleName, []byte("FarmingFeeCollectorAcc")))
 	DefaultDelayedStakingGasFee   = sdk.Gas(60000) // See https://github.com/tendermint/farming/issues/102 for details.
 	DefaultMaxNumPrivatePlans     = uint32(10000)
+	DefaultAllocationPolicy       = AllocationPolicySkipAll
+	DefaultRewardsStreaming       = false
+	DefaultMaxCatchUpEpochs       = uint32(10)
+	DefaultUnstakingPeriod        = time.Duration(0)
+	DefaultAutoCompoundGasPrice   = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 2)))
+	DefaultLockMultipliers        = []LockMultiplier{
+		{Duration: 7 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.1")},
+		{Duration: 30 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.25")},
+		{Duration: 90 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.5")},
+	}
 
 	// ReserveAddressType is an address type of reserve accounts for staking or rewards.
 	// The module uses the address type of 32 bytes length, but it can be changed depending on Cosmos SDK's direction.
@@ -40,6 +60,7 @@ var (
 	// https://github.com/tendermint/farming/issues/200
 	ReserveAddressType = AddressType32Bytes
 	RewardsReserveAcc  = DeriveAddress(ReserveAddressType, ModuleName, RewardReserveAccPrefix)
+	VestingReserveAcc  = DeriveAddress(ReserveAddressType, ModuleName, VestingReserveAccPrefix)
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -53,10 +74,16 @@ func ParamKeyTable() paramstypes.KeyTable {
 func DefaultParams() Params {
 	return Params{
 		PrivatePlanCreationFee: DefaultPrivatePlanCreationFee,
-		NextEpochDays:          DefaultNextEpochDays,
+		NextEpochDuration:      DefaultNextEpochDuration,
 		FarmingFeeCollector:    DefaultFarmingFeeCollector.String(),
 		DelayedStakingGasFee:   DefaultDelayedStakingGasFee,
 		MaxNumPrivatePlans:     DefaultMaxNumPrivatePlans,
+		LockMultipliers:        DefaultLockMultipliers,
+		AllocationPolicy:       DefaultAllocationPolicy,
+		RewardsStreaming:       DefaultRewardsStreaming,
+		MaxCatchUpEpochs:       DefaultMaxCatchUpEpochs,
+		UnstakingPeriod:        DefaultUnstakingPeriod,
+		AutoCompoundGasPrice:   DefaultAutoCompoundGasPrice,
 	}
 }
 
@@ -64,10 +91,16 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
 		paramstypes.NewParamSetPair(KeyPrivatePlanCreationFee, &p.PrivatePlanCreationFee, validatePrivatePlanCreationFee),
-		paramstypes.NewParamSetPair(KeyNextEpochDays, &p.NextEpochDays, validateNextEpochDays),
+		paramstypes.NewParamSetPair(KeyNextEpochDuration, &p.NextEpochDuration, validateNextEpochDuration),
 		paramstypes.NewParamSetPair(KeyFarmingFeeCollector, &p.FarmingFeeCollector, validateFarmingFeeCollector),
 		paramstypes.NewParamSetPair(KeyDelayedStakingGasFee, &p.DelayedStakingGasFee, validateDelayedStakingGas),
 		paramstypes.NewParamSetPair(KeyMaxNumPrivatePlans, &p.MaxNumPrivatePlans, validateMaxNumPrivatePlans),
+		paramstypes.NewParamSetPair(KeyLockMultipliers, &p.LockMultipliers, validateLockMultipliers),
+		paramstypes.NewParamSetPair(KeyAllocationPolicy, &p.AllocationPolicy, validateAllocationPolicy),
+		paramstypes.NewParamSetPair(KeyRewardsStreaming, &p.RewardsStreaming, validateRewardsStreaming),
+		paramstypes.NewParamSetPair(KeyMaxCatchUpEpochs, &p.MaxCatchUpEpochs, validateMaxCatchUpEpochs),
+		paramstypes.NewParamSetPair(KeyUnstakingPeriod, &p.UnstakingPeriod, validateUnstakingPeriod),
+		paramstypes.NewParamSetPair(KeyAutoCompoundGasPrice, &p.AutoCompoundGasPrice, validateAutoCompoundGasPrice),
 	}
 }
 
@@ -77,6 +110,30 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// GetLockMultiplier returns the multiplier for the lock duration, which is
+// the multiplier of the entry with the greatest duration not exceeding it.
+// It returns false if there is no such entry.
+func (p Params) GetLockMultiplier(duration time.Duration) (multiplier sdk.Dec, found bool) {
+	for _, lm := range p.LockMultipliers {
+		if lm.Duration > duration {
+			break
+		}
+		multiplier, found = lm.Multiplier, true
+	}
+	return
+}
+
+// GetAutoCompoundFee returns the auto-compounding fee for the amount of gas
+// consumed, which is rounded up.
+func (p Params) GetAutoCompoundFee(gasConsumed sdk.Gas) sdk.Coins {
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasConsumed))
+	fee := sdk.NewCoins()
+	for _, gasPrice := range p.AutoCompoundGasPrice {
+		fee = fee.Add(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(gas).Ceil().RoundInt()))
+	}
+	return fee
+}
+
 // Validate validates parameters.
 func (p Params) Validate() error {
 	for _, v := range []struct {
@@ -84,10 +141,16 @@ func (p Params) Validate() error {
 		validator func(interface{}) error
 	}{
 		{p.PrivatePlanCreationFee, validatePrivatePlanCreationFee},
-		{p.NextEpochDays, validateNextEpochDays},
+		{p.NextEpochDuration, validateNextEpochDuration},
 		{p.FarmingFeeCollector, validateFarmingFeeCollector},
 		{p.DelayedStakingGasFee, validateDelayedStakingGas},
 		{p.MaxNumPrivatePlans, validateMaxNumPrivatePlans},
+		{p.LockMultipliers, validateLockMultipliers},
+		{p.AllocationPolicy, validateAllocationPolicy},
+		{p.RewardsStreaming, validateRewardsStreaming},
+		{p.MaxCatchUpEpochs, validateMaxCatchUpEpochs},
+		{p.UnstakingPeriod, validateUnstakingPeriod},
+		{p.AutoCompoundGasPrice, validateAutoCompoundGasPrice},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -109,14 +172,14 @@ func validatePrivatePlanCreationFee(i interface{}) error {
 	return nil
 }
 
-func validateNextEpochDays(i interface{}) error {
-	v, ok := i.(uint32)
+func validateNextEpochDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
-		return fmt.Errorf("next epoch days must be positive: %d", v)
+	if v <= 0 {
+		return fmt.Errorf("next epoch duration must be positive: %s", v)
 	}
 
 	return nil
@@ -158,3 +221,85 @@ func validateMaxNumPrivatePlans(i interface{}) error {
 	// Allow zero MaxNumPrivatePlans
 	return nil
 }
+
+func validateLockMultipliers(i interface{}) error {
+	v, ok := i.([]LockMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, lm := range v {
+		if lm.Duration <= 0 {
+			return fmt.Errorf("lock duration must be positive: %s", lm.Duration)
+		}
+		if i > 0 && lm.Duration <= v[i-1].Duration {
+			return fmt.Errorf("lock durations must be in ascending order without duplicates: %s", lm.Duration)
+		}
+		if lm.Multiplier.IsNil() || lm.Multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lock multiplier must not be less than 1: %s", lm.Multiplier)
+		}
+	}
+
+	return nil
+}
+
+func validateAllocationPolicy(i interface{}) error {
+	v, ok := i.(AllocationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case AllocationPolicySkipAll, AllocationPolicyProRata, AllocationPolicyPriority:
+	default:
+		return fmt.Errorf("invalid allocation policy: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardsStreaming(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxCatchUpEpochs(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Allow zero MaxCatchUpEpochs, which disables catching up
+	return nil
+}
+
+func validateUnstakingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Allow zero UnstakingPeriod, which pays out unstaked coins immediately
+	if v < 0 {
+		return fmt.Errorf("unstaking period must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundGasPrice(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
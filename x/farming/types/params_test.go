@@ -3,6 +3,7 @@ package types_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,10 +21,24 @@ func TestParams(t *testing.T) {
 	paramsStr := `private_plan_creation_fee:
 - denom: stake
   amount: "1000000000"
-next_epoch_days: 1
+next_epoch_duration: 24h0m0s
 farming_fee_collector: cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x
 delayed_staking_gas_fee: 60000
 max_num_private_plans: 10000
+lock_multipliers:
+- duration: 168h0m0s
+  multiplier: "1.100000000000000000"
+- duration: 720h0m0s
+  multiplier: "1.250000000000000000"
+- duration: 2160h0m0s
+  multiplier: "1.500000000000000000"
+allocation_policy: 1
+rewards_streaming: false
+max_catch_up_epochs: 10
+unstaking_period: 0s
+auto_compound_gas_price:
+- denom: stake
+  amount: "0.050000000000000000"
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -44,11 +59,25 @@ func TestParamsValidate(t *testing.T) {
 			"",
 		},
 		{
-			"ZeroNextEpochDays",
+			"ZeroNextEpochDuration",
+			func(params *types.Params) {
+				params.NextEpochDuration = 0
+			},
+			"next epoch duration must be positive: 0s",
+		},
+		{
+			"SubDayNextEpochDuration",
+			func(params *types.Params) {
+				params.NextEpochDuration = 10 * time.Minute
+			},
+			"",
+		},
+		{
+			"NegativeNextEpochDuration",
 			func(params *types.Params) {
-				params.NextEpochDays = uint32(0)
+				params.NextEpochDuration = -time.Hour
 			},
-			"next epoch days must be positive: 0",
+			"next epoch duration must be positive: -1h0m0s",
 		},
 		{
 			"EmptyFarmingFeeCollector",
@@ -57,6 +86,100 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"farming fee collector address must not be empty",
 		},
+		{
+			"EmptyLockMultipliers",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{}
+			},
+			"",
+		},
+		{
+			"ValidLockMultipliers",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{
+					{Duration: 7 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.2")},
+					{Duration: 30 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.5")},
+				}
+			},
+			"",
+		},
+		{
+			"ZeroLockDuration",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{
+					{Duration: 0, Multiplier: sdk.OneDec()},
+				}
+			},
+			"lock duration must be positive: 0s",
+		},
+		{
+			"UnsortedLockDurations",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{
+					{Duration: 30 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.5")},
+					{Duration: 7 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.2")},
+				}
+			},
+			"lock durations must be in ascending order without duplicates: 168h0m0s",
+		},
+		{
+			"TooSmallLockMultiplier",
+			func(params *types.Params) {
+				params.LockMultipliers = []types.LockMultiplier{
+					{Duration: time.Hour, Multiplier: sdk.MustNewDecFromStr("0.5")},
+				}
+			},
+			"lock multiplier must not be less than 1: 0.500000000000000000",
+		},
+		{
+			"ProRataAllocationPolicy",
+			func(params *types.Params) {
+				params.AllocationPolicy = types.AllocationPolicyProRata
+			},
+			"",
+		},
+		{
+			"UnspecifiedAllocationPolicy",
+			func(params *types.Params) {
+				params.AllocationPolicy = types.AllocationPolicyNil
+			},
+			"invalid allocation policy: ALLOCATION_POLICY_UNSPECIFIED",
+		},
+		{
+			"InvalidAllocationPolicy",
+			func(params *types.Params) {
+				params.AllocationPolicy = 10
+			},
+			"invalid allocation policy: 10",
+		},
+		{
+			"PositiveUnstakingPeriod",
+			func(params *types.Params) {
+				params.UnstakingPeriod = 7 * 24 * time.Hour
+			},
+			"",
+		},
+		{
+			"NegativeUnstakingPeriod",
+			func(params *types.Params) {
+				params.UnstakingPeriod = -time.Hour
+			},
+			"unstaking period must not be negative: -1h0m0s",
+		},
+		{
+			"EmptyAutoCompoundGasPrice",
+			func(params *types.Params) {
+				params.AutoCompoundGasPrice = sdk.DecCoins{}
+			},
+			"",
+		},
+		{
+			"InvalidAutoCompoundGasPrice",
+			func(params *types.Params) {
+				params.AutoCompoundGasPrice = sdk.DecCoins{sdk.NewDecCoinFromDec("denom1", sdk.ZeroDec())}
+			},
+			"coin 0.000000000000000000denom1 amount is not positive",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -83,3 +206,51 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_GetLockMultiplier(t *testing.T) {
+	params := types.DefaultParams()
+	params.LockMultipliers = []types.LockMultiplier{
+		{Duration: 7 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.2")},
+		{Duration: 30 * 24 * time.Hour, Multiplier: sdk.MustNewDecFromStr("1.5")},
+	}
+
+	for _, tc := range []struct {
+		duration   time.Duration
+		found      bool
+		multiplier sdk.Dec
+	}{
+		{24 * time.Hour, false, sdk.Dec{}},
+		{7 * 24 * time.Hour, true, sdk.MustNewDecFromStr("1.2")},
+		{29 * 24 * time.Hour, true, sdk.MustNewDecFromStr("1.2")},
+		{365 * 24 * time.Hour, true, sdk.MustNewDecFromStr("1.5")},
+	} {
+		multiplier, found := params.GetLockMultiplier(tc.duration)
+		require.Equal(t, tc.found, found)
+		if tc.found {
+			require.True(t, tc.multiplier.Equal(multiplier))
+		}
+	}
+}
+
+func TestParams_GetAutoCompoundFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.AutoCompoundGasPrice = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("denom1", sdk.MustNewDecFromStr("0.05")),
+		sdk.NewDecCoinFromDec("denom2", sdk.MustNewDecFromStr("0.0001")),
+	)
+
+	for _, tc := range []struct {
+		gasConsumed sdk.Gas
+		fee         sdk.Coins
+	}{
+		{0, sdk.NewCoins()},
+		{1, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1), sdk.NewInt64Coin("denom2", 1))}, // Rounded up
+		{100_000, sdk.NewCoins(sdk.NewInt64Coin("denom1", 5_000), sdk.NewInt64Coin("denom2", 10))},
+		{123_456, sdk.NewCoins(sdk.NewInt64Coin("denom1", 6_173), sdk.NewInt64Coin("denom2", 13))},
+	} {
+		require.True(t, tc.fee.IsEqual(params.GetAutoCompoundFee(tc.gasConsumed)))
+	}
+
+	params.AutoCompoundGasPrice = sdk.DecCoins{}
+	require.True(t, params.GetAutoCompoundFee(100_000).IsZero())
+}
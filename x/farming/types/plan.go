@@ -17,12 +17,14 @@ const (
 	PrivatePlanFarmingPoolAccPrefix string = "PrivatePlan"
 	StakingReserveAccPrefix         string = "StakingReserveAcc"
 	RewardReserveAccPrefix          string = "RewardsReserveAcc"
+	VestingReserveAccPrefix         string = "VestingReserveAcc"
 	AccNameSplitter                 string = "|"
 )
 
 var (
 	_ PlanI = (*FixedAmountPlan)(nil)
 	_ PlanI = (*RatioPlan)(nil)
+	_ PlanI = (*DecayingAmountPlan)(nil)
 
 	planNameRegexp = regexp.MustCompile(`^[[:print:]]+$`)
 )
@@ -42,6 +44,8 @@ func NewBasePlan(id uint64, name string, typ PlanType, farmingPoolAddr, terminat
 		Terminated:           false,
 		LastDistributionTime: nil,
 		DistributedCoins:     sdk.NewCoins(),
+		MaxStakePerFarmer:    sdk.ZeroInt(),
+		MaxTotalStake:        sdk.ZeroInt(),
 	}
 	return basePlan
 }
@@ -153,6 +157,43 @@ func (plan *BasePlan) SetDistributedCoins(distributedCoins sdk.Coins) error {
 	return nil
 }
 
+func (plan BasePlan) GetVestingDuration() time.Duration {
+	return plan.VestingDuration
+}
+
+func (plan *BasePlan) SetVestingDuration(d time.Duration) error {
+	plan.VestingDuration = d
+	return nil
+}
+
+// GetMaxStakePerFarmer returns the maximum amount of a farmer's stake counted
+// toward the plan, or zero if there is no limit.
+func (plan BasePlan) GetMaxStakePerFarmer() sdk.Int {
+	if plan.MaxStakePerFarmer.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return plan.MaxStakePerFarmer
+}
+
+func (plan *BasePlan) SetMaxStakePerFarmer(amt sdk.Int) error {
+	plan.MaxStakePerFarmer = amt
+	return nil
+}
+
+// GetMaxTotalStake returns the maximum total amount of stakes counted toward
+// the plan, or zero if there is no limit.
+func (plan BasePlan) GetMaxTotalStake() sdk.Int {
+	if plan.MaxTotalStake.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return plan.MaxTotalStake
+}
+
+func (plan *BasePlan) SetMaxTotalStake(amt sdk.Int) error {
+	plan.MaxTotalStake = amt
+	return nil
+}
+
 func (plan BasePlan) GetBasePlan() *BasePlan {
 	return &BasePlan{
 		Id:                   plan.GetId(),
@@ -166,6 +207,9 @@ func (plan BasePlan) GetBasePlan() *BasePlan {
 		Terminated:           plan.IsTerminated(),
 		LastDistributionTime: plan.GetLastDistributionTime(),
 		DistributedCoins:     plan.GetDistributedCoins(),
+		VestingDuration:      plan.GetVestingDuration(),
+		MaxStakePerFarmer:    plan.GetMaxStakePerFarmer(),
+		MaxTotalStake:        plan.GetMaxTotalStake(),
 	}
 }
 
@@ -192,6 +236,12 @@ func (plan BasePlan) Validate() error {
 	if err := plan.DistributedCoins.Validate(); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distributed coins: %v", err)
 	}
+	if err := ValidateVestingDuration(plan.VestingDuration); err != nil {
+		return err
+	}
+	if err := ValidateStakeCaps(plan.MaxStakePerFarmer, plan.MaxTotalStake); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -211,6 +261,44 @@ func NewRatioPlan(basePlan *BasePlan, epochRatio sdk.Dec) *RatioPlan {
 	}
 }
 
+// NewDecayingAmountPlan returns a new decaying amount plan.
+func NewDecayingAmountPlan(basePlan *BasePlan, epochAmount sdk.Coins, decayFactor sdk.Dec, decayPeriod uint32) *DecayingAmountPlan {
+	return &DecayingAmountPlan{
+		BasePlan:      basePlan,
+		EpochAmount:   epochAmount,
+		DecayFactor:   decayFactor,
+		DecayPeriod:   decayPeriod,
+		ElapsedEpochs: 0,
+	}
+}
+
+// CurrentEpochAmount returns the distributing amount for the current epoch,
+// which is the initial epoch amount multiplied by the decay factor for each
+// decay period that has elapsed.
+func (plan DecayingAmountPlan) CurrentEpochAmount() sdk.Coins {
+	numDecays := plan.ElapsedEpochs / uint64(plan.DecayPeriod)
+	multiplier := plan.DecayFactor.Power(numDecays)
+	amt, _ := sdk.NewDecCoinsFromCoins(plan.EpochAmount...).MulDecTruncate(multiplier).TruncateDecimal()
+	return amt
+}
+
+// Validate checks for errors on the DecayingAmountPlan fields.
+func (plan DecayingAmountPlan) Validate() error {
+	if err := plan.BasePlan.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateEpochAmount(plan.EpochAmount); err != nil {
+		return err
+	}
+	if err := ValidateDecayFactor(plan.DecayFactor); err != nil {
+		return err
+	}
+	if err := ValidateDecayPeriod(plan.DecayPeriod); err != nil {
+		return err
+	}
+	return nil
+}
+
 // PlanI represents a farming plan.
 type PlanI interface {
 	proto.Message
@@ -248,6 +336,15 @@ type PlanI interface {
 	GetDistributedCoins() sdk.Coins
 	SetDistributedCoins(sdk.Coins) error
 
+	GetVestingDuration() time.Duration
+	SetVestingDuration(time.Duration) error
+
+	GetMaxStakePerFarmer() sdk.Int
+	SetMaxStakePerFarmer(sdk.Int) error
+
+	GetMaxTotalStake() sdk.Int
+	SetMaxTotalStake(sdk.Int) error
+
 	GetBasePlan() *BasePlan
 
 	Validate() error
@@ -303,6 +400,52 @@ func ValidateEpochAmount(epochAmount sdk.Coins) error {
 	return nil
 }
 
+// ValidateDecayFactor validates a decay factor that must be positive and less than 1.
+func ValidateDecayFactor(decayFactor sdk.Dec) error {
+	if decayFactor.IsNil() || !decayFactor.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay factor must be positive: %s", decayFactor)
+	}
+	if !decayFactor.LT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay factor must be less than 1: %s", decayFactor)
+	}
+	return nil
+}
+
+// ValidateDecayPeriod validates a decay period that must be positive.
+func ValidateDecayPeriod(decayPeriod uint32) error {
+	if decayPeriod == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay period must be positive")
+	}
+	return nil
+}
+
+// ValidateVestingDuration validates a vesting duration that must not be negative.
+func ValidateVestingDuration(vestingDuration time.Duration) error {
+	if vestingDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "vesting duration must not be negative: %s", vestingDuration)
+	}
+	return nil
+}
+
+// ValidateStakeCaps validates the stake caps of a plan that must not be
+// negative. Nil values are treated as zero, which means no limit.
+func ValidateStakeCaps(maxStakePerFarmer, maxTotalStake sdk.Int) error {
+	if !maxStakePerFarmer.IsNil() && maxStakePerFarmer.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max stake per farmer must not be negative: %s", maxStakePerFarmer)
+	}
+	if !maxTotalStake.IsNil() && maxTotalStake.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max total stake must not be negative: %s", maxTotalStake)
+	}
+	return nil
+}
+
+// HasStakeCaps returns true if the plan limits the amount of stakes counted
+// toward it, in which case the rewards of the plan are calculated only with
+// the counted portion of each farmer's stake.
+func HasStakeCaps(plan PlanI) bool {
+	return plan.GetMaxStakePerFarmer().IsPositive() || plan.GetMaxTotalStake().IsPositive()
+}
+
 // PackPlan converts PlanI to Any
 func PackPlan(plan PlanI) (*codectypes.Any, error) {
 	any, err := codectypes.NewAnyWithValue(plan)
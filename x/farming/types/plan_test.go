@@ -342,6 +342,44 @@ func TestBasePlanValidate(t *testing.T) {
 	}
 }
 
+func TestDecayingAmountPlan(t *testing.T) {
+	basePlan := types.NewBasePlan(
+		1,
+		"sample plan",
+		types.PlanTypePublic,
+		sdk.AccAddress(crypto.AddressHash([]byte("address1"))).String(),
+		sdk.AccAddress(crypto.AddressHash([]byte("address1"))).String(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake1", sdk.OneDec())),
+		types.ParseTime("2021-08-03T00:00:00Z"),
+		types.ParseTime("2021-08-07T00:00:00Z"),
+	)
+	plan := types.NewDecayingAmountPlan(
+		basePlan, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000)), sdk.NewDecWithPrec(5, 1), 2)
+	require.NoError(t, plan.Validate())
+
+	for _, tc := range []struct {
+		elapsedEpochs uint64
+		expected      sdk.Coins
+	}{
+		{0, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000))},
+		{1, sdk.NewCoins(sdk.NewInt64Coin("reward1", 1000000))},
+		{2, sdk.NewCoins(sdk.NewInt64Coin("reward1", 500000))},
+		{5, sdk.NewCoins(sdk.NewInt64Coin("reward1", 250000))},
+		{100, sdk.NewCoins()},
+	} {
+		plan.ElapsedEpochs = tc.elapsedEpochs
+		require.True(t, tc.expected.IsEqual(plan.CurrentEpochAmount()), "elapsed epochs %d", tc.elapsedEpochs)
+	}
+
+	plan.DecayFactor = sdk.OneDec()
+	require.EqualError(t, plan.Validate(), "decay factor must be less than 1: 1.000000000000000000: invalid request")
+	plan.DecayFactor = sdk.ZeroDec()
+	require.EqualError(t, plan.Validate(), "decay factor must be positive: 0.000000000000000000: invalid request")
+	plan.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	plan.DecayPeriod = 0
+	require.EqualError(t, plan.Validate(), "decay period must be positive: invalid request")
+}
+
 func TestIsPlanActiveAt(t *testing.T) {
 	plan := types.NewFixedAmountPlan(
 		types.NewBasePlan(
@@ -11,14 +11,24 @@ import (
 
 const (
 	ProposalTypePublicPlan string = "PublicPlan"
+	ProposalTypePause      string = "Pause"
+	ProposalTypeUnpause    string = "Unpause"
 )
 
 // Implements Proposal Interface
-var _ gov.Content = &PublicPlanProposal{}
+var (
+	_ gov.Content = &PublicPlanProposal{}
+	_ gov.Content = &PauseProposal{}
+	_ gov.Content = &UnpauseProposal{}
+)
 
 func init() {
 	gov.RegisterProposalType(ProposalTypePublicPlan)
 	gov.RegisterProposalTypeCodec(&PublicPlanProposal{}, "cosmos-sdk/PublicPlanProposal")
+	gov.RegisterProposalType(ProposalTypePause)
+	gov.RegisterProposalTypeCodec(&PauseProposal{}, "cosmos-sdk/FarmingPauseProposal")
+	gov.RegisterProposalType(ProposalTypeUnpause)
+	gov.RegisterProposalTypeCodec(&UnpauseProposal{}, "cosmos-sdk/FarmingUnpauseProposal")
 }
 
 // NewPublicPlanProposal creates a new PublicPlanProposal object.
@@ -81,6 +91,100 @@ func (p PublicPlanProposal) String() string {
 `, p.Title, p.Description, p.AddPlanRequests, p.ModifyPlanRequests, p.DeletePlanRequests)
 }
 
+// NewPauseProposal creates a new PauseProposal object.
+func NewPauseProposal(title, description string, functions []PausableFunction) *PauseProposal {
+	return &PauseProposal{
+		Title:       title,
+		Description: description,
+		Functions:   functions,
+	}
+}
+
+func (p *PauseProposal) GetTitle() string { return p.Title }
+
+func (p *PauseProposal) GetDescription() string { return p.Description }
+
+func (p *PauseProposal) ProposalRoute() string { return RouterKey }
+
+func (p *PauseProposal) ProposalType() string { return ProposalTypePause }
+
+func (p *PauseProposal) ValidateBasic() error {
+	if err := ValidatePausableFunctions(p.Functions); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PauseProposal) String() string {
+	return fmt.Sprintf(`Pause Proposal:
+  Title:       %s
+  Description: %s
+  Functions:   %v
+`, p.Title, p.Description, p.Functions)
+}
+
+// NewUnpauseProposal creates a new UnpauseProposal object.
+func NewUnpauseProposal(title, description string, functions []PausableFunction) *UnpauseProposal {
+	return &UnpauseProposal{
+		Title:       title,
+		Description: description,
+		Functions:   functions,
+	}
+}
+
+func (p *UnpauseProposal) GetTitle() string { return p.Title }
+
+func (p *UnpauseProposal) GetDescription() string { return p.Description }
+
+func (p *UnpauseProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UnpauseProposal) ProposalType() string { return ProposalTypeUnpause }
+
+func (p *UnpauseProposal) ValidateBasic() error {
+	if err := ValidatePausableFunctions(p.Functions); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p UnpauseProposal) String() string {
+	return fmt.Sprintf(`Unpause Proposal:
+  Title:       %s
+  Description: %s
+  Functions:   %v
+`, p.Title, p.Description, p.Functions)
+}
+
+// ValidatePausableFunctions validates that the functions are not empty
+// and that they are valid pausable functions without duplicates.
+func ValidatePausableFunctions(functions []PausableFunction) error {
+	if len(functions) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "functions must not be empty")
+	}
+	seen := map[PausableFunction]bool{}
+	for _, function := range functions {
+		if err := ValidatePausableFunction(function); err != nil {
+			return err
+		}
+		if seen[function] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate function: %s", function)
+		}
+		seen[function] = true
+	}
+	return nil
+}
+
+// ValidatePausableFunction validates a pausable function.
+func ValidatePausableFunction(function PausableFunction) error {
+	switch function {
+	case PausableFunctionStake, PausableFunctionUnstake, PausableFunctionHarvest,
+		PausableFunctionPlanCreation, PausableFunctionRewardAllocation:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid function: %s", function)
+	}
+}
+
 // NewAddPlanRequest creates a new AddPlanRequest object
 func NewAddPlanRequest(
 	name string,
@@ -104,11 +208,48 @@ func NewAddPlanRequest(
 	}
 }
 
+// NewAddDecayingAmountPlanRequest creates a new AddPlanRequest object
+// for decaying amount plan.
+func NewAddDecayingAmountPlanRequest(
+	name string,
+	farmingPoolAddr string,
+	terminationAddr string,
+	stakingCoinWeights sdk.DecCoins,
+	startTime time.Time,
+	endTime time.Time,
+	epochAmount sdk.Coins,
+	decayFactor sdk.Dec,
+	decayPeriod uint32,
+) AddPlanRequest {
+	return AddPlanRequest{
+		Name:               name,
+		FarmingPoolAddress: farmingPoolAddr,
+		TerminationAddress: terminationAddr,
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		DecayFactor:        decayFactor,
+		DecayPeriod:        decayPeriod,
+	}
+}
+
 // IsForFixedAmountPlan returns true if the request is for
 // fixed amount plan.
-// It checks if EpochAmount is not zero.
+// It checks if EpochAmount is not zero and DecayFactor is not provided.
 func (p *AddPlanRequest) IsForFixedAmountPlan() bool {
-	return !p.EpochAmount.Empty()
+	return !p.EpochAmount.Empty() && !p.hasDecayFactor()
+}
+
+// IsForDecayingAmountPlan returns true if the request is for
+// decaying amount plan.
+// It checks if both EpochAmount and DecayFactor are not zero.
+func (p *AddPlanRequest) IsForDecayingAmountPlan() bool {
+	return !p.EpochAmount.Empty() && p.hasDecayFactor()
+}
+
+func (p *AddPlanRequest) hasDecayFactor() bool {
+	return !p.DecayFactor.IsNil() && !p.DecayFactor.IsZero()
 }
 
 // IsForRatioPlan returns true if the request is for
@@ -129,10 +270,21 @@ func (p *AddPlanRequest) Validate() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid termination address %q: %v", p.TerminationAddress, err)
 	}
 
+	if p.hasDecayFactor() && p.EpochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay factor must be provided with epoch amount")
+	}
+	if err := ValidateVestingDuration(p.VestingDuration); err != nil {
+		return err
+	}
+	if err := ValidateStakeCaps(p.MaxStakePerFarmer, p.MaxTotalStake); err != nil {
+		return err
+	}
+
 	isForFixedAmountPlan := p.IsForFixedAmountPlan()
+	isForDecayingAmountPlan := p.IsForDecayingAmountPlan()
 	isForRatioPlan := p.IsForRatioPlan()
 	switch {
-	case isForFixedAmountPlan == isForRatioPlan:
+	case (isForFixedAmountPlan || isForDecayingAmountPlan) == isForRatioPlan:
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of epoch amount or epoch ratio must be provided")
 	case isForFixedAmountPlan:
 		if err := NewMsgCreateFixedAmountPlan(
@@ -140,6 +292,13 @@ func (p *AddPlanRequest) Validate() error {
 		).ValidateBasic(); err != nil {
 			return err
 		}
+	case isForDecayingAmountPlan:
+		if err := NewMsgCreateDecayingAmountPlan(
+			p.Name, farmingPoolAddr, p.StakingCoinWeights, p.StartTime, p.EndTime, p.EpochAmount,
+			p.DecayFactor, p.DecayPeriod,
+		).ValidateBasic(); err != nil {
+			return err
+		}
 	case isForRatioPlan:
 		if err := NewMsgCreateRatioPlan(
 			p.Name, farmingPoolAddr, p.StakingCoinWeights, p.StartTime, p.EndTime, p.EpochRatio,
@@ -177,9 +336,20 @@ func NewModifyPlanRequest(
 
 // IsForFixedAmountPlan returns true if the request is for
 // fixed amount plan.
-// It checks if EpochAmount is not zero.
+// It checks if EpochAmount is not zero and DecayFactor is not provided.
 func (p *ModifyPlanRequest) IsForFixedAmountPlan() bool {
-	return !p.EpochAmount.Empty()
+	return !p.EpochAmount.Empty() && !p.hasDecayFactor()
+}
+
+// IsForDecayingAmountPlan returns true if the request is for
+// decaying amount plan.
+// It checks if both EpochAmount and DecayFactor are not zero.
+func (p *ModifyPlanRequest) IsForDecayingAmountPlan() bool {
+	return !p.EpochAmount.Empty() && p.hasDecayFactor()
+}
+
+func (p *ModifyPlanRequest) hasDecayFactor() bool {
+	return !p.DecayFactor.IsNil() && !p.DecayFactor.IsZero()
 }
 
 // IsForRatioPlan returns true if the request is for
@@ -219,15 +389,29 @@ func (p *ModifyPlanRequest) Validate() error {
 			return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", p.EndTime, p.StartTime)
 		}
 	}
+	if p.hasDecayFactor() && p.EpochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay factor must be provided with epoch amount")
+	}
 	isForFixedAmountPlan := p.IsForFixedAmountPlan()
+	isForDecayingAmountPlan := p.IsForDecayingAmountPlan()
 	isForRatioPlan := p.IsForRatioPlan()
 	switch {
-	case isForFixedAmountPlan && isForRatioPlan:
+	case (isForFixedAmountPlan || isForDecayingAmountPlan) && isForRatioPlan:
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at most one of epoch amount or epoch ratio must be provided")
 	case isForFixedAmountPlan:
 		if err := ValidateEpochAmount(p.EpochAmount); err != nil {
 			return err
 		}
+	case isForDecayingAmountPlan:
+		if err := ValidateEpochAmount(p.EpochAmount); err != nil {
+			return err
+		}
+		if err := ValidateDecayFactor(p.DecayFactor); err != nil {
+			return err
+		}
+		if err := ValidateDecayPeriod(p.DecayPeriod); err != nil {
+			return err
+		}
 	case isForRatioPlan:
 		if err := ValidateEpochRatio(p.EpochRatio); err != nil {
 			return err
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -33,7 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // PublicPlanProposal defines a public farming plan governance proposal that receives one of the following requests:
 // A request that creates a public farming plan, a request that updates the plan, and a request that deletes the plan.
 // For public plan creation, depending on which field is passed, either epoch amount or epoch ratio, it creates a fixed
-// amount plan or ratio plan.
+// amount plan or ratio plan. If decay factor is passed along with epoch amount, it creates a decaying amount plan.
 type PublicPlanProposal struct {
 	// title specifies the title of the plan
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -79,6 +80,92 @@ func (m *PublicPlanProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PublicPlanProposal proto.InternalMessageInfo
 
+// PauseProposal defines a governance proposal that pauses functions of the
+// farming module, to be used as a circuit breaker.
+type PauseProposal struct {
+	// title specifies the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description specifies the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// functions specifies the functions to pause
+	Functions []PausableFunction `protobuf:"varint,3,rep,packed,name=functions,proto3,enum=cosmos.farming.v1beta1.PausableFunction" json:"functions,omitempty"`
+}
+
+func (m *PauseProposal) Reset()      { *m = PauseProposal{} }
+func (*PauseProposal) ProtoMessage() {}
+func (*PauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4719b03c30c7910a, []int{1}
+}
+func (m *PauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseProposal.Merge(m, src)
+}
+func (m *PauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseProposal proto.InternalMessageInfo
+
+// UnpauseProposal defines a governance proposal that unpauses functions of
+// the farming module paused by a PauseProposal.
+type UnpauseProposal struct {
+	// title specifies the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description specifies the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// functions specifies the functions to unpause
+	Functions []PausableFunction `protobuf:"varint,3,rep,packed,name=functions,proto3,enum=cosmos.farming.v1beta1.PausableFunction" json:"functions,omitempty"`
+}
+
+func (m *UnpauseProposal) Reset()      { *m = UnpauseProposal{} }
+func (*UnpauseProposal) ProtoMessage() {}
+func (*UnpauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4719b03c30c7910a, []int{2}
+}
+func (m *UnpauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseProposal.Merge(m, src)
+}
+func (m *UnpauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseProposal proto.InternalMessageInfo
+
 // AddPlanRequest details a proposal for creating a public plan.
 type AddPlanRequest struct {
 	// name specifies the plan name for display
@@ -99,13 +186,27 @@ type AddPlanRequest struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor that the epoch amount is multiplied by
+	// for every decay period
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period specifies the number of epochs between decays
+	DecayPeriod uint32 `protobuf:"varint,10,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
+	// vesting_duration specifies the duration over which the rewards unlock
+	// linearly; zero means the rewards are paid out immediately
+	VestingDuration time.Duration `protobuf:"bytes,11,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// max_stake_per_farmer specifies the maximum amount of a farmer's stake
+	// counted toward the plan for each staking coin denom; zero means no limit
+	MaxStakePerFarmer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_stake_per_farmer,json=maxStakePerFarmer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake_per_farmer" yaml:"max_stake_per_farmer"`
+	// max_total_stake specifies the maximum total amount of stakes counted
+	// toward the plan for each staking coin denom; zero means no limit
+	MaxTotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_total_stake,json=maxTotalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_stake" yaml:"max_total_stake"`
 }
 
 func (m *AddPlanRequest) Reset()         { *m = AddPlanRequest{} }
 func (m *AddPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPlanRequest) ProtoMessage()    {}
 func (*AddPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{1}
+	return fileDescriptor_4719b03c30c7910a, []int{3}
 }
 func (m *AddPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,6 +284,20 @@ func (m *AddPlanRequest) GetEpochAmount() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *AddPlanRequest) GetDecayPeriod() uint32 {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return 0
+}
+
+func (m *AddPlanRequest) GetVestingDuration() time.Duration {
+	if m != nil {
+		return m.VestingDuration
+	}
+	return 0
+}
+
 // ModifyPlanRequest details a proposal for modifying the existing public plan.
 type ModifyPlanRequest struct {
 	// plan_id specifies index of the farming plan
@@ -205,13 +320,18 @@ type ModifyPlanRequest struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor that the epoch amount is multiplied by
+	// for every decay period
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period specifies the number of epochs between decays
+	DecayPeriod uint32 `protobuf:"varint,11,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
 }
 
 func (m *ModifyPlanRequest) Reset()         { *m = ModifyPlanRequest{} }
 func (m *ModifyPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyPlanRequest) ProtoMessage()    {}
 func (*ModifyPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{2}
+	return fileDescriptor_4719b03c30c7910a, []int{4}
 }
 func (m *ModifyPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,6 +416,13 @@ func (m *ModifyPlanRequest) GetEpochAmount() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+func (m *ModifyPlanRequest) GetDecayPeriod() uint32 {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return 0
+}
+
 // DeletePlanRequest details a proposal for deleting an existing public plan.
 type DeletePlanRequest struct {
 	// plan_id specifies index of the farming plan
@@ -306,7 +433,7 @@ func (m *DeletePlanRequest) Reset()         { *m = DeletePlanRequest{} }
 func (m *DeletePlanRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePlanRequest) ProtoMessage()    {}
 func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{3}
+	return fileDescriptor_4719b03c30c7910a, []int{5}
 }
 func (m *DeletePlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +471,8 @@ func (m *DeletePlanRequest) GetPlanId() uint64 {
 
 func init() {
 	proto.RegisterType((*PublicPlanProposal)(nil), "cosmos.farming.v1beta1.PublicPlanProposal")
+	proto.RegisterType((*PauseProposal)(nil), "cosmos.farming.v1beta1.PauseProposal")
+	proto.RegisterType((*UnpauseProposal)(nil), "cosmos.farming.v1beta1.UnpauseProposal")
 	proto.RegisterType((*AddPlanRequest)(nil), "cosmos.farming.v1beta1.AddPlanRequest")
 	proto.RegisterType((*ModifyPlanRequest)(nil), "cosmos.farming.v1beta1.ModifyPlanRequest")
 	proto.RegisterType((*DeletePlanRequest)(nil), "cosmos.farming.v1beta1.DeletePlanRequest")
@@ -354,56 +483,70 @@ func init() {
 }
 
 var fileDescriptor_4719b03c30c7910a = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0xf2, 0x93, 0xc9, 0x6a, 0x51, 0x86, 0x68, 0x37, 0x84, 0x5d, 0x3b, 0xf2, 0x4a,
-	0x28, 0x68, 0x17, 0x5b, 0xb0, 0x37, 0x6e, 0x64, 0x91, 0xd0, 0x1e, 0x56, 0x9b, 0x5a, 0x95, 0x5a,
-	0xf5, 0x62, 0x4d, 0x32, 0x43, 0xb0, 0xb0, 0x3d, 0xae, 0x67, 0xd2, 0x96, 0x5b, 0x2f, 0x95, 0xaa,
-	0x9e, 0x38, 0xf6, 0x88, 0x7a, 0xe8, 0xa1, 0x7f, 0x09, 0x47, 0xd4, 0x53, 0xd5, 0x43, 0xa8, 0xe0,
-	0x3f, 0xe0, 0x2f, 0xa8, 0x3c, 0x33, 0x4e, 0x43, 0x30, 0x34, 0x48, 0x54, 0xe2, 0x64, 0xbf, 0x99,
-	0xf7, 0xbe, 0xef, 0xcb, 0x7b, 0xfe, 0x88, 0x80, 0x55, 0x4e, 0x42, 0x4c, 0xe2, 0xc0, 0x0b, 0xb9,
-	0xbd, 0x8b, 0x92, 0xe7, 0xc0, 0x7e, 0xb6, 0xde, 0x23, 0x1c, 0xad, 0xdb, 0x51, 0x4c, 0x23, 0xca,
-	0x90, 0x6f, 0x45, 0x31, 0xe5, 0x14, 0xfe, 0xd2, 0xa7, 0x2c, 0xa0, 0xcc, 0x52, 0x69, 0x96, 0x4a,
-	0x6b, 0xd6, 0x07, 0x74, 0x40, 0x45, 0x8a, 0x9d, 0xbc, 0xc9, 0xec, 0xe6, 0x92, 0xcc, 0x76, 0xe5,
-	0x85, 0x2a, 0x95, 0x57, 0xba, 0x8c, 0xec, 0x1e, 0x62, 0x64, 0xdc, 0xac, 0x4f, 0xbd, 0x50, 0xdd,
-	0xb7, 0x6f, 0xf0, 0x94, 0x36, 0x97, 0x99, 0xc6, 0x80, 0xd2, 0x81, 0x4f, 0x6c, 0x11, 0xf5, 0x86,
-	0xbb, 0x36, 0xf7, 0x02, 0xc2, 0x38, 0x0a, 0x22, 0x99, 0x60, 0x7e, 0xcc, 0x03, 0xd8, 0x1d, 0xf6,
-	0x7c, 0xaf, 0xdf, 0xf5, 0x51, 0xd8, 0x55, 0x7f, 0x10, 0xac, 0x83, 0x22, 0xf7, 0xb8, 0x4f, 0x1a,
-	0x5a, 0x4b, 0x6b, 0xcf, 0x3b, 0x32, 0x80, 0x2d, 0x50, 0xc5, 0x84, 0xf5, 0x63, 0x2f, 0xe2, 0x1e,
-	0x0d, 0x1b, 0x73, 0xe2, 0x6e, 0xf2, 0x08, 0x72, 0x50, 0x43, 0x18, 0xbb, 0x91, 0x8f, 0x42, 0x37,
-	0x26, 0x4f, 0x87, 0x84, 0x71, 0xd6, 0xc8, 0xb7, 0xf2, 0xed, 0xea, 0xc6, 0x8a, 0x95, 0x3d, 0x1e,
-	0x6b, 0x0b, 0xe3, 0xa4, 0xb7, 0x23, 0xd3, 0x3b, 0xad, 0xe3, 0x91, 0x91, 0xbb, 0x18, 0x19, 0x8d,
-	0x03, 0x14, 0xf8, 0x9b, 0xe6, 0x15, 0x39, 0xd3, 0x59, 0x40, 0x97, 0x2a, 0x18, 0x7c, 0xa9, 0x81,
-	0x7a, 0x40, 0xb1, 0xb7, 0x7b, 0x30, 0xd5, 0xb9, 0x20, 0x3a, 0xaf, 0x5e, 0xd7, 0xf9, 0x3f, 0x51,
-	0x33, 0xd9, 0xfc, 0x0f, 0xd5, 0x7c, 0x59, 0x36, 0xcf, 0x12, 0x35, 0x1d, 0x18, 0x4c, 0xd7, 0x49,
-	0x0b, 0x98, 0xf8, 0x84, 0x93, 0x29, 0x0b, 0xc5, 0x9b, 0x2d, 0x6c, 0x8b, 0x9a, 0x1b, 0x2c, 0x64,
-	0x89, 0x9a, 0x0e, 0xc4, 0xd3, 0x75, 0x6c, 0xb3, 0xf2, 0xfa, 0xc8, 0xc8, 0xbd, 0x3d, 0x32, 0x72,
-	0xe6, 0x9b, 0x12, 0xf8, 0xf9, 0xf2, 0x54, 0x21, 0x04, 0x85, 0x10, 0x05, 0xe9, 0x3e, 0xc5, 0x3b,
-	0x7c, 0x00, 0xea, 0xca, 0x8e, 0x1b, 0x51, 0xea, 0xbb, 0x08, 0xe3, 0x98, 0x30, 0x26, 0xf7, 0xda,
-	0x31, 0xbe, 0x79, 0xc8, 0xca, 0x32, 0x1d, 0xa8, 0x8e, 0xbb, 0x94, 0xfa, 0x5b, 0xf2, 0x10, 0xfe,
-	0x0f, 0x16, 0xb9, 0xf8, 0x30, 0x51, 0xf2, 0x39, 0x8c, 0x15, 0xf3, 0x42, 0x51, 0xbf, 0x18, 0x19,
-	0x4d, 0xa9, 0x98, 0x91, 0x64, 0x3a, 0x70, 0xe2, 0x34, 0x15, 0x7c, 0xa7, 0x81, 0x3a, 0xe3, 0x68,
-	0x3f, 0x69, 0x9f, 0x10, 0xe0, 0x3e, 0x27, 0xde, 0x60, 0x6f, 0xbc, 0xda, 0xdf, 0xd2, 0xb9, 0x26,
-	0xa8, 0x4c, 0x0c, 0xb5, 0xff, 0x0f, 0xf5, 0xc2, 0x8e, 0x73, 0x79, 0x94, 0x59, 0x3a, 0xe6, 0x87,
-	0x53, 0xe3, 0xcf, 0x81, 0xc7, 0xf7, 0x86, 0x3d, 0xab, 0x4f, 0x03, 0xc5, 0xa1, 0x7a, 0xac, 0x31,
-	0xbc, 0x6f, 0xf3, 0x83, 0x88, 0xb0, 0x54, 0x92, 0x39, 0x50, 0xa9, 0x24, 0xd1, 0x23, 0xa9, 0x01,
-	0x1f, 0x03, 0xc0, 0x38, 0x8a, 0xb9, 0x9b, 0xd0, 0xd5, 0x28, 0xb6, 0xb4, 0x76, 0x75, 0xa3, 0x69,
-	0x49, 0xf4, 0xac, 0x14, 0x3d, 0xeb, 0x61, 0x8a, 0x5e, 0xe7, 0x77, 0xe5, 0xab, 0x36, 0xf6, 0xa5,
-	0x6a, 0xcd, 0xc3, 0x53, 0x43, 0x73, 0xe6, 0xc5, 0x41, 0x92, 0x0e, 0x1d, 0x50, 0x21, 0x21, 0x96,
-	0xba, 0xa5, 0xef, 0xea, 0x2e, 0x2b, 0xdd, 0x05, 0xa9, 0x9b, 0x56, 0x4a, 0xd5, 0x32, 0x09, 0xb1,
-	0xd0, 0x7c, 0xa5, 0x81, 0x9f, 0x48, 0x44, 0xfb, 0x7b, 0x2e, 0x0a, 0xe8, 0x30, 0xe4, 0x8d, 0xb2,
-	0x18, 0xe5, 0x52, 0xe6, 0x28, 0xc5, 0x1c, 0x77, 0x94, 0xee, 0xa2, 0xd2, 0x9d, 0x28, 0x4e, 0xe6,
-	0xd7, 0x9e, 0x61, 0x7e, 0x72, 0x78, 0x55, 0x51, 0xba, 0x25, 0x2a, 0x21, 0x01, 0x32, 0x74, 0xe3,
-	0x64, 0xe3, 0x8d, 0x8a, 0xf8, 0x46, 0xb6, 0x93, 0x56, 0x9f, 0x47, 0xc6, 0xca, 0x6c, 0x3b, 0xb9,
-	0x18, 0x19, 0x70, 0xd2, 0x94, 0x90, 0x32, 0x1d, 0x20, 0x22, 0x47, 0x04, 0xef, 0x4b, 0xa0, 0x76,
-	0x05, 0x74, 0xf8, 0x2b, 0x28, 0x0b, 0xa4, 0x3c, 0x2c, 0x90, 0x28, 0x38, 0xa5, 0x24, 0xfc, 0x17,
-	0x8f, 0x41, 0x99, 0x9b, 0x01, 0x94, 0xfc, 0x9d, 0x83, 0x52, 0xb8, 0x7b, 0x50, 0x8a, 0xf7, 0x16,
-	0x94, 0xd2, 0x4c, 0xa0, 0x68, 0xb7, 0x06, 0xa5, 0x3c, 0x13, 0x28, 0xda, 0xed, 0x41, 0xa9, 0xdc,
-	0x0b, 0x50, 0xe6, 0x7f, 0x10, 0x28, 0x7f, 0x81, 0xda, 0x95, 0xff, 0x46, 0xd7, 0x72, 0xd2, 0xd9,
-	0x39, 0x3e, 0xd3, 0xb5, 0x93, 0x33, 0x5d, 0xfb, 0x72, 0xa6, 0x6b, 0x87, 0xe7, 0x7a, 0xee, 0xe4,
-	0x5c, 0xcf, 0x7d, 0x3a, 0xd7, 0x73, 0x4f, 0xd6, 0x26, 0x1c, 0x65, 0xfc, 0x50, 0x79, 0x31, 0x7e,
-	0x13, 0xe6, 0x7a, 0x25, 0xb1, 0x9f, 0xbf, 0xbf, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x2f, 0xf0,
-	0x0f, 0x69, 0x09, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd6, 0x49, 0x1c, 0x8f, 0x93, 0x1a, 0x4f, 0xac, 0x66, 0x93, 0x82, 0xd7, 0x5a, 0xa4,
+	0xca, 0x15, 0xd4, 0x56, 0xc3, 0x2d, 0xb7, 0x98, 0x90, 0xd2, 0x43, 0x85, 0x59, 0x8a, 0x40, 0x5c,
+	0x56, 0xe3, 0x9d, 0xb1, 0xb3, 0xea, 0xee, 0xce, 0xb2, 0x33, 0x2e, 0xf1, 0x05, 0x71, 0x41, 0xe2,
+	0x82, 0xd4, 0x63, 0x11, 0x97, 0x8a, 0x23, 0x7f, 0x49, 0x0f, 0x1c, 0x2a, 0x4e, 0x88, 0x83, 0x8b,
+	0x92, 0xff, 0x20, 0x7f, 0x01, 0x9a, 0x1f, 0xeb, 0x6c, 0x9c, 0x4d, 0xb0, 0x45, 0x55, 0xe5, 0xe4,
+	0x7d, 0x6f, 0xde, 0xf7, 0x7d, 0x6f, 0xdf, 0xcc, 0x7c, 0x9b, 0x80, 0xbb, 0x9c, 0x44, 0x98, 0x24,
+	0xa1, 0x1f, 0xf1, 0xce, 0x00, 0x89, 0xdf, 0x61, 0xe7, 0xe9, 0xfd, 0x3e, 0xe1, 0xe8, 0x7e, 0x27,
+	0x4e, 0x68, 0x4c, 0x19, 0x0a, 0xda, 0x71, 0x42, 0x39, 0x85, 0xb7, 0x3c, 0xca, 0x42, 0xca, 0xda,
+	0xba, 0xac, 0xad, 0xcb, 0xb6, 0xeb, 0x43, 0x3a, 0xa4, 0xb2, 0xa4, 0x23, 0x9e, 0x54, 0xf5, 0xf6,
+	0x96, 0xaa, 0x76, 0xd5, 0x82, 0x86, 0xaa, 0xa5, 0x86, 0x8a, 0x3a, 0x7d, 0xc4, 0xc8, 0x54, 0xcc,
+	0xa3, 0x7e, 0xa4, 0xd7, 0x5b, 0x57, 0xf4, 0x94, 0x8a, 0xab, 0x4a, 0x6b, 0x48, 0xe9, 0x30, 0x20,
+	0x1d, 0x19, 0xf5, 0x47, 0x83, 0x0e, 0xf7, 0x43, 0xc2, 0x38, 0x0a, 0xe3, 0x54, 0x6a, 0xb6, 0x00,
+	0x8f, 0x12, 0xc4, 0x7d, 0xaa, 0xa5, 0xec, 0x3f, 0x8b, 0x00, 0xf6, 0x46, 0xfd, 0xc0, 0xf7, 0x7a,
+	0x01, 0x8a, 0x7a, 0xfa, 0x85, 0x61, 0x1d, 0x2c, 0x73, 0x9f, 0x07, 0xc4, 0x34, 0x9a, 0x46, 0xab,
+	0xec, 0xa8, 0x00, 0x36, 0x41, 0x05, 0x13, 0xe6, 0x25, 0x7e, 0x2c, 0x18, 0xcc, 0x1b, 0x72, 0x2d,
+	0x9b, 0x82, 0x1c, 0xd4, 0x10, 0xc6, 0x6e, 0x1c, 0xa0, 0xc8, 0x4d, 0xc8, 0xb7, 0x23, 0xc2, 0x38,
+	0x33, 0x8b, 0xcd, 0x62, 0xab, 0xb2, 0x73, 0xa7, 0x9d, 0x3f, 0xbe, 0xf6, 0x1e, 0xc6, 0x42, 0xdb,
+	0x51, 0xe5, 0xdd, 0xe6, 0xcb, 0x89, 0x55, 0x38, 0x9d, 0x58, 0xe6, 0x18, 0x85, 0xc1, 0xae, 0x7d,
+	0x81, 0xce, 0x76, 0xaa, 0xe8, 0x1c, 0x82, 0xc1, 0x1f, 0x0c, 0x50, 0x0f, 0x29, 0xf6, 0x07, 0xe3,
+	0x19, 0xe5, 0x25, 0xa9, 0x7c, 0xf7, 0x32, 0xe5, 0x47, 0x12, 0x93, 0x15, 0x7f, 0x5f, 0x8b, 0xdf,
+	0x56, 0xe2, 0x79, 0xa4, 0xb6, 0x03, 0xc3, 0x59, 0x9c, 0x6a, 0x01, 0x93, 0x80, 0x70, 0x32, 0xd3,
+	0xc2, 0xf2, 0xd5, 0x2d, 0xec, 0x4b, 0xcc, 0x15, 0x2d, 0xe4, 0x91, 0xda, 0x0e, 0xc4, 0xb3, 0x38,
+	0xb6, 0xbb, 0xfa, 0xd3, 0x0b, 0xab, 0xf0, 0xfc, 0x85, 0x55, 0xb0, 0x7f, 0x31, 0xc0, 0x7a, 0x0f,
+	0x8d, 0x18, 0xf9, 0xdf, 0xfb, 0x79, 0x00, 0xca, 0x83, 0x51, 0xe4, 0x89, 0x67, 0xb5, 0x8f, 0x37,
+	0x77, 0x5a, 0x97, 0xbd, 0x8a, 0x50, 0x44, 0xfd, 0x80, 0x1c, 0x68, 0x80, 0x73, 0x06, 0xcd, 0xf4,
+	0xf6, 0xab, 0x01, 0xaa, 0x5f, 0x46, 0xf1, 0x35, 0xed, 0xee, 0x67, 0x00, 0x6e, 0x9e, 0x3f, 0x8f,
+	0x10, 0x82, 0xa5, 0x08, 0x85, 0x69, 0x6f, 0xf2, 0x19, 0x7e, 0x0e, 0xea, 0x9a, 0xdf, 0x8d, 0x29,
+	0x0d, 0x5c, 0x84, 0x71, 0x42, 0x18, 0x53, 0x3d, 0x76, 0xad, 0xb3, 0xdd, 0xcb, 0xab, 0xb2, 0x1d,
+	0xa8, 0xd3, 0x3d, 0x4a, 0x83, 0x3d, 0x95, 0x84, 0x9f, 0x81, 0x0d, 0x2e, 0xaf, 0xbc, 0xbc, 0x9d,
+	0x53, 0xc6, 0xa2, 0x64, 0x6c, 0x9c, 0x4e, 0xac, 0x6d, 0xc5, 0x98, 0x53, 0x64, 0x3b, 0x30, 0x93,
+	0x4d, 0x09, 0x7f, 0x33, 0x40, 0x9d, 0x71, 0xf4, 0x44, 0xc8, 0x0b, 0x6f, 0x71, 0xbf, 0x23, 0xfe,
+	0xf0, 0x70, 0x7a, 0x29, 0xde, 0x4d, 0x07, 0x25, 0x4c, 0x28, 0x73, 0x1c, 0xbd, 0x8f, 0xa9, 0x1f,
+	0x75, 0x9d, 0xf3, 0x87, 0x30, 0x8f, 0xc7, 0xfe, 0xfd, 0xb5, 0xf5, 0xc1, 0xd0, 0xe7, 0x87, 0xa3,
+	0x7e, 0xdb, 0xa3, 0xa1, 0x76, 0x38, 0xfd, 0x73, 0x8f, 0xe1, 0x27, 0x1d, 0x3e, 0x8e, 0x09, 0x4b,
+	0x29, 0x99, 0x03, 0x35, 0x8b, 0x88, 0xbe, 0x52, 0x1c, 0xf0, 0x6b, 0x00, 0x18, 0x47, 0x09, 0x77,
+	0x85, 0x6f, 0x99, 0xcb, 0x4d, 0xa3, 0x55, 0xd9, 0xd9, 0x6e, 0x2b, 0xcf, 0x6a, 0xa7, 0x9e, 0xd5,
+	0x7e, 0x9c, 0x9a, 0x5a, 0xf7, 0x3d, 0xdd, 0x57, 0x6d, 0xda, 0x97, 0xc6, 0xda, 0xcf, 0x5e, 0x5b,
+	0x86, 0x53, 0x96, 0x09, 0x51, 0x0e, 0x1d, 0xb0, 0x4a, 0x22, 0xac, 0x78, 0x57, 0xfe, 0x93, 0xf7,
+	0xb6, 0xe6, 0xad, 0x2a, 0xde, 0x14, 0xa9, 0x58, 0x4b, 0x24, 0xc2, 0x92, 0xf3, 0x47, 0x03, 0xac,
+	0x91, 0x98, 0x7a, 0x87, 0x2e, 0x0a, 0xe9, 0x28, 0xe2, 0x66, 0x49, 0x8e, 0x72, 0x2b, 0x77, 0x94,
+	0x72, 0x8e, 0x0f, 0x34, 0xef, 0x86, 0xe6, 0xcd, 0x80, 0xc5, 0xfc, 0x5a, 0x73, 0xcc, 0x4f, 0x0d,
+	0xaf, 0x22, 0xa1, 0x7b, 0x12, 0x09, 0x09, 0x50, 0xa1, 0x2b, 0xad, 0xdc, 0x5c, 0x95, 0x67, 0x64,
+	0x5f, 0x48, 0xfd, 0x3d, 0xb1, 0xee, 0xcc, 0xb7, 0x27, 0xa7, 0x13, 0x0b, 0x66, 0x9b, 0x92, 0x54,
+	0xb6, 0x03, 0x64, 0xe4, 0x88, 0x00, 0x1e, 0x82, 0x35, 0x4c, 0x3c, 0x34, 0x76, 0x07, 0xc8, 0xe3,
+	0x34, 0x31, 0xcb, 0x52, 0xe7, 0x93, 0x85, 0x75, 0x36, 0x52, 0x27, 0x3b, 0xe3, 0xb2, 0xc5, 0x45,
+	0xf6, 0xd0, 0xf8, 0x40, 0x46, 0x70, 0x37, 0x55, 0x8a, 0x49, 0xe2, 0x53, 0x6c, 0x82, 0xa6, 0xd1,
+	0x5a, 0xef, 0x6e, 0xce, 0x62, 0xd5, 0x6a, 0x8a, 0xed, 0xc9, 0x08, 0xfa, 0xe0, 0x9d, 0xa7, 0x84,
+	0x71, 0x71, 0x3c, 0xd3, 0x6f, 0x9b, 0x59, 0x91, 0x1b, 0xbe, 0x75, 0x61, 0xc3, 0xf7, 0x75, 0xc1,
+	0xd4, 0x64, 0x37, 0x15, 0xfd, 0x2c, 0x81, 0xfd, 0x5c, 0xec, 0x7b, 0x55, 0xa7, 0x53, 0x14, 0xfc,
+	0x1e, 0xd4, 0x43, 0x74, 0xe4, 0x8a, 0x73, 0x4c, 0x44, 0x33, 0xae, 0xb8, 0xc7, 0x24, 0x31, 0xd7,
+	0xe4, 0x60, 0x1e, 0x2d, 0x30, 0x98, 0x87, 0x11, 0xcf, 0x7c, 0x65, 0x72, 0x38, 0x6d, 0xa7, 0x16,
+	0xa2, 0xa3, 0x2f, 0x44, 0xb6, 0x47, 0x92, 0x03, 0x99, 0x83, 0x31, 0xa8, 0x8a, 0x5a, 0x4e, 0x39,
+	0x0a, 0x14, 0xc2, 0x5c, 0x97, 0xd2, 0x9f, 0x2e, 0x2c, 0x7d, 0xeb, 0x4c, 0x3a, 0x43, 0x67, 0x3b,
+	0xeb, 0x21, 0x3a, 0x7a, 0x2c, 0x12, 0x52, 0xda, 0xfe, 0xa3, 0x04, 0x6a, 0x17, 0xbe, 0x92, 0x70,
+	0x13, 0x94, 0xe4, 0xf7, 0xc8, 0xc7, 0xd2, 0x15, 0x97, 0x9c, 0x15, 0x11, 0x3e, 0xc4, 0x53, 0xaf,
+	0xbc, 0x31, 0x87, 0x57, 0x16, 0xdf, 0xb8, 0x57, 0x2e, 0xbd, 0x79, 0xaf, 0x5c, 0xbe, 0xb6, 0x5e,
+	0xb9, 0x32, 0x97, 0x57, 0x1a, 0x0b, 0x7b, 0x65, 0x69, 0x2e, 0xaf, 0x34, 0x16, 0xf7, 0xca, 0xd5,
+	0x6b, 0xe1, 0x95, 0xe5, 0xb7, 0xe4, 0x95, 0xe0, 0xad, 0x79, 0x65, 0x65, 0x7e, 0xaf, 0xb4, 0x3f,
+	0x04, 0xb5, 0x0b, 0x7f, 0x70, 0x5e, 0x7a, 0x9b, 0xbb, 0x0f, 0x5e, 0x1e, 0x37, 0x8c, 0x57, 0xc7,
+	0x0d, 0xe3, 0x9f, 0xe3, 0x86, 0xf1, 0xec, 0xa4, 0x51, 0x78, 0x75, 0xd2, 0x28, 0xfc, 0x75, 0xd2,
+	0x28, 0x7c, 0x73, 0x2f, 0xf3, 0x3e, 0x39, 0xff, 0xab, 0x1c, 0x4d, 0x9f, 0xe4, 0xab, 0xf5, 0x57,
+	0xe4, 0x29, 0xfa, 0xe8, 0xdf, 0x01, 0x00, 0xce, 0x93, 0xdc, 0x27, 0x6c, 0x0d, 0x00, 0x00,
 }
 
 func (m *PublicPlanProposal) Marshal() (dAtA []byte, err error) {
@@ -485,6 +628,116 @@ func (m *PublicPlanProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Functions) > 0 {
+		dAtA2 := make([]byte, len(m.Functions)*10)
+		var j1 int
+		for _, num := range m.Functions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintProposal(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Functions) > 0 {
+		dAtA4 := make([]byte, len(m.Functions)*10)
+		var j3 int
+		for _, num := range m.Functions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintProposal(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AddPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -505,6 +758,49 @@ func (m *AddPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTotalStake.Size()
+		i -= size
+		if _, err := m.MaxTotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxStakePerFarmer.Size()
+		i -= size
+		if _, err := m.MaxStakePerFarmer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintProposal(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x5a
+	if m.DecayPeriod != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -529,20 +825,20 @@ func (m *AddPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n1
-	i = encodeVarintProposal(dAtA, i, uint64(n1))
+	i -= n6
+	i = encodeVarintProposal(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n2
-	i = encodeVarintProposal(dAtA, i, uint64(n2))
+	i -= n7
+	i = encodeVarintProposal(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.StakingCoinWeights) > 0 {
@@ -603,6 +899,21 @@ func (m *ModifyPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecayPeriod != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -628,22 +939,22 @@ func (m *ModifyPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.EndTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
-		if err3 != nil {
-			return 0, err3
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n3
-		i = encodeVarintProposal(dAtA, i, uint64(n3))
+		i -= n8
+		i = encodeVarintProposal(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.StartTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err4 != nil {
-			return 0, err4
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n4
-		i = encodeVarintProposal(dAtA, i, uint64(n4))
+		i -= n9
+		i = encodeVarintProposal(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -764,6 +1075,54 @@ func (m *PublicPlanProposal) Size() (n int) {
 	return n
 }
 
+func (m *PauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Functions) > 0 {
+		l = 0
+		for _, e := range m.Functions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *UnpauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Functions) > 0 {
+		l = 0
+		for _, e := range m.Functions {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *AddPlanRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -800,6 +1159,17 @@ func (m *AddPlanRequest) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovProposal(uint64(m.DecayPeriod))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.MaxStakePerFarmer.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	l = m.MaxTotalStake.Size()
+	n += 1 + l + sovProposal(uint64(l))
 	return n
 }
 
@@ -846,6 +1216,11 @@ func (m *ModifyPlanRequest) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovProposal(uint64(m.DecayPeriod))
+	}
 	return n
 }
 
@@ -1083,7 +1458,7 @@ func (m *PublicPlanProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1106,15 +1481,15 @@ func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1142,11 +1517,11 @@ func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1174,29 +1549,395 @@ func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v PausableFunction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PausableFunction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Functions = append(m.Functions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				if elementCount != 0 && len(m.Functions) == 0 {
+					m.Functions = make([]PausableFunction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PausableFunction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PausableFunction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Functions = append(m.Functions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v PausableFunction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PausableFunction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Functions = append(m.Functions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Functions) == 0 {
+					m.Functions = make([]PausableFunction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PausableFunction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PausableFunction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Functions = append(m.Functions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
 			postIndex := iNdEx + intStringLen
@@ -1376,6 +2117,160 @@ func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			m.DecayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakePerFarmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakePerFarmer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -1715,6 +2610,59 @@ func (m *ModifyPlanRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			m.DecayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -114,6 +114,38 @@ func TestAddPlanRequest_Validate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"valid for decaying amount plan",
+			func(req *types.AddPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayPeriod = 10
+			},
+			"",
+		},
+		{
+			"decay factor without epoch amount",
+			func(req *types.AddPlanRequest) {
+				req.EpochAmount = nil
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayPeriod = 10
+			},
+			"decay factor must be provided with epoch amount: invalid request",
+		},
+		{
+			"invalid decay factor",
+			func(req *types.AddPlanRequest) {
+				req.DecayFactor = sdk.NewDec(2)
+				req.DecayPeriod = 10
+			},
+			"decay factor must be less than 1: 2.000000000000000000: invalid request",
+		},
+		{
+			"zero decay period",
+			func(req *types.AddPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+			},
+			"decay period must be positive: invalid request",
+		},
 		{
 			"invalid plan name",
 			func(req *types.AddPlanRequest) {
@@ -276,6 +308,30 @@ func TestModifyPlanRequest_Validate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"valid for decaying amount plan",
+			func(req *types.ModifyPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayPeriod = 10
+			},
+			"",
+		},
+		{
+			"decay factor without epoch amount",
+			func(req *types.ModifyPlanRequest) {
+				req.EpochAmount = nil
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayPeriod = 10
+			},
+			"decay factor must be provided with epoch amount: invalid request",
+		},
+		{
+			"zero decay period",
+			func(req *types.ModifyPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+			},
+			"decay period must be positive: invalid request",
+		},
 		{
 			"invalid plan name",
 			func(req *types.ModifyPlanRequest) {
@@ -491,3 +547,59 @@ func TestDeletePlanRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(*types.PauseProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(proposal *types.PauseProposal) {},
+			"",
+		},
+		{
+			"empty functions",
+			func(proposal *types.PauseProposal) {
+				proposal.Functions = []types.PausableFunction{}
+			},
+			"functions must not be empty: invalid request",
+		},
+		{
+			"invalid function",
+			func(proposal *types.PauseProposal) {
+				proposal.Functions = []types.PausableFunction{types.PausableFunctionNil}
+			},
+			"invalid function: PAUSABLE_FUNCTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"duplicate function",
+			func(proposal *types.PauseProposal) {
+				proposal.Functions = []types.PausableFunction{types.PausableFunctionStake, types.PausableFunctionStake}
+			},
+			"duplicate function: PAUSABLE_FUNCTION_STAKE: invalid request",
+		},
+		{
+			"empty title",
+			func(proposal *types.PauseProposal) {
+				proposal.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewPauseProposal("title", "description", []types.PausableFunction{
+				types.PausableFunctionStake,
+				types.PausableFunctionHarvest,
+			})
+			tc.malleate(proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
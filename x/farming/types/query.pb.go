@@ -13,21 +13,26 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -351,6 +356,8 @@ func (m *QueryPlanResponse) GetPlan() *types.Any {
 type QueryStakingsRequest struct {
 	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// pagination paginates the stakings by staking coin denom.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryStakingsRequest) Reset()         { *m = QueryStakingsRequest{} }
@@ -400,10 +407,19 @@ func (m *QueryStakingsRequest) GetStakingCoinDenom() string {
 	return ""
 }
 
+func (m *QueryStakingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryStakingsResponse is the response type for the Query/Stakings RPC method.
 type QueryStakingsResponse struct {
 	StakedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=staked_coins,json=stakedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staked_coins"`
 	QueuedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=queued_coins,json=queuedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"queued_coins"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryStakingsResponse) Reset()         { *m = QueryStakingsResponse{} }
@@ -453,6 +469,274 @@ func (m *QueryStakingsResponse) GetQueuedCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *QueryStakingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakingsByDenomRequest is the request type for the Query/StakingsByDenom RPC method.
+type QueryStakingsByDenomRequest struct {
+	StakingCoinDenom string             `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakingsByDenomRequest) Reset()         { *m = QueryStakingsByDenomRequest{} }
+func (m *QueryStakingsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsByDenomRequest) ProtoMessage()    {}
+func (*QueryStakingsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{8}
+}
+func (m *QueryStakingsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsByDenomRequest.Merge(m, src)
+}
+func (m *QueryStakingsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryStakingsByDenomRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+func (m *QueryStakingsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakingsByDenomResponse is the response type for the Query/StakingsByDenom RPC method.
+type QueryStakingsByDenomResponse struct {
+	Stakings []FarmerStaking `protobuf:"bytes,1,rep,name=stakings,proto3" json:"stakings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakingsByDenomResponse) Reset()         { *m = QueryStakingsByDenomResponse{} }
+func (m *QueryStakingsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsByDenomResponse) ProtoMessage()    {}
+func (*QueryStakingsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{9}
+}
+func (m *QueryStakingsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsByDenomResponse.Merge(m, src)
+}
+func (m *QueryStakingsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsByDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryStakingsByDenomResponse) GetStakings() []FarmerStaking {
+	if m != nil {
+		return m.Stakings
+	}
+	return nil
+}
+
+func (m *QueryStakingsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// FarmerStaking defines the staked and queued amounts of a farmer for a staking coin denom.
+type FarmerStaking struct {
+	Farmer       string                                 `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=staked_amount,json=stakedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked_amount"`
+	QueuedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=queued_amount,json=queuedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"queued_amount"`
+}
+
+func (m *FarmerStaking) Reset()         { *m = FarmerStaking{} }
+func (m *FarmerStaking) String() string { return proto.CompactTextString(m) }
+func (*FarmerStaking) ProtoMessage()    {}
+func (*FarmerStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{10}
+}
+func (m *FarmerStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FarmerStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FarmerStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FarmerStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FarmerStaking.Merge(m, src)
+}
+func (m *FarmerStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *FarmerStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_FarmerStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FarmerStaking proto.InternalMessageInfo
+
+func (m *FarmerStaking) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+// QueryQueuedStakingsRequest is the request type for the Query/QueuedStakings RPC method.
+type QueryQueuedStakingsRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+}
+
+func (m *QueryQueuedStakingsRequest) Reset()         { *m = QueryQueuedStakingsRequest{} }
+func (m *QueryQueuedStakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsRequest) ProtoMessage()    {}
+func (*QueryQueuedStakingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{11}
+}
+func (m *QueryQueuedStakingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedStakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsRequest.Merge(m, src)
+}
+func (m *QueryQueuedStakingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedStakingsRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedStakingsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+func (m *QueryQueuedStakingsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+// QueryQueuedStakingsResponse is the response type for the Query/QueuedStakings RPC method.
+type QueryQueuedStakingsResponse struct {
+	QueuedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=queued_coins,json=queuedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"queued_coins"`
+	// next_epoch_time is the estimated time when the queued coins become staked
+	NextEpochTime *time.Time `protobuf:"bytes,2,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
+}
+
+func (m *QueryQueuedStakingsResponse) Reset()         { *m = QueryQueuedStakingsResponse{} }
+func (m *QueryQueuedStakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsResponse) ProtoMessage()    {}
+func (*QueryQueuedStakingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{12}
+}
+func (m *QueryQueuedStakingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedStakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsResponse.Merge(m, src)
+}
+func (m *QueryQueuedStakingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedStakingsResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedStakingsResponse) GetQueuedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QueuedCoins
+	}
+	return nil
+}
+
+func (m *QueryQueuedStakingsResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return nil
+}
+
 // QueryTotalStakingsRequest is the request type for the Query/TotalStakings RPC method.
 type QueryTotalStakingsRequest struct {
 	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
@@ -462,7 +746,7 @@ func (m *QueryTotalStakingsRequest) Reset()         { *m = QueryTotalStakingsReq
 func (m *QueryTotalStakingsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalStakingsRequest) ProtoMessage()    {}
 func (*QueryTotalStakingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{8}
+	return fileDescriptor_00c8db58c274b111, []int{13}
 }
 func (m *QueryTotalStakingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +791,7 @@ func (m *QueryTotalStakingsResponse) Reset()         { *m = QueryTotalStakingsRe
 func (m *QueryTotalStakingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalStakingsResponse) ProtoMessage()    {}
 func (*QueryTotalStakingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{9}
+	return fileDescriptor_00c8db58c274b111, []int{14}
 }
 func (m *QueryTotalStakingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,13 +824,15 @@ var xxx_messageInfo_QueryTotalStakingsResponse proto.InternalMessageInfo
 type QueryRewardsRequest struct {
 	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// pagination paginates the rewards by staking coin denom.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
 func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsRequest) ProtoMessage()    {}
 func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{10}
+	return fileDescriptor_00c8db58c274b111, []int{15}
 }
 func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,16 +875,27 @@ func (m *QueryRewardsRequest) GetStakingCoinDenom() string {
 	return ""
 }
 
+func (m *QueryRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryRewardsResponse is the response type for the Query/Rewards RPC method.
 type QueryRewardsResponse struct {
 	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// plan_rewards is the breakdown of the rewards by plan and staking coin denom
+	PlanRewards []PlanRewards `protobuf:"bytes,2,rep,name=plan_rewards,json=planRewards,proto3" json:"plan_rewards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
 func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsResponse) ProtoMessage()    {}
 func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{11}
+	return fileDescriptor_00c8db58c274b111, []int{16}
 }
 func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,22 +931,39 @@ func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
-// QueryCurrentEpochDaysRequest is the request type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysRequest struct {
+func (m *QueryRewardsResponse) GetPlanRewards() []PlanRewards {
+	if m != nil {
+		return m.PlanRewards
+	}
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) Reset()         { *m = QueryCurrentEpochDaysRequest{} }
-func (m *QueryCurrentEpochDaysRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysRequest) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{12}
+func (m *QueryRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Unmarshal(b []byte) error {
+
+// QueryLocksRequest is the request type for the Query/Locks RPC method.
+type QueryLocksRequest struct {
+	Farmer           string             `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string             `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLocksRequest) Reset()         { *m = QueryLocksRequest{} }
+func (m *QueryLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLocksRequest) ProtoMessage()    {}
+func (*QueryLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{17}
+}
+func (m *QueryLocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLocksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -659,35 +973,58 @@ func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.Merge(m, src)
+func (m *QueryLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLocksRequest.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Size() int {
+func (m *QueryLocksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.DiscardUnknown(m)
+func (m *QueryLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLocksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLocksRequest proto.InternalMessageInfo
 
-// QuerCurrentEpochDaysResponse is the response type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysResponse struct {
-	CurrentEpochDays uint32 `protobuf:"varint,1,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+func (m *QueryLocksRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
 }
 
-func (m *QueryCurrentEpochDaysResponse) Reset()         { *m = QueryCurrentEpochDaysResponse{} }
-func (m *QueryCurrentEpochDaysResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysResponse) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00c8db58c274b111, []int{13}
+func (m *QueryLocksRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLocksResponse is the response type for the Query/Locks RPC method.
+type QueryLocksResponse struct {
+	Locks []Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLocksResponse) Reset()         { *m = QueryLocksResponse{} }
+func (m *QueryLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLocksResponse) ProtoMessage()    {}
+func (*QueryLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{18}
+}
+func (m *QueryLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLocksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -697,1204 +1034,10327 @@ func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.Merge(m, src)
+func (m *QueryLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLocksResponse.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Size() int {
+func (m *QueryLocksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.DiscardUnknown(m)
+func (m *QueryLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLocksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLocksResponse proto.InternalMessageInfo
 
-func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDays() uint32 {
+func (m *QueryLocksResponse) GetLocks() []Lock {
 	if m != nil {
-		return m.CurrentEpochDays
+		return m.Locks
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
-	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
-	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
-	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
-	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
-	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
-	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
-	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
-	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
-	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
-	proto.RegisterType((*QueryCurrentEpochDaysRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysRequest")
-	proto.RegisterType((*QueryCurrentEpochDaysResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysResponse")
+func (m *QueryLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+// QueryUnbondingsRequest is the request type for the Query/Unbondings RPC method.
+type QueryUnbondingsRequest struct {
+	Farmer           string             `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string             `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var fileDescriptor_00c8db58c274b111 = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6c, 0x14, 0x47,
-	0x1a, 0xf6, 0x3c, 0x6c, 0x96, 0xf2, 0x22, 0x79, 0x0b, 0xc3, 0x9a, 0x16, 0x8c, 0x4b, 0xbd, 0x12,
-	0xd8, 0xc6, 0xd3, 0x6d, 0x1b, 0xac, 0xdd, 0x35, 0xcb, 0x61, 0x0c, 0x36, 0x98, 0x05, 0xe4, 0x1d,
-	0xb8, 0x2c, 0xb0, 0x9a, 0xad, 0xe9, 0x2e, 0x8f, 0x3b, 0xf4, 0x54, 0x35, 0xdd, 0xd5, 0x86, 0x11,
-	0x71, 0x5e, 0x8a, 0x38, 0x24, 0x97, 0xc4, 0xe4, 0x1c, 0xe5, 0x9a, 0xc7, 0x31, 0xb7, 0x9c, 0x23,
-	0x21, 0x0e, 0x11, 0x51, 0x24, 0x84, 0x72, 0x20, 0x09, 0xce, 0x3d, 0xb9, 0x91, 0x63, 0x54, 0x8f,
-	0x1e, 0xcf, 0x8c, 0x67, 0xc6, 0xb6, 0x1c, 0x24, 0x9f, 0x3c, 0xdd, 0xf5, 0xd7, 0xff, 0x7f, 0xf5,
-	0x7d, 0x5f, 0x57, 0xfd, 0x65, 0x70, 0x9c, 0x13, 0xea, 0x92, 0xb0, 0xea, 0x51, 0x6e, 0x2f, 0x61,
-	0xf1, 0xb7, 0x62, 0xaf, 0x4c, 0x96, 0x09, 0xc7, 0x93, 0xf6, 0x9d, 0x98, 0x84, 0x35, 0x2b, 0x08,
-	0x19, 0x67, 0xf0, 0xb0, 0xc3, 0xa2, 0x2a, 0x8b, 0x2c, 0x1d, 0x63, 0xe9, 0x18, 0x63, 0xa4, 0xcb,
-	0xfc, 0x24, 0x56, 0x66, 0x30, 0x8e, 0xa8, 0x0c, 0x25, 0xf9, 0x64, 0xeb, 0x74, 0x6a, 0x68, 0x4c,
-	0x3d, 0xd9, 0x65, 0x1c, 0x11, 0x55, 0xb5, 0x9e, 0x23, 0xc0, 0x15, 0x8f, 0x62, 0xee, 0x31, 0xaa,
-	0x63, 0x73, 0x8d, 0xb1, 0x49, 0x94, 0xc3, 0xbc, 0x64, 0x7c, 0xb0, 0xc2, 0x2a, 0x4c, 0xd5, 0x10,
-	0xbf, 0x92, 0xe2, 0x15, 0xc6, 0x2a, 0x3e, 0xb1, 0xe5, 0x53, 0x39, 0x5e, 0xb2, 0x31, 0xd5, 0x2b,
-	0x33, 0x8e, 0xea, 0x21, 0x1c, 0x78, 0x36, 0xa6, 0x94, 0x71, 0x59, 0x2d, 0x81, 0xa6, 0xfe, 0x38,
-	0xf9, 0x0a, 0xa1, 0x79, 0x16, 0x10, 0x8a, 0x03, 0x6f, 0x65, 0xca, 0x66, 0x81, 0x8c, 0xd9, 0x1c,
-	0x6f, 0x0e, 0x02, 0xf8, 0x1f, 0xb1, 0x80, 0x45, 0x1c, 0xe2, 0x6a, 0x54, 0x24, 0x77, 0x62, 0x12,
-	0x71, 0xf3, 0x1a, 0x38, 0xd8, 0xf4, 0x36, 0x0a, 0x18, 0x8d, 0x08, 0xfc, 0x17, 0xe8, 0x0b, 0xe4,
-	0x9b, 0xa1, 0x14, 0x4a, 0x8d, 0xf4, 0x4f, 0xe5, 0xac, 0xf6, 0x2c, 0x5b, 0x6a, 0xde, 0x6c, 0xf6,
-	0xd1, 0xf3, 0xe1, 0x9e, 0xa2, 0x9e, 0x63, 0x7e, 0x92, 0x06, 0x7f, 0x51, 0x59, 0x7d, 0x4c, 0x93,
-	0x52, 0x10, 0x82, 0x2c, 0xaf, 0x05, 0x44, 0x66, 0xdc, 0x5f, 0x94, 0xbf, 0xe1, 0x04, 0x18, 0xd4,
-	0x19, 0x4b, 0x01, 0x63, 0x7e, 0x09, 0xbb, 0x6e, 0x48, 0xa2, 0x68, 0x28, 0x2d, 0x63, 0xa0, 0x1e,
-	0x5b, 0x64, 0xcc, 0x2f, 0xa8, 0x11, 0x68, 0x83, 0x83, 0x5c, 0xaa, 0x2a, 0x17, 0x57, 0x9f, 0x90,
-	0x51, 0x13, 0x1a, 0x86, 0x92, 0x09, 0xe3, 0x00, 0x46, 0x1c, 0xdf, 0x16, 0x25, 0x84, 0x18, 0x25,
-	0x97, 0x50, 0x56, 0x1d, 0xca, 0xca, 0xf8, 0x01, 0x3d, 0x72, 0x8e, 0x79, 0xf4, 0xbc, 0x78, 0x0f,
-	0x73, 0x00, 0x24, 0x39, 0x88, 0x3b, 0xd4, 0x2b, 0xa3, 0x1a, 0xde, 0xc0, 0x79, 0x00, 0x36, 0x84,
-	0x1f, 0xea, 0x93, 0xe4, 0x1c, 0x4f, 0xc8, 0x11, 0xca, 0x5b, 0xca, 0x9b, 0x1b, 0xfc, 0x54, 0x88,
-	0x26, 0xa0, 0xd8, 0x30, 0xd3, 0xfc, 0x28, 0x95, 0xc8, 0xa1, 0x28, 0xd2, 0xbc, 0x4f, 0x83, 0xde,
-	0x40, 0xbc, 0x18, 0x4a, 0xa1, 0xcc, 0x48, 0xff, 0xd4, 0xa0, 0xa5, 0x2c, 0x60, 0x25, 0xee, 0xb0,
-	0x0a, 0xb4, 0x36, 0xbb, 0xff, 0xf1, 0x97, 0xf9, 0x5e, 0x31, 0x6f, 0xa1, 0xa8, 0xa2, 0xe1, 0x85,
-	0x26, 0x54, 0x69, 0x89, 0xea, 0xc4, 0x96, 0xa8, 0x54, 0xcd, 0x26, 0x58, 0x27, 0xc1, 0x40, 0x1d,
-	0x55, 0xa2, 0xdb, 0x5f, 0xc1, 0x3e, 0x51, 0xa5, 0xe4, 0xb9, 0x52, 0xba, 0x6c, 0xb1, 0x4f, 0x3c,
-	0x2e, 0xb8, 0xe6, 0xc5, 0x06, 0x95, 0xeb, 0x2b, 0x38, 0x05, 0xb2, 0x62, 0x58, 0xfb, 0x66, 0xcb,
-	0x05, 0xc8, 0x60, 0xf3, 0x16, 0x18, 0x94, 0x99, 0xae, 0x29, 0x39, 0xea, 0x96, 0x39, 0x0c, 0xfa,
-	0x84, 0x05, 0x48, 0xa8, 0x4d, 0xa3, 0x9f, 0x3a, 0x68, 0x9a, 0x6e, 0xaf, 0xa9, 0xf9, 0x32, 0x05,
-	0x0e, 0xb5, 0xa4, 0xd7, 0x60, 0x29, 0xf8, 0xb3, 0x88, 0x26, 0xae, 0x4c, 0x93, 0xb0, 0x7e, 0xa4,
-	0x89, 0xb9, 0x84, 0x33, 0x91, 0x6f, 0x76, 0x42, 0xf8, 0xfc, 0xb3, 0x1f, 0x86, 0x47, 0x2a, 0x1e,
-	0x5f, 0x8e, 0xcb, 0x96, 0xc3, 0xaa, 0x7a, 0xc3, 0xd0, 0x7f, 0xf2, 0x91, 0x7b, 0xdb, 0x16, 0xd6,
-	0x8e, 0xe4, 0x84, 0xa8, 0xd8, 0xaf, 0x0a, 0xc8, 0x07, 0x51, 0xef, 0x4e, 0x4c, 0xe2, 0x7a, 0xbd,
-	0xf4, 0x2b, 0xa8, 0xa7, 0x0a, 0xc8, 0x07, 0x73, 0x01, 0x1c, 0x91, 0x0b, 0xbf, 0xce, 0x38, 0xf6,
-	0x5b, 0xc9, 0x6d, 0x4f, 0x62, 0xaa, 0x03, 0x89, 0x2e, 0x30, 0xda, 0xa5, 0xd2, 0x44, 0xce, 0x83,
-	0x3e, 0x5c, 0x65, 0x31, 0xe5, 0x6a, 0xfe, 0xac, 0x25, 0x70, 0x7f, 0xff, 0x7c, 0xf8, 0xf8, 0x36,
-	0x70, 0x2f, 0x50, 0x5e, 0xd4, 0xb3, 0xcd, 0x9b, 0x7a, 0x3b, 0x2a, 0x92, 0xbb, 0x38, 0x74, 0xff,
-	0x60, 0x1f, 0xac, 0x6a, 0x97, 0xd5, 0x93, 0x6b, 0xf0, 0x04, 0xec, 0x0b, 0xd5, 0xab, 0x57, 0x61,
-	0x80, 0x24, 0xb7, 0x99, 0x03, 0x47, 0x65, 0xf9, 0x73, 0x71, 0x18, 0x12, 0xca, 0xe7, 0x02, 0xe6,
-	0x2c, 0x9f, 0xc7, 0xb5, 0xfa, 0x56, 0x7c, 0x05, 0x1c, 0xeb, 0x30, 0xae, 0x71, 0x8e, 0x03, 0xe8,
-	0xa8, 0xb1, 0x12, 0x11, 0x83, 0x25, 0x17, 0xd7, 0xd4, 0x06, 0x7d, 0xa0, 0x38, 0xe0, 0xb4, 0xcc,
-	0x9a, 0xfa, 0xf4, 0x28, 0xe8, 0x95, 0xf9, 0xe0, 0x17, 0x69, 0xd0, 0xa7, 0xf6, 0x69, 0x38, 0xd6,
-	0x69, 0x1f, 0xdf, 0x7c, 0x34, 0x18, 0x27, 0xb7, 0x15, 0xab, 0xb0, 0x99, 0x8f, 0x52, 0x6b, 0x85,
-	0x8f, 0x53, 0x46, 0xbe, 0x48, 0x78, 0x1c, 0xd2, 0x08, 0x61, 0xdf, 0x47, 0xf2, 0x34, 0x20, 0x9c,
-	0x84, 0x11, 0x62, 0x4b, 0x88, 0x2f, 0x13, 0xa4, 0x33, 0xa1, 0x2a, 0x73, 0x63, 0x9f, 0x58, 0x66,
-	0x15, 0xe4, 0xe6, 0x3d, 0xea, 0x22, 0x16, 0x73, 0x54, 0x65, 0x21, 0x41, 0xb8, 0x2c, 0x7e, 0x8a,
-	0x50, 0x75, 0x94, 0xc0, 0x7f, 0x2f, 0x73, 0x1e, 0x44, 0x33, 0xb6, 0xdd, 0xc0, 0x77, 0x9b, 0x83,
-	0xbd, 0xec, 0xb3, 0xb2, 0x5d, 0xc5, 0x1e, 0xb5, 0xef, 0xd5, 0xdf, 0x45, 0x01, 0x71, 0xec, 0x89,
-	0xbf, 0x97, 0x54, 0x26, 0xab, 0xea, 0xbe, 0xf3, 0xdd, 0xcf, 0x0f, 0xd3, 0x08, 0xe6, 0x12, 0xc1,
-	0x5a, 0xbb, 0x02, 0x5d, 0xf2, 0x59, 0x16, 0xc8, 0xcd, 0x29, 0x82, 0xa3, 0xdd, 0x19, 0x68, 0x38,
-	0xdc, 0x8c, 0xb1, 0xed, 0x84, 0x6a, 0xae, 0x5e, 0x66, 0xd6, 0x0a, 0xdf, 0x64, 0x8c, 0x33, 0x75,
-	0xae, 0x90, 0xef, 0x45, 0x5c, 0x70, 0x24, 0x58, 0x4b, 0x38, 0x92, 0x3b, 0x3b, 0xba, 0xeb, 0xf1,
-	0x65, 0xb4, 0xb1, 0x41, 0xa3, 0x90, 0x44, 0xb1, 0xcf, 0x2d, 0x73, 0x05, 0xe4, 0x3b, 0x31, 0x27,
-	0xb7, 0x7a, 0x84, 0xa9, 0x8b, 0x48, 0x18, 0xb2, 0x10, 0x39, 0xcc, 0x25, 0x11, 0x9c, 0xdb, 0x1e,
-	0x91, 0x3c, 0x24, 0x44, 0x11, 0xe9, 0x32, 0x27, 0xb2, 0x2f, 0xb2, 0xbb, 0xf9, 0xeb, 0xcc, 0x76,
-	0x7c, 0xef, 0x6f, 0x72, 0x0d, 0x97, 0x1e, 0xa6, 0x40, 0xe6, 0xf4, 0xc4, 0x04, 0x7c, 0x3f, 0x05,
-	0xfa, 0x67, 0xb1, 0x8b, 0x12, 0xf3, 0xbe, 0x0e, 0x06, 0x70, 0x10, 0xf8, 0x9e, 0x23, 0x61, 0xda,
-	0xaf, 0x45, 0x8c, 0xc2, 0xe5, 0xfb, 0xa6, 0xa8, 0x6d, 0xce, 0x9c, 0x1a, 0x37, 0xab, 0x24, 0x8a,
-	0x70, 0x85, 0x98, 0x33, 0x66, 0x18, 0x38, 0x0a, 0xd8, 0x8c, 0x44, 0x86, 0xce, 0xa2, 0x05, 0xba,
-	0x82, 0x7d, 0xcf, 0x2d, 0x84, 0x95, 0xb8, 0x4a, 0x28, 0x47, 0x2e, 0x89, 0x1c, 0x74, 0x16, 0x79,
-	0xea, 0xb5, 0x24, 0x02, 0x89, 0x2f, 0x0a, 0x2d, 0x5e, 0x2e, 0x5c, 0x2d, 0x5d, 0xff, 0xef, 0xe2,
-	0x9c, 0x39, 0x6e, 0xba, 0x84, 0x63, 0xcf, 0x8f, 0xcc, 0x99, 0x9b, 0xff, 0x5b, 0xbd, 0xf4, 0x56,
-	0x0a, 0x64, 0xa6, 0x27, 0x26, 0x60, 0x0d, 0x1c, 0x5a, 0xa0, 0x9c, 0x84, 0x14, 0xfb, 0xe8, 0x1a,
-	0x09, 0x57, 0x48, 0x88, 0xe6, 0x44, 0x29, 0xf3, 0xff, 0x6d, 0xe0, 0x5d, 0x4e, 0xe0, 0x4d, 0x6e,
-	0x89, 0x4f, 0xa7, 0xd4, 0xc0, 0xe4, 0x68, 0x0b, 0x04, 0xe9, 0xad, 0x61, 0x78, 0xac, 0xa3, 0xb7,
-	0xa4, 0xa1, 0x9e, 0xf6, 0x82, 0xac, 0xe0, 0x11, 0x8e, 0x6c, 0x69, 0x97, 0xc4, 0x58, 0xa3, 0xdb,
-	0x88, 0xd4, 0xbe, 0xfa, 0x2d, 0xbb, 0x56, 0xf8, 0x3a, 0x6b, 0xfc, 0x33, 0xf1, 0x55, 0xe3, 0x17,
-	0xa7, 0x48, 0x5c, 0xc6, 0x1c, 0x39, 0x2c, 0x0c, 0xe5, 0x0c, 0x37, 0x42, 0x9c, 0xa9, 0x6f, 0x4d,
-	0x1d, 0xef, 0x96, 0x19, 0xef, 0xd4, 0x55, 0xe7, 0x77, 0xeb, 0x2a, 0x51, 0xfa, 0xd2, 0xbb, 0xda,
-	0x54, 0xab, 0xcd, 0x9e, 0xa2, 0x6d, 0x44, 0xbb, 0xb1, 0x3b, 0x4f, 0x91, 0x6a, 0xc0, 0x6b, 0x28,
-	0xd4, 0x05, 0x5a, 0x5c, 0xf4, 0x40, 0xc2, 0x38, 0x0d, 0xdf, 0x6c, 0x86, 0x11, 0xb4, 0x81, 0x71,
-	0x2b, 0x81, 0x31, 0xdd, 0x1d, 0xc6, 0x55, 0xc6, 0xe7, 0x59, 0x4c, 0xdd, 0xa4, 0xbe, 0x94, 0x41,
-	0xd3, 0x8d, 0x28, 0xe3, 0x68, 0x49, 0x8c, 0xee, 0x51, 0x3b, 0x8f, 0xc2, 0x13, 0x5d, 0xed, 0x6c,
-	0xdf, 0xd7, 0x2b, 0x59, 0x85, 0xbf, 0x66, 0xc0, 0x9f, 0x92, 0xa6, 0x00, 0x8e, 0x77, 0xb5, 0x6c,
-	0x4b, 0x1b, 0x62, 0xe4, 0xb7, 0x19, 0xad, 0x4d, 0xfe, 0x20, 0xb3, 0x56, 0xf8, 0x36, 0x6d, 0x5c,
-	0x69, 0x3c, 0x68, 0xf4, 0x49, 0x1f, 0xa1, 0x11, 0xd5, 0x6c, 0x49, 0x9b, 0xaa, 0x3e, 0x08, 0xc9,
-	0x46, 0x6b, 0xb4, 0xa3, 0xf5, 0x55, 0x23, 0x61, 0xd6, 0x76, 0x6a, 0xfc, 0x8b, 0xbb, 0x35, 0x7e,
-	0x82, 0x79, 0x8f, 0x98, 0x5f, 0x0a, 0x7e, 0x12, 0x8e, 0x76, 0x12, 0x3c, 0x81, 0x6b, 0xdf, 0x57,
-	0x8c, 0xad, 0xc2, 0xf7, 0xb2, 0xe0, 0x40, 0x53, 0x33, 0x08, 0x27, 0xbb, 0x2a, 0xd9, 0xae, 0x07,
-	0x35, 0xa6, 0x76, 0x32, 0x45, 0x3b, 0xe0, 0xc3, 0xcc, 0x5a, 0xe1, 0x71, 0xda, 0x28, 0xd4, 0xb7,
-	0x39, 0x11, 0xb5, 0xe1, 0x81, 0x4e, 0x4a, 0x6f, 0x6e, 0x14, 0xcd, 0x37, 0x76, 0xaa, 0xfa, 0x95,
-	0xdd, 0xaa, 0x2e, 0xb1, 0xee, 0x45, 0xe9, 0xcf, 0xc2, 0x33, 0x9d, 0xa4, 0x97, 0x98, 0x4b, 0x1b,
-	0x06, 0xd8, 0x4c, 0xe4, 0x2a, 0x7c, 0x9a, 0x01, 0xfb, 0x74, 0x5b, 0x0d, 0xbb, 0xf7, 0x8d, 0xcd,
-	0x9d, 0xbd, 0x31, 0xbe, 0xbd, 0x60, 0x2d, 0xfd, 0x2f, 0xe9, 0xb5, 0xc2, 0x57, 0x69, 0xe3, 0x1f,
-	0x8d, 0x1f, 0xbf, 0x6e, 0xaf, 0xd5, 0x87, 0xbe, 0xd5, 0x77, 0x7e, 0x6f, 0xa7, 0x8a, 0x5f, 0xd8,
-	0xad, 0xe2, 0x1a, 0xde, 0x5e, 0xd2, 0x7a, 0x0c, 0x8e, 0x74, 0xd2, 0x5a, 0xa3, 0xdd, 0xf8, 0xca,
-	0xd7, 0x33, 0x60, 0xa0, 0xf5, 0x42, 0x02, 0x4f, 0x77, 0x15, 0xad, 0xc3, 0xfd, 0xc6, 0x98, 0xde,
-	0xe1, 0x2c, 0xad, 0xf9, 0x4f, 0xe9, 0xb5, 0xc2, 0xe7, 0x69, 0x23, 0xd7, 0xd8, 0xd5, 0xe8, 0xcb,
-	0x0e, 0x92, 0xd7, 0x20, 0x24, 0xae, 0x41, 0xe6, 0xdb, 0xa9, 0x9d, 0x4a, 0xbb, 0xb8, 0x5b, 0x69,
-	0x35, 0x0a, 0x09, 0x42, 0x60, 0xd8, 0x4b, 0x1a, 0x8f, 0xc3, 0xb1, 0x4e, 0x1a, 0x6f, 0xbe, 0x43,
-	0xce, 0x5e, 0x78, 0xf4, 0x22, 0x97, 0x7a, 0xf2, 0x22, 0x97, 0xfa, 0xf1, 0x45, 0x2e, 0xf5, 0xc1,
-	0x7a, 0xae, 0xe7, 0xc9, 0x7a, 0xae, 0xe7, 0xd9, 0x7a, 0xae, 0xe7, 0x46, 0xbe, 0x3b, 0x39, 0x1b,
-	0xb7, 0x2d, 0x79, 0xe5, 0x2d, 0xf7, 0xc9, 0xff, 0xf3, 0x9c, 0xfa, 0x3d, 0x00, 0x00, 0xff, 0xff,
-	0x83, 0xbd, 0x86, 0x7b, 0xbd, 0x15, 0x00, 0x00,
+func (m *QueryUnbondingsRequest) Reset()         { *m = QueryUnbondingsRequest{} }
+func (m *QueryUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsRequest) ProtoMessage()    {}
+func (*QueryUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{19}
+}
+func (m *QueryUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryUnbondingsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryUnbondingsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the farming module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
-	// Stakings returns all stakings by a farmer.
-	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
-	// TotalStakings returns total staking amount for a staking coin denom
-	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
-	// Rewards returns rewards for a farmer
-	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error)
+func (m *QueryUnbondingsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryUnbondingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryUnbondingsResponse is the response type for the Query/Unbondings RPC method.
+type QueryUnbondingsResponse struct {
+	Unbondings []Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingsResponse) Reset()         { *m = QueryUnbondingsResponse{} }
+func (m *QueryUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsResponse) ProtoMessage()    {}
+func (*QueryUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{20}
+}
+func (m *QueryUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
-	out := new(QueryPlansResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingsResponse) GetUnbondings() []Unbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
-	out := new(QueryPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
-	out := new(QueryStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryVestedRewardsRequest is the request type for the Query/VestedRewards RPC method.
+type QueryVestedRewardsRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+}
+
+func (m *QueryVestedRewardsRequest) Reset()         { *m = QueryVestedRewardsRequest{} }
+func (m *QueryVestedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestedRewardsRequest) ProtoMessage()    {}
+func (*QueryVestedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{21}
+}
+func (m *QueryVestedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVestedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestedRewardsRequest.Merge(m, src)
+}
+func (m *QueryVestedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestedRewardsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
-	out := new(QueryTotalStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryVestedRewardsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
-	out := new(QueryRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryVestedRewardsResponse is the response type for the Query/VestedRewards RPC method.
+type QueryVestedRewardsResponse struct {
+	Vestings []RewardVesting `protobuf:"bytes,1,rep,name=vestings,proto3" json:"vestings"`
+	// vested is the amount of rewards that can be claimed now
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unvested is the amount of rewards that are still locked
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+}
+
+func (m *QueryVestedRewardsResponse) Reset()         { *m = QueryVestedRewardsResponse{} }
+func (m *QueryVestedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestedRewardsResponse) ProtoMessage()    {}
+func (*QueryVestedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{22}
+}
+func (m *QueryVestedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVestedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestedRewardsResponse.Merge(m, src)
+}
+func (m *QueryVestedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestedRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error) {
-	out := new(QueryCurrentEpochDaysResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDays", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryVestedRewardsResponse) GetVestings() []RewardVesting {
+	if m != nil {
+		return m.Vestings
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the farming module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
-	// Stakings returns all stakings by a farmer.
-	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
-	// TotalStakings returns total staking amount for a staking coin denom
-	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
-	// Rewards returns rewards for a farmer
-	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(context.Context, *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error)
+func (m *QueryVestedRewardsResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVestedRewardsResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryAutoCompoundRequest is the request type for the Query/AutoCompound RPC method.
+type QueryAutoCompoundRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
-func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+
+func (m *QueryAutoCompoundRequest) Reset()         { *m = QueryAutoCompoundRequest{} }
+func (m *QueryAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{23}
 }
-func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+func (m *QueryAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+func (m *QueryAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+func (m *QueryAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+func (m *QueryAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CurrentEpochDays(ctx context.Context, req *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDays not implemented")
+func (m *QueryAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAutoCompoundRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAutoCompoundRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAutoCompoundResponse is the response type for the Query/AutoCompound RPC method.
+type QueryAutoCompoundResponse struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+func (m *QueryAutoCompoundResponse) Reset()         { *m = QueryAutoCompoundResponse{} }
+func (m *QueryAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{24}
+}
+func (m *QueryAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundResponse.DiscardUnknown(m)
 }
 
-func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+var xxx_messageInfo_QueryAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalStakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRewardsWithdrawAddressRequest is the request type for the Query/RewardsWithdrawAddress RPC method.
+type QueryRewardsWithdrawAddressRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Rewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+func (m *QueryRewardsWithdrawAddressRequest) Reset()         { *m = QueryRewardsWithdrawAddressRequest{} }
+func (m *QueryRewardsWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryRewardsWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{25}
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_CurrentEpochDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochDaysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpochDays(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDays",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpochDays(ctx, req.(*QueryCurrentEpochDaysRequest))
+var xxx_messageInfo_QueryRewardsWithdrawAddressRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsWithdrawAddressRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.farming.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Plans",
-			Handler:    _Query_Plans_Handler,
-		},
-		{
-			MethodName: "Plan",
-			Handler:    _Query_Plan_Handler,
-		},
-		{
-			MethodName: "Stakings",
-			Handler:    _Query_Stakings_Handler,
-		},
-		{
-			MethodName: "TotalStakings",
-			Handler:    _Query_TotalStakings_Handler,
-		},
-		{
-			MethodName: "Rewards",
-			Handler:    _Query_Rewards_Handler,
-		},
-		{
-			MethodName: "CurrentEpochDays",
-			Handler:    _Query_CurrentEpochDays_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/farming/v1beta1/query.proto",
+// QueryRewardsWithdrawAddressResponse is the response type for the Query/RewardsWithdrawAddress RPC method.
+type QueryRewardsWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsWithdrawAddressResponse) Reset()         { *m = QueryRewardsWithdrawAddressResponse{} }
+func (m *QueryRewardsWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryRewardsWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{26}
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsWithdrawAddressResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHistoricalRewardsRequest is the request type for the Query/HistoricalRewards RPC method.
+type QueryHistoricalRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// start_epoch is the first epoch to query, inclusive
+	StartEpoch uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch to query, inclusive; zero means no upper bound
+	EndEpoch   uint64             `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryHistoricalRewardsRequest) Reset()         { *m = QueryHistoricalRewardsRequest{} }
+func (m *QueryHistoricalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRewardsRequest) ProtoMessage()    {}
+func (*QueryHistoricalRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{27}
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRewardsRequest.Merge(m, src)
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoricalRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHistoricalRewardsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Terminated) > 0 {
-		i -= len(m.Terminated)
-		copy(dAtA[i:], m.Terminated)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TerminationAddress) > 0 {
-		i -= len(m.TerminationAddress)
-		copy(dAtA[i:], m.TerminationAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FarmingPoolAddress) > 0 {
-		i -= len(m.FarmingPoolAddress)
-		copy(dAtA[i:], m.FarmingPoolAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHistoricalRewardsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricalRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHistoricalRewardsResponse is the response type for the Query/HistoricalRewards RPC method.
+type QueryHistoricalRewardsResponse struct {
+	HistoricalRewards []HistoricalRewardsResponse `protobuf:"bytes,1,rep,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHistoricalRewardsResponse) Reset()         { *m = QueryHistoricalRewardsResponse{} }
+func (m *QueryHistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRewardsResponse) ProtoMessage()    {}
+func (*QueryHistoricalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{28}
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRewardsResponse.Merge(m, src)
 }
-
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHistoricalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryHistoricalRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalRewardsResponse) GetHistoricalRewards() []HistoricalRewardsResponse {
+	if m != nil {
+		return m.HistoricalRewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricalRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HistoricalRewardsResponse represents the cumulative unit rewards of an epoch.
+type HistoricalRewardsResponse struct {
+	Epoch                 uint64                                      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards"`
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *HistoricalRewardsResponse) Reset()         { *m = HistoricalRewardsResponse{} }
+func (m *HistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoricalRewardsResponse) ProtoMessage()    {}
+func (*HistoricalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{29}
+}
+func (m *HistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalRewardsResponse.Merge(m, src)
+}
+func (m *HistoricalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HistoricalRewardsResponse proto.InternalMessageInfo
+
+func (m *HistoricalRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRewardsResponse) GetCumulativeUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeUnitRewards
+	}
+	return nil
 }
 
-func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+// QueryOutstandingRewardsRequest is the request type for the Query/OutstandingRewards RPC method.
+type QueryOutstandingRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+}
+
+func (m *QueryOutstandingRewardsRequest) Reset()         { *m = QueryOutstandingRewardsRequest{} }
+func (m *QueryOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsRequest) ProtoMessage()    {}
+func (*QueryOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{30}
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutstandingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOutstandingRewardsResponse is the response type for the Query/OutstandingRewards RPC method.
+type QueryOutstandingRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueuedCoins) > 0 {
-		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.StakedCoins) > 0 {
-		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOutstandingRewardsResponse) Reset()         { *m = QueryOutstandingRewardsResponse{} }
+func (m *QueryOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsResponse) ProtoMessage()    {}
+func (*QueryOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{31}
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutstandingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCurrentEpochRequest is the request type for the Query/CurrentEpoch RPC method.
+type QueryCurrentEpochRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochRequest{} }
+func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{32}
+}
+func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCurrentEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentEpochRequest proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCurrentEpochResponse is the response type for the Query/CurrentEpoch RPC method.
+type QueryCurrentEpochResponse struct {
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
 }
 
-func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
+func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{33}
+}
+func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCurrentEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentEpochResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpectedRewardsRequest is the request type for the Query/ExpectedRewards RPC method.
+type QueryExpectedRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// farmer is the farmer whose current stakings are counted in the expected rewards; optional
+	Farmer string `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// staking_amount is the hypothetical amount of the staking coin denom to be
+	// staked in addition to the farmer's stakings; optional
+	StakingAmount string `protobuf:"bytes,3,opt,name=staking_amount,json=stakingAmount,proto3" json:"staking_amount,omitempty"`
 }
 
-func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExpectedRewardsRequest) Reset()         { *m = QueryExpectedRewardsRequest{} }
+func (m *QueryExpectedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpectedRewardsRequest) ProtoMessage()    {}
+func (*QueryExpectedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{34}
+}
+func (m *QueryExpectedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpectedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpectedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExpectedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpectedRewardsRequest.Merge(m, src)
+}
+func (m *QueryExpectedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpectedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpectedRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpectedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryExpectedRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExpectedRewardsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
 }
 
-func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryExpectedRewardsRequest) GetStakingAmount() string {
+	if m != nil {
+		return m.StakingAmount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCurrentEpochDaysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryExpectedRewardsResponse is the response type for the Query/ExpectedRewards RPC method.
+type QueryExpectedRewardsResponse struct {
+	// total_stakings is the total stakings of the staking coin denom, including staking_amount
+	TotalStakings github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_stakings,json=totalStakings,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stakings"`
+	// staked_amount is the farmer's stakings plus staking_amount
+	StakedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=staked_amount,json=stakedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked_amount"`
+	// unit_rewards is the expected rewards per unit of the staking coin denom for an epoch
+	UnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=unit_rewards,json=unitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unit_rewards"`
+	// rewards is the expected rewards for staked_amount for an epoch
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+	// plan_rewards is the breakdown of the expected rewards by plan
+	PlanRewards []ExpectedPlanRewards `protobuf:"bytes,5,rep,name=plan_rewards,json=planRewards,proto3" json:"plan_rewards"`
+}
+
+func (m *QueryExpectedRewardsResponse) Reset()         { *m = QueryExpectedRewardsResponse{} }
+func (m *QueryExpectedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpectedRewardsResponse) ProtoMessage()    {}
+func (*QueryExpectedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{35}
+}
+func (m *QueryExpectedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpectedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpectedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryExpectedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpectedRewardsResponse.Merge(m, src)
+}
+func (m *QueryExpectedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpectedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpectedRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryExpectedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryExpectedRewardsResponse) GetUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UnitRewards
+	}
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryExpectedRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExpectedRewardsResponse) GetPlanRewards() []ExpectedPlanRewards {
+	if m != nil {
+		return m.PlanRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExpectedPlanRewards represents rewards that a plan is expected to allocate
+// for a staking coin denom at the end of the current epoch.
+type ExpectedPlanRewards struct {
+	PlanId             uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	// allocation_amount is the amount of coins the plan allocates for the staking coin denom
+	AllocationAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=allocation_amount,json=allocationAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allocation_amount"`
+	// sufficient_balance specifies whether the farming pool's balance covers the allocations of the pool
+	SufficientBalance bool                                        `protobuf:"varint,4,opt,name=sufficient_balance,json=sufficientBalance,proto3" json:"sufficient_balance,omitempty"`
+	UnitRewards       github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=unit_rewards,json=unitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unit_rewards"`
+	Rewards           github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *QueryCurrentEpochDaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CurrentEpochDays != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochDays))
-		i--
-		dAtA[i] = 0x8
+func (m *ExpectedPlanRewards) Reset()         { *m = ExpectedPlanRewards{} }
+func (m *ExpectedPlanRewards) String() string { return proto.CompactTextString(m) }
+func (*ExpectedPlanRewards) ProtoMessage()    {}
+func (*ExpectedPlanRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{36}
+}
+func (m *ExpectedPlanRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpectedPlanRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpectedPlanRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExpectedPlanRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpectedPlanRewards.Merge(m, src)
+}
+func (m *ExpectedPlanRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpectedPlanRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpectedPlanRewards.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ExpectedPlanRewards proto.InternalMessageInfo
+
+func (m *ExpectedPlanRewards) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ExpectedPlanRewards) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExpectedPlanRewards) GetAllocationAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AllocationAmount
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Terminated)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ExpectedPlanRewards) GetSufficientBalance() bool {
+	if m != nil {
+		return m.SufficientBalance
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *ExpectedPlanRewards) GetUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UnitRewards
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExpectedPlanRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	var l int
-	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QuerySimulateAllocationRequest is the request type for the Query/SimulateAllocation RPC method.
+type QuerySimulateAllocationRequest struct {
+}
+
+func (m *QuerySimulateAllocationRequest) Reset()         { *m = QuerySimulateAllocationRequest{} }
+func (m *QuerySimulateAllocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAllocationRequest) ProtoMessage()    {}
+func (*QuerySimulateAllocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{37}
+}
+func (m *QuerySimulateAllocationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAllocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAllocationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySimulateAllocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAllocationRequest.Merge(m, src)
+}
+func (m *QuerySimulateAllocationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAllocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAllocationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		n += 1 + sovQuery(uint64(m.PlanId))
-	}
-	return n
+var xxx_messageInfo_QuerySimulateAllocationRequest proto.InternalMessageInfo
+
+// QuerySimulateAllocationResponse is the response type for the Query/SimulateAllocation RPC method.
+type QuerySimulateAllocationResponse struct {
+	FarmingPoolAllocations []FarmingPoolAllocation `protobuf:"bytes,1,rep,name=farming_pool_allocations,json=farmingPoolAllocations,proto3" json:"farming_pool_allocations"`
+	PlanAllocations        []PlanAllocation        `protobuf:"bytes,2,rep,name=plan_allocations,json=planAllocations,proto3" json:"plan_allocations"`
+	// unit_rewards is the unit rewards to be added for each staking coin denom
+	UnitRewards []DenomUnitRewards `protobuf:"bytes,3,rep,name=unit_rewards,json=unitRewards,proto3" json:"unit_rewards"`
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateAllocationResponse) Reset()         { *m = QuerySimulateAllocationResponse{} }
+func (m *QuerySimulateAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAllocationResponse) ProtoMessage()    {}
+func (*QuerySimulateAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{38}
+}
+func (m *QuerySimulateAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySimulateAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAllocationResponse.Merge(m, src)
+}
+func (m *QuerySimulateAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAllocationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateAllocationResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateAllocationResponse) GetFarmingPoolAllocations() []FarmingPoolAllocation {
+	if m != nil {
+		return m.FarmingPoolAllocations
 	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateAllocationResponse) GetPlanAllocations() []PlanAllocation {
+	if m != nil {
+		return m.PlanAllocations
 	}
-	return n
+	return nil
 }
 
-func (m *QueryStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateAllocationResponse) GetUnitRewards() []DenomUnitRewards {
+	if m != nil {
+		return m.UnitRewards
 	}
-	var l int
-	_ = l
-	if len(m.StakedCoins) > 0 {
-		for _, e := range m.StakedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// FarmingPoolAllocation represents the allocations from a farming pool.
+type FarmingPoolAllocation struct {
+	FarmingPoolAddress string `protobuf:"bytes,1,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	// balance is the spendable balance of the farming pool
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// total_amount is the total amount of coins the plans want to allocate from the farming pool
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_amount,json=totalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_amount"`
+	// skipped specifies whether the allocations from the farming pool are skipped
+	Skipped bool `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// reason is the reason why the allocations are skipped or adjusted by the allocation policy
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *FarmingPoolAllocation) Reset()         { *m = FarmingPoolAllocation{} }
+func (m *FarmingPoolAllocation) String() string { return proto.CompactTextString(m) }
+func (*FarmingPoolAllocation) ProtoMessage()    {}
+func (*FarmingPoolAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{39}
+}
+func (m *FarmingPoolAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FarmingPoolAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FarmingPoolAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.QueuedCoins) > 0 {
-		for _, e := range m.QueuedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+}
+func (m *FarmingPoolAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FarmingPoolAllocation.Merge(m, src)
+}
+func (m *FarmingPoolAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FarmingPoolAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FarmingPoolAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FarmingPoolAllocation proto.InternalMessageInfo
+
+func (m *FarmingPoolAllocation) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryTotalStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FarmingPoolAllocation) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	var l int
-	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *FarmingPoolAllocation) GetTotalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAmount
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTotalStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FarmingPoolAllocation) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return false
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FarmingPoolAllocation) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// PlanAllocation represents the allocation of a plan.
+type PlanAllocation struct {
+	PlanId             uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	// amount is the amount of coins the plan wants to allocate
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// allocated_amount is the amount of coins to be actually allocated
+	AllocatedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=allocated_amount,json=allocatedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allocated_amount"`
+	// skipped specifies whether the allocation of the plan is skipped
+	Skipped bool `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// reason is the reason why the allocation is skipped
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PlanAllocation) Reset()         { *m = PlanAllocation{} }
+func (m *PlanAllocation) String() string { return proto.CompactTextString(m) }
+func (*PlanAllocation) ProtoMessage()    {}
+func (*PlanAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{40}
+}
+func (m *PlanAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *PlanAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAllocation.Merge(m, src)
+}
+func (m *PlanAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanAllocation proto.InternalMessageInfo
+
+func (m *PlanAllocation) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PlanAllocation) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *PlanAllocation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PlanAllocation) GetAllocatedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AllocatedAmount
+	}
+	return nil
+}
+
+func (m *PlanAllocation) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *PlanAllocation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// DenomUnitRewards represents the unit rewards for a staking coin denom.
+type DenomUnitRewards struct {
+	StakingCoinDenom string                                      `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	TotalStakings    github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,2,opt,name=total_stakings,json=totalStakings,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stakings"`
+	UnitRewards      github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=unit_rewards,json=unitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unit_rewards"`
+}
+
+func (m *DenomUnitRewards) Reset()         { *m = DenomUnitRewards{} }
+func (m *DenomUnitRewards) String() string { return proto.CompactTextString(m) }
+func (*DenomUnitRewards) ProtoMessage()    {}
+func (*DenomUnitRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{41}
+}
+func (m *DenomUnitRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomUnitRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomUnitRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DenomUnitRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomUnitRewards.Merge(m, src)
+}
+func (m *DenomUnitRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomUnitRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomUnitRewards.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DenomUnitRewards proto.InternalMessageInfo
+
+func (m *DenomUnitRewards) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryCurrentEpochDaysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomUnitRewards) GetUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UnitRewards
 	}
-	var l int
-	_ = l
-	if m.CurrentEpochDays != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpochDays))
+	return nil
+}
+
+// QueryCurrentEpochDaysRequest is the request type for the Query/CurrentEpochDays RPC method.
+type QueryCurrentEpochDaysRequest struct {
+}
+
+func (m *QueryCurrentEpochDaysRequest) Reset()         { *m = QueryCurrentEpochDaysRequest{} }
+func (m *QueryCurrentEpochDaysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDaysRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochDaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{42}
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDaysRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentEpochDaysRequest proto.InternalMessageInfo
+
+// QueryCurrentEpochDaysResponse is the response type for the Query/CurrentEpochDays RPC method.
+type QueryCurrentEpochDaysResponse struct {
+	CurrentEpochDays uint32 `protobuf:"varint,1,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+}
+
+func (m *QueryCurrentEpochDaysResponse) Reset()         { *m = QueryCurrentEpochDaysResponse{} }
+func (m *QueryCurrentEpochDaysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDaysResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochDaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{43}
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDaysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDaysResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentEpochDaysResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDays() uint32 {
+	if m != nil {
+		return m.CurrentEpochDays
+	}
+	return 0
+}
+
+// QueryCurrentEpochDurationRequest is the request type for the Query/CurrentEpochDuration RPC method.
+type QueryCurrentEpochDurationRequest struct {
+}
+
+func (m *QueryCurrentEpochDurationRequest) Reset()         { *m = QueryCurrentEpochDurationRequest{} }
+func (m *QueryCurrentEpochDurationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDurationRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochDurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{44}
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDurationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDurationRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentEpochDurationRequest proto.InternalMessageInfo
+
+// QueryCurrentEpochDurationResponse is the response type for the Query/CurrentEpochDuration RPC method.
+type QueryCurrentEpochDurationResponse struct {
+	CurrentEpochDuration time.Duration `protobuf:"bytes,1,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
+}
+
+func (m *QueryCurrentEpochDurationResponse) Reset()         { *m = QueryCurrentEpochDurationResponse{} }
+func (m *QueryCurrentEpochDurationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDurationResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochDurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{45}
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDurationResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentEpochDurationResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochDurationResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
+	}
+	return 0
+}
+
+// QueryEpochInfoRequest is the request type for the Query/EpochInfo RPC method.
+type QueryEpochInfoRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+}
+
+func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
+func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoRequest) ProtoMessage()    {}
+func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{46}
+}
+func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoRequest.Merge(m, src)
+}
+func (m *QueryEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoRequest proto.InternalMessageInfo
+
+func (m *QueryEpochInfoRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+// QueryEpochInfoResponse is the response type for the Query/EpochInfo RPC method.
+type QueryEpochInfoResponse struct {
+	// last_epoch_time is the time the last epoch ended
+	LastEpochTime        *time.Time    `protobuf:"bytes,1,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty"`
+	CurrentEpochDuration time.Duration `protobuf:"bytes,2,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
+	// next_epoch_time is the estimated time when the current epoch ends
+	NextEpochTime *time.Time          `protobuf:"bytes,3,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
+	CurrentEpochs []DenomCurrentEpoch `protobuf:"bytes,4,rep,name=current_epochs,json=currentEpochs,proto3" json:"current_epochs"`
+}
+
+func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{} }
+func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoResponse) ProtoMessage()    {}
+func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{47}
+}
+func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoResponse.Merge(m, src)
+}
+func (m *QueryEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfoResponse) GetLastEpochTime() *time.Time {
+	if m != nil {
+		return m.LastEpochTime
+	}
+	return nil
+}
+
+func (m *QueryEpochInfoResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
+	}
+	return 0
+}
+
+func (m *QueryEpochInfoResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return nil
+}
+
+func (m *QueryEpochInfoResponse) GetCurrentEpochs() []DenomCurrentEpoch {
+	if m != nil {
+		return m.CurrentEpochs
+	}
+	return nil
+}
+
+// DenomCurrentEpoch defines the current epoch number for a staking coin denom.
+type DenomCurrentEpoch struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	CurrentEpoch     uint64 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+}
+
+func (m *DenomCurrentEpoch) Reset()         { *m = DenomCurrentEpoch{} }
+func (m *DenomCurrentEpoch) String() string { return proto.CompactTextString(m) }
+func (*DenomCurrentEpoch) ProtoMessage()    {}
+func (*DenomCurrentEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{48}
+}
+func (m *DenomCurrentEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCurrentEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCurrentEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCurrentEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCurrentEpoch.Merge(m, src)
+}
+func (m *DenomCurrentEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCurrentEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCurrentEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCurrentEpoch proto.InternalMessageInfo
+
+func (m *DenomCurrentEpoch) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+func (m *DenomCurrentEpoch) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
+	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
+	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
+	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
+	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
+	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
+	proto.RegisterType((*QueryStakingsByDenomRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsByDenomRequest")
+	proto.RegisterType((*QueryStakingsByDenomResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsByDenomResponse")
+	proto.RegisterType((*FarmerStaking)(nil), "cosmos.farming.v1beta1.FarmerStaking")
+	proto.RegisterType((*QueryQueuedStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsRequest")
+	proto.RegisterType((*QueryQueuedStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsResponse")
+	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
+	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
+	proto.RegisterType((*QueryLocksRequest)(nil), "cosmos.farming.v1beta1.QueryLocksRequest")
+	proto.RegisterType((*QueryLocksResponse)(nil), "cosmos.farming.v1beta1.QueryLocksResponse")
+	proto.RegisterType((*QueryUnbondingsRequest)(nil), "cosmos.farming.v1beta1.QueryUnbondingsRequest")
+	proto.RegisterType((*QueryUnbondingsResponse)(nil), "cosmos.farming.v1beta1.QueryUnbondingsResponse")
+	proto.RegisterType((*QueryVestedRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryVestedRewardsRequest")
+	proto.RegisterType((*QueryVestedRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryVestedRewardsResponse")
+	proto.RegisterType((*QueryAutoCompoundRequest)(nil), "cosmos.farming.v1beta1.QueryAutoCompoundRequest")
+	proto.RegisterType((*QueryAutoCompoundResponse)(nil), "cosmos.farming.v1beta1.QueryAutoCompoundResponse")
+	proto.RegisterType((*QueryRewardsWithdrawAddressRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsWithdrawAddressRequest")
+	proto.RegisterType((*QueryRewardsWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsWithdrawAddressResponse")
+	proto.RegisterType((*QueryHistoricalRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryHistoricalRewardsRequest")
+	proto.RegisterType((*QueryHistoricalRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryHistoricalRewardsResponse")
+	proto.RegisterType((*HistoricalRewardsResponse)(nil), "cosmos.farming.v1beta1.HistoricalRewardsResponse")
+	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryOutstandingRewardsRequest")
+	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryOutstandingRewardsResponse")
+	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochRequest")
+	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryExpectedRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryExpectedRewardsRequest")
+	proto.RegisterType((*QueryExpectedRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryExpectedRewardsResponse")
+	proto.RegisterType((*ExpectedPlanRewards)(nil), "cosmos.farming.v1beta1.ExpectedPlanRewards")
+	proto.RegisterType((*QuerySimulateAllocationRequest)(nil), "cosmos.farming.v1beta1.QuerySimulateAllocationRequest")
+	proto.RegisterType((*QuerySimulateAllocationResponse)(nil), "cosmos.farming.v1beta1.QuerySimulateAllocationResponse")
+	proto.RegisterType((*FarmingPoolAllocation)(nil), "cosmos.farming.v1beta1.FarmingPoolAllocation")
+	proto.RegisterType((*PlanAllocation)(nil), "cosmos.farming.v1beta1.PlanAllocation")
+	proto.RegisterType((*DenomUnitRewards)(nil), "cosmos.farming.v1beta1.DenomUnitRewards")
+	proto.RegisterType((*QueryCurrentEpochDaysRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysRequest")
+	proto.RegisterType((*QueryCurrentEpochDaysResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysResponse")
+	proto.RegisterType((*QueryCurrentEpochDurationRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDurationRequest")
+	proto.RegisterType((*QueryCurrentEpochDurationResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDurationResponse")
+	proto.RegisterType((*QueryEpochInfoRequest)(nil), "cosmos.farming.v1beta1.QueryEpochInfoRequest")
+	proto.RegisterType((*QueryEpochInfoResponse)(nil), "cosmos.farming.v1beta1.QueryEpochInfoResponse")
+	proto.RegisterType((*DenomCurrentEpoch)(nil), "cosmos.farming.v1beta1.DenomCurrentEpoch")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+}
+
+var fileDescriptor_00c8db58c274b111 = []byte{
+	// 3766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x7d, 0x6c, 0x1c, 0xd5,
+	0xb5, 0xcf, 0xcc, 0xac, 0x9d, 0xe4, 0x26, 0x4e, 0x9c, 0x8b, 0x13, 0x9c, 0x21, 0xd8, 0xc3, 0xe4,
+	0x11, 0x9c, 0xc4, 0xde, 0xb5, 0x9d, 0x84, 0x0f, 0x87, 0x3c, 0x58, 0x93, 0x2f, 0xe7, 0x85, 0x90,
+	0x6c, 0x42, 0x10, 0xbc, 0xf7, 0xb4, 0xcc, 0xce, 0x5c, 0xdb, 0x43, 0x76, 0x67, 0x96, 0xf9, 0xb0,
+	0xe3, 0x97, 0x17, 0xa0, 0xd0, 0x42, 0x9b, 0xaa, 0x15, 0x5d, 0x90, 0xa0, 0x52, 0x55, 0xb5, 0x2a,
+	0x45, 0x15, 0xf4, 0x8f, 0x4a, 0x54, 0x6a, 0xa5, 0x52, 0x4a, 0xff, 0x40, 0xa2, 0x48, 0xad, 0xf8,
+	0x90, 0x28, 0xe2, 0x0f, 0x40, 0xa1, 0x7f, 0x55, 0x45, 0x54, 0xfc, 0xd1, 0x52, 0x55, 0x95, 0xaa,
+	0xfb, 0x35, 0x3b, 0xb3, 0x3b, 0xb3, 0x1f, 0xfe, 0x20, 0xcb, 0x5f, 0xf6, 0xce, 0xdc, 0x73, 0xcf,
+	0x99, 0xf3, 0xfb, 0xdd, 0x73, 0xce, 0xbd, 0xf7, 0x80, 0x1d, 0x1e, 0xb2, 0x0c, 0xe4, 0x94, 0x4c,
+	0xcb, 0xcb, 0x4c, 0x6b, 0xf8, 0xef, 0x4c, 0x66, 0x6e, 0xac, 0x80, 0x3c, 0x6d, 0x2c, 0xf3, 0xa0,
+	0x8f, 0x9c, 0x85, 0x74, 0xd9, 0xb1, 0x3d, 0x1b, 0x6e, 0xd1, 0x6d, 0xb7, 0x64, 0xbb, 0x69, 0x36,
+	0x26, 0xcd, 0xc6, 0xc8, 0x43, 0x0d, 0xe4, 0xf9, 0x58, 0x32, 0x83, 0xbc, 0x95, 0xce, 0x90, 0x27,
+	0xbf, 0x32, 0x6c, 0x3a, 0xfa, 0x6a, 0x17, 0xfd, 0x95, 0x29, 0x68, 0x2e, 0xa2, 0x5a, 0x83, 0x39,
+	0xca, 0xda, 0x8c, 0x69, 0x69, 0x9e, 0x69, 0x5b, 0x6c, 0xec, 0x40, 0x78, 0x2c, 0x1f, 0xa5, 0xdb,
+	0x26, 0x7f, 0xdf, 0x37, 0x63, 0xcf, 0xd8, 0x54, 0x07, 0xfe, 0x8f, 0x2b, 0x9f, 0xb1, 0xed, 0x99,
+	0x22, 0xca, 0x90, 0x5f, 0x05, 0x7f, 0x3a, 0xa3, 0x59, 0xec, 0xcb, 0xe4, 0x6d, 0xec, 0x95, 0x56,
+	0x36, 0x33, 0x9a, 0x65, 0xd9, 0x1e, 0xd1, 0xc6, 0x4d, 0x1b, 0xa8, 0x15, 0x34, 0x7c, 0x27, 0x6c,
+	0xce, 0x60, 0xed, 0x7b, 0xcf, 0x2c, 0x21, 0xd7, 0xd3, 0x4a, 0x65, 0x36, 0x80, 0xfe, 0xd1, 0x47,
+	0x66, 0x90, 0x35, 0x62, 0x97, 0x91, 0xa5, 0x95, 0xcd, 0xb9, 0xf1, 0x8c, 0x5d, 0x26, 0x4a, 0xea,
+	0x15, 0xaa, 0x7d, 0x00, 0x9e, 0xc2, 0x1e, 0x38, 0xa9, 0x39, 0x5a, 0xc9, 0xcd, 0xa1, 0x07, 0x7d,
+	0xe4, 0x7a, 0xea, 0x69, 0x70, 0x55, 0xe4, 0xa9, 0x5b, 0xb6, 0x2d, 0x17, 0xc1, 0x5b, 0x41, 0x77,
+	0x99, 0x3c, 0xe9, 0x17, 0x14, 0x61, 0x68, 0xdd, 0xf8, 0x40, 0x3a, 0x1e, 0xa6, 0x34, 0x95, 0x9b,
+	0x4c, 0xbd, 0xfe, 0xc1, 0xe0, 0xaa, 0x1c, 0x93, 0x51, 0x7f, 0x20, 0x82, 0x4d, 0x74, 0xd6, 0xa2,
+	0x66, 0x71, 0x55, 0x10, 0x82, 0x94, 0xb7, 0x50, 0x46, 0x64, 0xc6, 0xb5, 0x39, 0xf2, 0x3f, 0x1c,
+	0x05, 0x7d, 0x6c, 0xc6, 0x7c, 0xd9, 0xb6, 0x8b, 0x79, 0xcd, 0x30, 0x1c, 0xe4, 0xba, 0xfd, 0x22,
+	0x19, 0x03, 0xd9, 0xbb, 0x93, 0xb6, 0x5d, 0xcc, 0xd2, 0x37, 0x30, 0x03, 0xae, 0xf2, 0x08, 0x2d,
+	0xc8, 0xc7, 0x05, 0x02, 0x12, 0x15, 0x08, 0xbd, 0xe2, 0x02, 0xc3, 0x00, 0xba, 0x9e, 0x76, 0x0e,
+	0xab, 0xc0, 0x68, 0xe6, 0x0d, 0x64, 0xd9, 0xa5, 0xfe, 0x14, 0x19, 0xdf, 0xcb, 0xde, 0xdc, 0x61,
+	0x9b, 0xd6, 0x41, 0xfc, 0x1c, 0x0e, 0x00, 0xc0, 0xe7, 0x40, 0x46, 0x7f, 0x17, 0x19, 0x15, 0x7a,
+	0x02, 0x0f, 0x03, 0x50, 0x65, 0x4e, 0x7f, 0x37, 0x71, 0xce, 0x0e, 0xee, 0x1c, 0x4c, 0x9d, 0x34,
+	0x25, 0x77, 0xd5, 0x3f, 0x33, 0x88, 0x39, 0x20, 0x17, 0x92, 0x54, 0x9f, 0x16, 0x00, 0x0c, 0xbb,
+	0x88, 0xf9, 0x7d, 0x1f, 0xe8, 0x2a, 0xe3, 0x07, 0xfd, 0x82, 0x22, 0x0d, 0xad, 0x1b, 0xef, 0x4b,
+	0x53, 0x16, 0xa4, 0x39, 0x0b, 0xd2, 0x59, 0x6b, 0x61, 0x72, 0xed, 0x1b, 0x3f, 0x1f, 0xe9, 0xc2,
+	0x72, 0x53, 0x39, 0x3a, 0x1a, 0x1e, 0x89, 0x58, 0x25, 0x12, 0xab, 0x6e, 0x68, 0x6a, 0x15, 0xd5,
+	0x19, 0x31, 0x6b, 0x37, 0xe8, 0x0d, 0xac, 0xe2, 0xb8, 0x5d, 0x0d, 0x56, 0x63, 0x2d, 0x79, 0xd3,
+	0x20, 0xd0, 0xa5, 0x72, 0xdd, 0xf8, 0xe7, 0x94, 0xa1, 0x1e, 0x0d, 0xa1, 0x1c, 0x7c, 0xc1, 0x1e,
+	0x90, 0xc2, 0xaf, 0x19, 0x6f, 0x9a, 0x7e, 0x00, 0x19, 0xac, 0x3e, 0x27, 0x80, 0x3e, 0x32, 0xd5,
+	0x69, 0x8a, 0x47, 0xc0, 0x99, 0x2d, 0xa0, 0x1b, 0x73, 0x00, 0x39, 0x8c, 0x35, 0xec, 0x57, 0x02,
+	0xa8, 0x62, 0x02, 0xa8, 0x51, 0xd0, 0xa4, 0x45, 0x83, 0xf6, 0xaa, 0x08, 0x36, 0xd7, 0x98, 0xc9,
+	0xbe, 0xda, 0x02, 0xeb, 0xb1, 0x56, 0x64, 0x10, 0x73, 0x38, 0x7c, 0x5b, 0x23, 0x3a, 0xf8, 0xec,
+	0xd8, 0xae, 0xc9, 0x51, 0xbc, 0x60, 0x5e, 0xf8, 0x70, 0x70, 0x68, 0xc6, 0xf4, 0x66, 0xfd, 0x42,
+	0x5a, 0xb7, 0x4b, 0x2c, 0x74, 0xb1, 0x3f, 0x23, 0xae, 0x71, 0x2e, 0x83, 0xd7, 0x88, 0x4b, 0x04,
+	0xdc, 0xdc, 0x3a, 0xaa, 0x80, 0xfc, 0xc0, 0xfa, 0x1e, 0xf4, 0x91, 0x1f, 0xe8, 0x13, 0x57, 0x40,
+	0x1f, 0x55, 0x40, 0xf5, 0x1d, 0x89, 0xf1, 0xe0, 0xa2, 0x08, 0xf6, 0x94, 0x00, 0xae, 0x89, 0xb8,
+	0x70, 0x72, 0x81, 0x60, 0xc4, 0x01, 0x8f, 0x07, 0x56, 0x68, 0x09, 0x58, 0x71, 0xd1, 0xc0, 0xfe,
+	0x4c, 0x00, 0xdb, 0xe2, 0xad, 0x62, 0xf8, 0x1e, 0x01, 0x6b, 0x98, 0x72, 0x8e, 0xed, 0xf5, 0x49,
+	0x11, 0xf1, 0x30, 0x61, 0x28, 0x9b, 0x88, 0x05, 0xc6, 0x40, 0x78, 0xf9, 0x56, 0xea, 0x5b, 0x02,
+	0xe8, 0x89, 0xa8, 0x4a, 0x5c, 0x2b, 0xa7, 0x41, 0x0f, 0xe3, 0xa6, 0x56, 0xb2, 0x7d, 0xcb, 0xa3,
+	0xcb, 0x64, 0x32, 0x8d, 0x2d, 0x7b, 0xff, 0x83, 0xc1, 0x1d, 0x2d, 0x30, 0x62, 0xca, 0xf2, 0x72,
+	0x8c, 0xe0, 0x59, 0x32, 0x07, 0x9e, 0x94, 0x11, 0x90, 0x4d, 0x2a, 0x2d, 0x6e, 0x52, 0x3a, 0x09,
+	0x9d, 0x54, 0x2d, 0x00, 0x99, 0xa0, 0x70, 0x8a, 0x3c, 0x5c, 0x91, 0x58, 0xa0, 0xfe, 0x91, 0x13,
+	0xb0, 0x56, 0x49, 0x75, 0x25, 0x47, 0x56, 0x96, 0xb0, 0xc2, 0x2b, 0xeb, 0x28, 0xd8, 0x68, 0xa1,
+	0xf3, 0x5e, 0x1e, 0x95, 0x6d, 0x7d, 0x36, 0x8f, 0x93, 0x3c, 0x63, 0x85, 0x5c, 0x17, 0x3a, 0xcf,
+	0xf0, 0x0a, 0x60, 0x32, 0xf5, 0xe4, 0x87, 0x83, 0x42, 0xae, 0x07, 0x0b, 0x1e, 0xc2, 0x72, 0xf8,
+	0x8d, 0x3a, 0x05, 0xb6, 0x92, 0x0f, 0x3b, 0x63, 0x7b, 0x5a, 0xb1, 0xd6, 0x79, 0x6d, 0xad, 0x2b,
+	0xd5, 0x00, 0x72, 0xdc, 0x54, 0xcc, 0x45, 0x87, 0x41, 0x37, 0x03, 0x5d, 0x58, 0x14, 0xe8, 0x4c,
+	0x5a, 0xfd, 0x91, 0xc0, 0x8a, 0x8f, 0x1c, 0x9a, 0xd7, 0x1c, 0xa3, 0x43, 0x83, 0xfe, 0xd3, 0x22,
+	0xe8, 0x8b, 0x5a, 0xc9, 0xdc, 0x80, 0xc0, 0x6a, 0x87, 0x3e, 0x5a, 0x09, 0x92, 0xf0, 0xb9, 0xe1,
+	0x71, 0xb0, 0x9e, 0xa4, 0x5f, 0xae, 0x8b, 0x86, 0xfa, 0xed, 0x89, 0x05, 0x19, 0x49, 0xc6, 0x64,
+	0x28, 0x0b, 0x3e, 0xeb, 0xca, 0xd5, 0x47, 0xcb, 0x17, 0xc8, 0x7f, 0x28, 0xb0, 0xec, 0x7f, 0xdc,
+	0xd6, 0xcf, 0x75, 0x28, 0x74, 0xcf, 0xf0, 0x22, 0x8b, 0xd9, 0xc8, 0x80, 0xbb, 0x19, 0x74, 0x15,
+	0xf1, 0x03, 0x06, 0xdb, 0xb6, 0x24, 0x57, 0x62, 0x29, 0xe6, 0x43, 0x2a, 0xb0, 0x7c, 0xd1, 0xfb,
+	0x79, 0x01, 0x6c, 0x21, 0x96, 0xdd, 0x6d, 0x15, 0x6c, 0xcb, 0xe8, 0xdc, 0x92, 0xe7, 0x45, 0x01,
+	0x5c, 0x5d, 0x67, 0x68, 0x90, 0x14, 0x81, 0x1f, 0x3c, 0x65, 0xce, 0xbc, 0x2e, 0xc9, 0x99, 0x81,
+	0x3c, 0xf3, 0x68, 0x48, 0x74, 0xf9, 0xdc, 0xba, 0x87, 0x85, 0xc0, 0xb3, 0xc8, 0xf5, 0x90, 0xd1,
+	0x5a, 0x58, 0x51, 0x5f, 0x12, 0x81, 0x1c, 0x27, 0x55, 0x4d, 0xfd, 0x73, 0xc8, 0xf5, 0x5a, 0x49,
+	0xfd, 0x54, 0xf4, 0x2c, 0x1d, 0xcd, 0x53, 0x3f, 0x17, 0x86, 0x3a, 0xe8, 0x9e, 0x23, 0x1a, 0x56,
+	0xa2, 0x5a, 0x63, 0x53, 0xc3, 0x19, 0xb0, 0xc6, 0xb7, 0x98, 0x1a, 0x69, 0xf9, 0xd5, 0x04, 0x93,
+	0xab, 0xe3, 0xa0, 0x9f, 0x38, 0x2d, 0xeb, 0x7b, 0xf6, 0x1d, 0x76, 0xa9, 0x6c, 0xfb, 0x96, 0xd1,
+	0xcc, 0xd3, 0xfb, 0xc0, 0xd6, 0x18, 0x19, 0xe6, 0xe7, 0x7e, 0xb0, 0x1a, 0x59, 0x5a, 0xa1, 0x88,
+	0xe8, 0x36, 0x63, 0x4d, 0x8e, 0xff, 0x54, 0x6f, 0x05, 0x6a, 0x38, 0x00, 0xdf, 0x63, 0x7a, 0xb3,
+	0x86, 0xa3, 0xcd, 0xb3, 0x0d, 0x5e, 0x33, 0xa5, 0x27, 0xc1, 0xf6, 0x86, 0xd2, 0x4c, 0xfd, 0x4e,
+	0xd0, 0x3b, 0xcf, 0x5e, 0x05, 0x9b, 0x4a, 0x3a, 0xd1, 0xc6, 0xf9, 0xa8, 0x88, 0xfa, 0x8e, 0x00,
+	0xae, 0x25, 0x53, 0x1e, 0x35, 0x5d, 0xcf, 0x76, 0x4c, 0x5d, 0x2b, 0xd6, 0x50, 0xad, 0xbd, 0x2a,
+	0x76, 0x10, 0xe0, 0xda, 0xde, 0x61, 0x35, 0x00, 0xe1, 0x7f, 0x2a, 0x07, 0xc8, 0x23, 0x92, 0xdd,
+	0xe1, 0x35, 0x60, 0x2d, 0xb2, 0x0c, 0xf6, 0x5a, 0x22, 0xaf, 0xd7, 0x20, 0xcb, 0xa0, 0x2f, 0xa3,
+	0x2b, 0x3d, 0xb5, 0xe8, 0x95, 0xfe, 0x96, 0x00, 0x06, 0x92, 0xbe, 0x8a, 0xf9, 0x68, 0x1a, 0xc0,
+	0xd9, 0xe0, 0x65, 0x3e, 0x9a, 0xfc, 0xc6, 0x92, 0x16, 0x45, 0xe2, 0x74, 0x6c, 0x81, 0x6c, 0x9a,
+	0xad, 0x1d, 0xb0, 0x7c, 0xf1, 0xe0, 0xd7, 0x02, 0xd8, 0x9a, 0xfc, 0x39, 0x7d, 0xa0, 0x8b, 0xba,
+	0x94, 0x6e, 0x6b, 0xe9, 0x0f, 0xf8, 0x0d, 0x01, 0x5c, 0xad, 0xfb, 0x25, 0xbf, 0xa8, 0x79, 0xe6,
+	0x1c, 0xca, 0xfb, 0x96, 0xe9, 0xd5, 0xe4, 0xde, 0x6d, 0xb1, 0x2b, 0xea, 0x20, 0xd2, 0xc9, 0xa2,
+	0xda, 0xc3, 0x16, 0xd5, 0xee, 0x16, 0x16, 0x15, 0x93, 0x71, 0x73, 0x9b, 0xab, 0x1a, 0xef, 0xb6,
+	0x4c, 0x8f, 0x59, 0xaa, 0x9e, 0x60, 0x90, 0xdc, 0xe5, 0x7b, 0xae, 0xa7, 0x91, 0x68, 0xb9, 0x14,
+	0xa6, 0xa9, 0xdf, 0x16, 0xc0, 0x60, 0xe2, 0x84, 0xcc, 0x2b, 0xe7, 0x6a, 0xcb, 0x9a, 0x15, 0xf8,
+	0x5c, 0xae, 0x41, 0x3d, 0xca, 0xa2, 0xc8, 0x1d, 0xbe, 0xe3, 0x20, 0x8b, 0xd2, 0x7d, 0x71, 0x9f,
+	0x76, 0x3b, 0xd8, 0x1a, 0x33, 0x13, 0xfb, 0xa6, 0xed, 0xa0, 0x47, 0xa7, 0xcf, 0xf3, 0x61, 0xc4,
+	0xd7, 0xeb, 0xa1, 0xc1, 0xea, 0x25, 0xbe, 0x33, 0x38, 0x74, 0xbe, 0x8c, 0xf4, 0xfa, 0xfc, 0xd1,
+	0xde, 0xa2, 0xae, 0x86, 0x23, 0x31, 0x92, 0xc6, 0xaf, 0x07, 0x1b, 0xf8, 0x2c, 0xe1, 0x9d, 0x53,
+	0xae, 0x87, 0x3d, 0x65, 0x5b, 0xa1, 0xc7, 0x52, 0x60, 0x5b, 0xbc, 0x31, 0xec, 0x93, 0xee, 0x06,
+	0x1b, 0x3c, 0x5c, 0x9d, 0xe7, 0x43, 0xfb, 0xd2, 0xc5, 0x14, 0xe3, 0x3d, 0x5e, 0xb8, 0xc6, 0x5f,
+	0x99, 0xcd, 0xa2, 0x07, 0xd6, 0x47, 0x96, 0x91, 0xb4, 0x52, 0xbc, 0x5a, 0xe7, 0x57, 0x17, 0x4f,
+	0x98, 0xc8, 0xa9, 0x95, 0x26, 0x32, 0x3c, 0x53, 0x53, 0xa5, 0x77, 0x11, 0x8d, 0xbb, 0x93, 0x82,
+	0x22, 0x47, 0xb5, 0x71, 0xb5, 0xae, 0xfe, 0x45, 0x02, 0x57, 0xc5, 0x0c, 0x4d, 0x3c, 0x92, 0x5b,
+	0xc4, 0x79, 0xea, 0x79, 0xb0, 0x49, 0x2b, 0x16, 0x6d, 0x9d, 0x1d, 0xa7, 0x72, 0x4a, 0x2e, 0x7b,
+	0xe5, 0xd0, 0x5b, 0xd5, 0xc2, 0x58, 0x31, 0x02, 0xa0, 0xeb, 0x4f, 0x4f, 0x9b, 0xba, 0x89, 0xd7,
+	0x65, 0x41, 0x2b, 0x6a, 0x96, 0x8e, 0x48, 0x02, 0x5b, 0x93, 0xdb, 0x54, 0x7d, 0x33, 0x49, 0x5f,
+	0xd4, 0x91, 0xa8, 0xeb, 0x8b, 0x26, 0x51, 0xf7, 0x8a, 0x47, 0x43, 0x85, 0x85, 0xfb, 0xd3, 0x26,
+	0x49, 0x06, 0x28, 0x1b, 0xb8, 0x8c, 0x1f, 0xd7, 0xbf, 0x2c, 0x82, 0xc1, 0xc4, 0x21, 0x2c, 0x32,
+	0x94, 0x40, 0x7f, 0x94, 0x03, 0xc1, 0x10, 0x1e, 0xd1, 0x47, 0x1a, 0x9d, 0x5d, 0x71, 0x7e, 0x04,
+	0x52, 0x8c, 0x98, 0x5b, 0xa6, 0xe3, 0x5e, 0xba, 0xf0, 0x1e, 0xd0, 0x4b, 0xb8, 0x18, 0x56, 0x43,
+	0xf3, 0xe4, 0x8e, 0x46, 0x7b, 0xd4, 0xba, 0xf9, 0x37, 0x96, 0x23, 0x4f, 0x5d, 0x78, 0x2a, 0x36,
+	0x6a, 0x0c, 0x25, 0x4d, 0x4a, 0xc2, 0x6e, 0x28, 0x79, 0xf2, 0xf5, 0x14, 0x42, 0x53, 0x7d, 0x5b,
+	0x04, 0x9b, 0x63, 0xbf, 0x31, 0x71, 0xe1, 0x08, 0x89, 0x0b, 0x07, 0x81, 0xd5, 0x9c, 0xb3, 0x2b,
+	0x50, 0xcf, 0xf3, 0xb9, 0xf1, 0x79, 0x14, 0x8d, 0xf3, 0x2b, 0xb7, 0x34, 0xd7, 0x11, 0x05, 0x6c,
+	0x55, 0xf6, 0x83, 0xd5, 0xee, 0x39, 0xb3, 0x5c, 0x46, 0x06, 0x5b, 0x8a, 0xfc, 0x27, 0xce, 0x68,
+	0x0e, 0xd2, 0x5c, 0xdb, 0x62, 0xd7, 0x22, 0xec, 0x97, 0xfa, 0x67, 0x11, 0x6c, 0x88, 0x22, 0xba,
+	0x9c, 0xf1, 0x49, 0x07, 0xdd, 0x2b, 0xf7, 0xe5, 0x6c, 0x6a, 0x38, 0x07, 0x78, 0x78, 0xaa, 0x26,
+	0xbe, 0xd4, 0xf2, 0xab, 0xdb, 0x18, 0x28, 0xa9, 0x77, 0x76, 0x57, 0x92, 0xb3, 0xbb, 0x23, 0xce,
+	0xfe, 0x96, 0x08, 0x7a, 0x6b, 0x99, 0xde, 0x66, 0x65, 0x52, 0x5f, 0x39, 0x88, 0xcb, 0x51, 0x39,
+	0x5c, 0x91, 0x24, 0xaf, 0x0e, 0x80, 0x6d, 0x75, 0x65, 0xdf, 0x41, 0x6d, 0x21, 0xb8, 0xdf, 0xbc,
+	0x13, 0x5c, 0x9b, 0xf0, 0x9e, 0x45, 0xcb, 0x61, 0x00, 0x23, 0xa5, 0x61, 0xde, 0xd0, 0x16, 0xe8,
+	0xb2, 0xef, 0xc9, 0xf5, 0xea, 0x35, 0x52, 0xaa, 0x0a, 0x94, 0xfa, 0xe9, 0xd8, 0xc5, 0x2d, 0x57,
+	0xf9, 0x10, 0xb8, 0xae, 0xc1, 0x18, 0xa6, 0xf6, 0x5e, 0xb0, 0xa5, 0x46, 0x2d, 0x1b, 0xc1, 0x2e,
+	0xce, 0xb6, 0xd6, 0x9d, 0xfe, 0xf2, 0x29, 0x26, 0xd7, 0x60, 0xa7, 0x3d, 0x8b, 0x0f, 0x80, 0xfb,
+	0xf4, 0x18, 0x15, 0xea, 0x21, 0x76, 0x49, 0x45, 0x9e, 0x4e, 0x59, 0xd3, 0xf6, 0xe2, 0x0a, 0xea,
+	0x0f, 0x45, 0xb0, 0xa5, 0x76, 0x1e, 0x66, 0xfc, 0x51, 0xb0, 0xb1, 0xa8, 0xb9, 0x91, 0x33, 0x6b,
+	0xa1, 0xd5, 0x33, 0x6b, 0x2c, 0x18, 0x9c, 0x59, 0x37, 0x70, 0x83, 0xb8, 0x44, 0x37, 0xc4, 0x1d,
+	0xac, 0x4b, 0x8b, 0x3a, 0x58, 0x87, 0x67, 0xc1, 0x86, 0x88, 0x91, 0xbc, 0x9e, 0xdc, 0xd9, 0x30,
+	0x15, 0x85, 0xe1, 0x67, 0xb9, 0xa8, 0x27, 0x6c, 0xa8, 0xab, 0x4e, 0x83, 0x4d, 0x75, 0x23, 0xdb,
+	0x5c, 0xcb, 0x75, 0x1b, 0x1b, 0xb1, 0x7e, 0x63, 0x33, 0xfe, 0xdc, 0x3d, 0xa0, 0x8b, 0x20, 0x09,
+	0x7f, 0x2a, 0x82, 0x6e, 0x7a, 0x63, 0x0f, 0x77, 0x25, 0x19, 0x5f, 0xdf, 0x24, 0x20, 0xef, 0x6e,
+	0x69, 0x2c, 0x25, 0x87, 0xfa, 0xba, 0x50, 0xc9, 0x7e, 0x5f, 0x90, 0x47, 0x72, 0xc8, 0xf3, 0x1d,
+	0xcb, 0x55, 0xb4, 0x62, 0x51, 0x21, 0x7d, 0x01, 0xc8, 0x43, 0x8e, 0xab, 0xd8, 0xd3, 0x8a, 0x37,
+	0x8b, 0x14, 0x36, 0x93, 0x52, 0xb2, 0x0d, 0xbf, 0x88, 0xd2, 0x6a, 0x09, 0x0c, 0x1c, 0x36, 0x2d,
+	0x43, 0xb1, 0x7d, 0x4f, 0x29, 0xd9, 0x0e, 0x52, 0xb4, 0x02, 0xfe, 0x17, 0x0f, 0x2d, 0x53, 0x83,
+	0xff, 0x6b, 0xd6, 0xf3, 0xca, 0xee, 0x44, 0x26, 0x13, 0x8a, 0x15, 0x31, 0x3d, 0x22, 0x85, 0xa2,
+	0x5d, 0xc8, 0x94, 0x34, 0xd3, 0xca, 0x9c, 0x0f, 0x9e, 0xb9, 0x65, 0xa4, 0x67, 0x46, 0x6f, 0xca,
+	0xd3, 0x99, 0xd2, 0x25, 0xe3, 0xd1, 0x77, 0xfe, 0xf4, 0x94, 0xa8, 0xc0, 0x01, 0x1e, 0x6c, 0x6a,
+	0x1b, 0x4c, 0x98, 0xca, 0xf7, 0x52, 0x80, 0x5c, 0x53, 0xbb, 0x70, 0x67, 0x63, 0x0f, 0x84, 0xda,
+	0x1c, 0xe4, 0x5d, 0xad, 0x0c, 0x65, 0xbe, 0xfa, 0xbb, 0x54, 0xc9, 0xfe, 0x41, 0x92, 0xf7, 0x07,
+	0xbe, 0x52, 0x8a, 0xa6, 0xeb, 0x61, 0x1f, 0x61, 0xaf, 0x71, 0x1f, 0x91, 0x3b, 0x7e, 0x05, 0x9f,
+	0x42, 0x29, 0xd5, 0xb3, 0x0d, 0xc5, 0x41, 0xae, 0x5f, 0xf4, 0xd2, 0xea, 0x1c, 0x18, 0x49, 0xf2,
+	0x1c, 0x39, 0x25, 0x51, 0x34, 0xcb, 0x50, 0x90, 0xe3, 0xd8, 0x8e, 0xa2, 0xdb, 0x06, 0x72, 0xe1,
+	0xa1, 0xd6, 0x1c, 0xe9, 0x39, 0x08, 0x51, 0x47, 0x1a, 0xb6, 0xee, 0x66, 0x8e, 0xda, 0xf3, 0x23,
+	0x67, 0xec, 0x8c, 0x5e, 0x34, 0xb7, 0x93, 0x6f, 0x38, 0xf6, 0x94, 0x00, 0xa4, 0xbd, 0xa3, 0xa3,
+	0xf0, 0x9b, 0x02, 0x58, 0x37, 0xa9, 0x19, 0x0a, 0x0f, 0x7f, 0xff, 0x0f, 0x7a, 0xb5, 0x72, 0xb9,
+	0x68, 0xd2, 0x52, 0x20, 0xf3, 0x80, 0x6b, 0x5b, 0x70, 0xf6, 0x82, 0x8a, 0x75, 0xab, 0x13, 0x7b,
+	0x86, 0xd5, 0x12, 0x72, 0x5d, 0x6d, 0x06, 0xa9, 0x13, 0xaa, 0x53, 0xd6, 0xa9, 0x61, 0x13, 0xc4,
+	0x32, 0xe5, 0x80, 0x32, 0x65, 0xcd, 0x69, 0x45, 0xd3, 0xc8, 0x3a, 0x33, 0x7e, 0x09, 0x59, 0x9e,
+	0x62, 0x20, 0x57, 0x57, 0x0e, 0x28, 0x26, 0x7d, 0x4c, 0x1c, 0xa1, 0xe0, 0x6c, 0xa0, 0x9c, 0x3c,
+	0x9e, 0x3d, 0x91, 0x3f, 0x73, 0xef, 0xc9, 0x43, 0xea, 0xb0, 0x6a, 0x20, 0x4f, 0x33, 0x8b, 0xae,
+	0x3a, 0xf1, 0xdf, 0xff, 0x7b, 0xf1, 0xd8, 0x23, 0x02, 0x90, 0xf6, 0x8d, 0x8e, 0xc2, 0x05, 0xb0,
+	0x79, 0xca, 0xf2, 0x90, 0x63, 0x69, 0x45, 0xe5, 0x34, 0x72, 0xe6, 0x90, 0xa3, 0x1c, 0xc2, 0xaa,
+	0xd4, 0xfb, 0x63, 0xcc, 0x3b, 0xce, 0xcd, 0x1b, 0x6b, 0x6a, 0x1f, 0x9b, 0x92, 0x19, 0x46, 0xde,
+	0xd6, 0x98, 0x40, 0xb8, 0x35, 0x08, 0xaf, 0x4d, 0xe4, 0x16, 0x21, 0xd4, 0xbb, 0x5d, 0x20, 0x85,
+	0xfd, 0x08, 0x87, 0x9a, 0xd2, 0x85, 0x13, 0x6b, 0x67, 0x0b, 0x23, 0x19, 0xaf, 0x3e, 0x4f, 0x55,
+	0xb2, 0xaf, 0xa5, 0xe4, 0x5b, 0x38, 0xaf, 0xc2, 0x2b, 0x8e, 0x3a, 0x71, 0x56, 0xf3, 0x14, 0xdd,
+	0x76, 0x1c, 0x22, 0x61, 0xb8, 0x8a, 0x67, 0xd3, 0xb5, 0x46, 0xab, 0xb6, 0xb4, 0xea, 0xb7, 0xcb,
+	0xaa, 0x83, 0x4b, 0x65, 0x15, 0x56, 0x7d, 0xec, 0xab, 0x8c, 0x54, 0x17, 0xa3, 0x9c, 0xb2, 0x62,
+	0x40, 0xbb, 0x6f, 0x69, 0x9c, 0x42, 0xa5, 0xb2, 0xb7, 0xa0, 0x38, 0x4c, 0x41, 0x0d, 0x8b, 0x1e,
+	0x27, 0x66, 0xec, 0x85, 0x0f, 0x47, 0xcd, 0x28, 0xc7, 0x98, 0xf1, 0x3f, 0xdc, 0x8c, 0x7d, 0x8d,
+	0xcd, 0x38, 0x61, 0x7b, 0x87, 0x6d, 0xdf, 0x32, 0xb8, 0x7e, 0x02, 0x03, 0x73, 0xb7, 0x62, 0xd9,
+	0x9e, 0x32, 0x8d, 0xdf, 0x76, 0x28, 0x9d, 0x77, 0xc2, 0x1b, 0x1a, 0xd2, 0x39, 0x73, 0x81, 0x7d,
+	0xc9, 0x45, 0xf8, 0x57, 0x09, 0xac, 0x09, 0x6a, 0xc2, 0xe1, 0x86, 0x94, 0xad, 0xb9, 0xa3, 0x96,
+	0x47, 0x5a, 0x1c, 0xcd, 0x48, 0xfe, 0xb8, 0x54, 0xc9, 0xbe, 0x25, 0xca, 0x77, 0x86, 0x13, 0x0d,
+	0x2f, 0x69, 0x95, 0x21, 0x97, 0x1c, 0x40, 0x11, 0x9a, 0xd2, 0xeb, 0x76, 0x85, 0xdc, 0xe7, 0xef,
+	0x4c, 0xa4, 0x3e, 0xbb, 0x2e, 0x58, 0x68, 0x97, 0xf8, 0x47, 0x97, 0x4a, 0x7c, 0x6e, 0x73, 0x87,
+	0x90, 0x9f, 0x00, 0xbe, 0x1b, 0xee, 0x4c, 0x02, 0x9c, 0x9b, 0x9b, 0xb9, 0x40, 0x3d, 0x76, 0x11,
+	0xfe, 0x26, 0x05, 0x36, 0xd6, 0x34, 0xce, 0xc0, 0x3d, 0x2d, 0x61, 0x19, 0x6d, 0xfe, 0x91, 0xf7,
+	0xb6, 0x27, 0xc4, 0x78, 0xf0, 0xaa, 0x54, 0xc9, 0xfe, 0x4d, 0x94, 0x1f, 0x08, 0x07, 0xbb, 0x7a,
+	0xf4, 0xe9, 0xae, 0xce, 0x0d, 0xe7, 0x56, 0xe4, 0xb8, 0x9c, 0x30, 0x81, 0x50, 0xb4, 0xee, 0x4a,
+	0x48, 0xba, 0xea, 0x23, 0x42, 0xbb, 0x2c, 0xb9, 0x6b, 0xb9, 0x58, 0x52, 0x58, 0x20, 0x96, 0x75,
+	0x12, 0x59, 0x6e, 0x87, 0xff, 0xd9, 0x8c, 0x2c, 0xf9, 0xc2, 0x02, 0xf5, 0x68, 0xe6, 0x42, 0xbd,
+	0x97, 0x2f, 0xc2, 0x67, 0x53, 0x60, 0x43, 0xb4, 0x1f, 0x07, 0x8e, 0x37, 0xe4, 0x42, 0x6c, 0x87,
+	0x90, 0xbc, 0xa7, 0x2d, 0x19, 0x46, 0x9f, 0xef, 0x49, 0x95, 0xec, 0x47, 0xa2, 0x7c, 0x36, 0x4c,
+	0x9f, 0x70, 0xc4, 0x08, 0x57, 0xac, 0xc8, 0x21, 0xc0, 0xe2, 0x9f, 0x78, 0x43, 0x81, 0xff, 0x59,
+	0x50, 0x34, 0x07, 0x29, 0x88, 0x1d, 0xd2, 0xe2, 0x60, 0x52, 0x40, 0xba, 0x5d, 0xe2, 0x14, 0x54,
+	0x1f, 0x6e, 0x97, 0x29, 0x27, 0x96, 0xca, 0x14, 0x6a, 0x7c, 0x27, 0x46, 0x95, 0x71, 0x38, 0x9a,
+	0x44, 0x14, 0xd6, 0x73, 0x55, 0x1f, 0x5c, 0x2e, 0xa5, 0x40, 0x4f, 0xa4, 0x0d, 0x09, 0x8e, 0x35,
+	0x44, 0x39, 0xae, 0xfb, 0x49, 0x1e, 0x6f, 0x47, 0x84, 0xf1, 0xe2, 0x3b, 0x52, 0x25, 0xfb, 0x86,
+	0x28, 0x67, 0x03, 0x5e, 0xe0, 0x51, 0xd5, 0x04, 0x93, 0x94, 0x46, 0xea, 0xe9, 0xad, 0x3e, 0xd4,
+	0x2e, 0x05, 0xee, 0x5c, 0x2a, 0x05, 0x88, 0xad, 0x9d, 0xc8, 0x80, 0x03, 0x70, 0x7f, 0x12, 0x03,
+	0xa2, 0x67, 0x52, 0xf1, 0x71, 0xe2, 0x5d, 0x09, 0xac, 0xe6, 0x87, 0x5e, 0x8d, 0x37, 0xa5, 0xd1,
+	0xbb, 0x3b, 0x79, 0xb8, 0xb5, 0xc1, 0x0c, 0xfa, 0x4f, 0xc5, 0x4a, 0xf6, 0x57, 0xa2, 0x7c, 0x73,
+	0xb8, 0xb2, 0x60, 0xc7, 0x5a, 0x2c, 0x26, 0x34, 0x29, 0x22, 0xce, 0xb7, 0x8b, 0xf8, 0x91, 0xa5,
+	0x22, 0xce, 0xcc, 0xeb, 0x24, 0xac, 0x77, 0xc1, 0xa1, 0x24, 0xac, 0x99, 0xb5, 0xd5, 0x55, 0xfe,
+	0x9e, 0x04, 0xba, 0x48, 0x93, 0x56, 0x93, 0x9d, 0x76, 0xb8, 0xd9, 0x4c, 0xde, 0xd5, 0xca, 0x50,
+	0xbe, 0xd3, 0x16, 0x2b, 0xd9, 0xd7, 0x44, 0xf9, 0x60, 0x18, 0x52, 0xd2, 0xd3, 0xa5, 0x0c, 0x69,
+	0x3a, 0xbe, 0x76, 0x0f, 0xd5, 0x0a, 0x4d, 0x6b, 0xc4, 0x2f, 0x7e, 0xcb, 0x4d, 0x4c, 0xed, 0x24,
+	0x70, 0x87, 0xe0, 0x8e, 0x24, 0x70, 0x89, 0xad, 0x55, 0x68, 0x2f, 0x4b, 0x00, 0x54, 0x9b, 0xc7,
+	0x60, 0xba, 0x21, 0x68, 0x75, 0xed, 0x70, 0x72, 0xa6, 0xe5, 0xf1, 0x0c, 0xe9, 0xcf, 0xe8, 0xe2,
+	0xdd, 0x17, 0x46, 0xba, 0xda, 0x6b, 0xd6, 0x0c, 0xda, 0x0b, 0xed, 0x42, 0x7b, 0x6c, 0xa9, 0xd0,
+	0x56, 0x6d, 0xeb, 0x24, 0x7c, 0x47, 0xe0, 0xee, 0x24, 0x7c, 0xab, 0x06, 0x57, 0x41, 0xfe, 0x4c,
+	0x02, 0x3d, 0x91, 0xf6, 0xb9, 0x26, 0x59, 0x3a, 0xae, 0x41, 0x4f, 0x1e, 0x6f, 0x47, 0x84, 0xa1,
+	0xfd, 0x0f, 0xb1, 0x92, 0x7d, 0x25, 0x84, 0x36, 0x06, 0x8a, 0x76, 0xa8, 0x11, 0xa4, 0x78, 0xbb,
+	0x5a, 0x10, 0xbe, 0x23, 0xc5, 0xdc, 0x15, 0xc8, 0xcc, 0xd4, 0x9c, 0x0e, 0x8c, 0xd6, 0x63, 0x30,
+	0x93, 0x04, 0x38, 0xb5, 0x39, 0x5f, 0x17, 0xb4, 0xff, 0x25, 0x81, 0xf5, 0xe1, 0x56, 0x3e, 0x38,
+	0xda, 0x10, 0xc0, 0x98, 0x4e, 0x41, 0x79, 0xac, 0x0d, 0x09, 0x86, 0xf8, 0x13, 0x52, 0x25, 0xfb,
+	0x7b, 0x51, 0x3e, 0xc4, 0x11, 0x9f, 0x9f, 0x45, 0xde, 0x2c, 0x72, 0x14, 0xcd, 0xf7, 0xec, 0x11,
+	0x9d, 0x8d, 0xc6, 0x5b, 0x3a, 0x7b, 0x3a, 0x40, 0xdd, 0x74, 0x15, 0xd6, 0x4c, 0xa8, 0x4c, 0xdb,
+	0x4e, 0x98, 0x01, 0x17, 0xdb, 0x65, 0xc0, 0xf1, 0xa5, 0x32, 0x00, 0xdb, 0xc9, 0xcd, 0xec, 0x24,
+	0x02, 0x8c, 0xc2, 0x74, 0x12, 0x01, 0xb0, 0xc9, 0x79, 0x6e, 0x73, 0x15, 0xff, 0x17, 0x53, 0x60,
+	0x4b, 0x7c, 0x57, 0x25, 0x9c, 0x68, 0xa5, 0xde, 0x8a, 0x6f, 0xe4, 0x94, 0xf7, 0x2f, 0x4a, 0x96,
+	0xb1, 0xe3, 0x19, 0xa9, 0x92, 0x7d, 0x5b, 0x94, 0x6f, 0x0b, 0xc7, 0x03, 0x76, 0xc9, 0x8c, 0x23,
+	0xfd, 0xfc, 0xac, 0xa9, 0xcf, 0x92, 0x87, 0xb1, 0x01, 0x81, 0xec, 0xe5, 0x5c, 0x64, 0x79, 0xea,
+	0x13, 0x6d, 0xef, 0xf0, 0xcf, 0x2e, 0x53, 0x09, 0xc7, 0xbb, 0x4d, 0x99, 0xd5, 0x9d, 0x44, 0x91,
+	0xfd, 0xf0, 0x96, 0x26, 0x15, 0x5d, 0xbe, 0xb6, 0x87, 0xb6, 0xca, 0x96, 0x17, 0x52, 0x60, 0x53,
+	0x5d, 0x2f, 0x26, 0xdc, 0xd7, 0x10, 0xec, 0xa4, 0x06, 0x5b, 0xf9, 0xc6, 0x76, 0xc5, 0x18, 0x3d,
+	0x7e, 0x2c, 0x55, 0xb2, 0xef, 0x8b, 0xf2, 0x71, 0x4e, 0x8f, 0x6a, 0xef, 0x69, 0x40, 0x08, 0x1e,
+	0x20, 0x5a, 0x3f, 0x0d, 0x7a, 0xb4, 0x6d, 0xae, 0x9c, 0x5a, 0x2a, 0x57, 0xaa, 0x76, 0x77, 0x60,
+	0x2a, 0xc9, 0xc2, 0xdb, 0x92, 0x68, 0x52, 0xdf, 0x3e, 0x1c, 0xbf, 0xd1, 0xfb, 0x6e, 0x0a, 0xc0,
+	0xfa, 0x1e, 0x55, 0xd8, 0x18, 0xf6, 0xc4, 0x2e, 0x59, 0xf9, 0xa6, 0xb6, 0xe5, 0x42, 0x87, 0x00,
+	0xaf, 0x89, 0xf2, 0x8d, 0x9c, 0x2f, 0x76, 0x75, 0x68, 0x0b, 0x84, 0x51, 0x1f, 0x6b, 0x9b, 0x19,
+	0xb9, 0xa5, 0x32, 0x23, 0x64, 0x61, 0x07, 0x52, 0x63, 0x12, 0xde, 0x9e, 0x44, 0x8d, 0x90, 0xe1,
+	0x8d, 0xb9, 0xf1, 0xb9, 0x04, 0xd6, 0x47, 0xae, 0xcc, 0x1b, 0x97, 0x1d, 0x31, 0xad, 0xc5, 0xf2,
+	0x58, 0x1b, 0x12, 0x8c, 0x09, 0x8f, 0x4a, 0x95, 0xec, 0xcb, 0xa2, 0xbc, 0x37, 0x9c, 0x58, 0xd8,
+	0x2d, 0xbb, 0x42, 0xae, 0xde, 0x1b, 0xf1, 0xe0, 0x8b, 0xaf, 0x32, 0x98, 0x69, 0xc4, 0xb2, 0x4e,
+	0x22, 0xc0, 0xad, 0x70, 0x22, 0x89, 0x00, 0x91, 0x46, 0x86, 0x78, 0xe8, 0x7f, 0x91, 0x02, 0x1b,
+	0x6b, 0x1a, 0xa2, 0x9b, 0xdc, 0x34, 0xc4, 0xf7, 0x72, 0xcb, 0x7b, 0xdb, 0x13, 0x62, 0x1c, 0xf8,
+	0xa5, 0x54, 0xc9, 0x7e, 0x2a, 0xca, 0x3a, 0xe7, 0x00, 0x8f, 0x00, 0xd1, 0xd3, 0x5f, 0x25, 0xe8,
+	0xf2, 0x6a, 0x94, 0x4b, 0x34, 0x0a, 0x3f, 0xb2, 0x0c, 0x5e, 0x82, 0x44, 0xd8, 0x74, 0x25, 0xae,
+	0x18, 0xf8, 0x67, 0x74, 0x60, 0xdc, 0xb8, 0x0d, 0x1e, 0x48, 0xa2, 0x0d, 0xb7, 0xba, 0x71, 0xd0,
+	0xf8, 0x5d, 0x0a, 0xc0, 0xfa, 0x9e, 0xd9, 0x26, 0x09, 0x25, 0xb1, 0x0f, 0x57, 0xbe, 0xa9, 0x6d,
+	0x39, 0x46, 0xa1, 0xdf, 0x4a, 0x95, 0xec, 0x25, 0x49, 0xbe, 0x10, 0x14, 0x20, 0xf6, 0x7c, 0x40,
+	0xa3, 0x79, 0xdb, 0x2f, 0x1a, 0x51, 0x02, 0x35, 0x61, 0xc9, 0xb0, 0x62, 0x5a, 0x7a, 0xd1, 0x37,
+	0xf8, 0x0d, 0x56, 0x70, 0xc7, 0x6f, 0xdb, 0x45, 0x97, 0x10, 0x84, 0xf6, 0x8e, 0x50, 0x5e, 0xb2,
+	0xde, 0xc2, 0x2b, 0x91, 0x95, 0x5c, 0xe6, 0x91, 0x6a, 0x93, 0xf0, 0x97, 0xe4, 0xb0, 0x83, 0x1b,
+	0x1e, 0x6a, 0x6f, 0x86, 0x3f, 0x49, 0x81, 0xde, 0xda, 0x7e, 0x42, 0xb8, 0xb7, 0xe5, 0x94, 0x12,
+	0x6a, 0x4f, 0x94, 0xf7, 0xb5, 0x29, 0xc5, 0x58, 0xf4, 0xbc, 0x54, 0xc9, 0x7e, 0x22, 0xca, 0xf7,
+	0x27, 0x27, 0x23, 0xde, 0x47, 0xa7, 0x38, 0x78, 0xcf, 0x46, 0x43, 0x13, 0xee, 0x6c, 0x4c, 0x2b,
+	0x07, 0x51, 0xd9, 0x41, 0x84, 0x5d, 0x13, 0x8a, 0xef, 0x22, 0x25, 0xae, 0x4d, 0x51, 0x31, 0x2d,
+	0xd7, 0x43, 0x9a, 0xa1, 0x7e, 0xa5, 0x6d, 0xaa, 0x9c, 0x5c, 0xce, 0xcc, 0x85, 0x2d, 0xee, 0x24,
+	0xa2, 0x0c, 0xc3, 0x5d, 0x2d, 0x65, 0x2f, 0xd2, 0x44, 0xfa, 0x75, 0x51, 0x80, 0xff, 0x94, 0x40,
+	0x5f, 0x9c, 0x83, 0xe1, 0xcd, 0xad, 0x03, 0x1f, 0x6d, 0x2f, 0x95, 0x6f, 0x59, 0x84, 0x24, 0xa3,
+	0xcd, 0x27, 0x62, 0x25, 0xfb, 0x92, 0x28, 0xab, 0xcd, 0x69, 0xa3, 0x7e, 0xad, 0x6d, 0xe0, 0xcf,
+	0x2c, 0x2b, 0xf0, 0xcc, 0x8e, 0x2f, 0xc9, 0x01, 0x49, 0x7c, 0x0f, 0x2b, 0x3e, 0x20, 0x59, 0x1b,
+	0x74, 0xcf, 0xc2, 0xc6, 0xed, 0x2d, 0xb5, 0xdd, 0xba, 0x72, 0xba, 0xd5, 0xe1, 0x0c, 0xdc, 0x57,
+	0xa4, 0x4a, 0xf6, 0x49, 0x49, 0xbe, 0x24, 0x84, 0xd1, 0x2d, 0x6a, 0x2e, 0x87, 0xd6, 0x33, 0x4b,
+	0x68, 0xb8, 0x01, 0xe4, 0xf4, 0x1d, 0x72, 0x3d, 0xb3, 0x44, 0x52, 0x0f, 0xee, 0x81, 0x0d, 0x89,
+	0x06, 0x17, 0xdf, 0x51, 0x69, 0xcb, 0x2f, 0x15, 0x90, 0xe3, 0x2a, 0x85, 0x85, 0xa0, 0x95, 0x02,
+	0xa7, 0x5d, 0x85, 0xd6, 0xbd, 0xff, 0xd7, 0x2e, 0x89, 0xa6, 0x96, 0x5c, 0xc3, 0x60, 0xab, 0x4c,
+	0x6b, 0xda, 0xee, 0x24, 0xe6, 0xfc, 0x07, 0x54, 0x13, 0xab, 0x17, 0xc2, 0x18, 0x6c, 0xf0, 0xe4,
+	0x91, 0xd7, 0x2f, 0x0f, 0x08, 0x6f, 0x5e, 0x1e, 0x10, 0x3e, 0xba, 0x3c, 0x20, 0x3c, 0xf9, 0xf1,
+	0xc0, 0xaa, 0x37, 0x3f, 0x1e, 0x58, 0xf5, 0xde, 0xc7, 0x03, 0xab, 0xee, 0x1b, 0x69, 0xec, 0x8d,
+	0x6a, 0xa3, 0x2b, 0xe9, 0x94, 0x2f, 0x74, 0x93, 0xc6, 0xe6, 0x3d, 0xff, 0x1e, 0x00, 0x9d, 0x48,
+	0x13, 0x18, 0x83, 0x4d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the farming module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
+	// Stakings returns all stakings by a farmer.
+	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
+	// StakingsByDenom returns all farmers staking a staking coin denom.
+	StakingsByDenom(ctx context.Context, in *QueryStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryStakingsByDenomResponse, error)
+	// QueuedStakings returns queued stakings by a farmer and the time the next epoch is expected to end.
+	QueuedStakings(ctx context.Context, in *QueryQueuedStakingsRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsResponse, error)
+	// TotalStakings returns total staking amount for a staking coin denom
+	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
+	// Rewards returns rewards for a farmer
+	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
+	// Locks returns all locks by a farmer.
+	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// Unbondings returns all unbondings by a farmer.
+	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
+	// VestedRewards returns the vesting rewards of a farmer.
+	VestedRewards(ctx context.Context, in *QueryVestedRewardsRequest, opts ...grpc.CallOption) (*QueryVestedRewardsResponse, error)
+	// AutoCompound returns the auto-compounding setting of a farmer.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
+	// RewardsWithdrawAddress returns the rewards withdraw address of a farmer.
+	RewardsWithdrawAddress(ctx context.Context, in *QueryRewardsWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardsWithdrawAddressResponse, error)
+	// HistoricalRewards returns historical rewards for a staking coin denom.
+	HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error)
+	// OutstandingRewards returns outstanding rewards for a staking coin denom.
+	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
+	// CurrentEpoch returns the current epoch for a staking coin denom.
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// ExpectedRewards returns rewards expected to be allocated for a staking coin denom at the end of the current epoch.
+	ExpectedRewards(ctx context.Context, in *QueryExpectedRewardsRequest, opts ...grpc.CallOption) (*QueryExpectedRewardsResponse, error)
+	// SimulateAllocation returns how rewards would be allocated at the end of the current epoch.
+	SimulateAllocation(ctx context.Context, in *QuerySimulateAllocationRequest, opts ...grpc.CallOption) (*QuerySimulateAllocationResponse, error)
+	// CurrentEpochDays returns current epoch duration rounded to days.
+	// Deprecated: use CurrentEpochDuration instead.
+	CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error)
+	// CurrentEpochDuration returns current epoch duration.
+	CurrentEpochDuration(ctx context.Context, in *QueryCurrentEpochDurationRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDurationResponse, error)
+	// EpochInfo returns information about the current epoch.
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
+	out := new(QueryPlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
+	out := new(QueryPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
+	out := new(QueryStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingsByDenom(ctx context.Context, in *QueryStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryStakingsByDenomResponse, error) {
+	out := new(QueryStakingsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/StakingsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedStakings(ctx context.Context, in *QueryQueuedStakingsRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsResponse, error) {
+	out := new(QueryQueuedStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/QueuedStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
+	out := new(QueryTotalStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
+	out := new(QueryLocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Locks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
+	out := new(QueryUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Unbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestedRewards(ctx context.Context, in *QueryVestedRewardsRequest, opts ...grpc.CallOption) (*QueryVestedRewardsResponse, error) {
+	out := new(QueryVestedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/VestedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsWithdrawAddress(ctx context.Context, in *QueryRewardsWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardsWithdrawAddressResponse, error) {
+	out := new(QueryRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/RewardsWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error) {
+	out := new(QueryHistoricalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/HistoricalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
+	out := new(QueryOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/OutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpectedRewards(ctx context.Context, in *QueryExpectedRewardsRequest, opts ...grpc.CallOption) (*QueryExpectedRewardsResponse, error) {
+	out := new(QueryExpectedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/ExpectedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAllocation(ctx context.Context, in *QuerySimulateAllocationRequest, opts ...grpc.CallOption) (*QuerySimulateAllocationResponse, error) {
+	out := new(QuerySimulateAllocationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/SimulateAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *queryClient) CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error) {
+	out := new(QueryCurrentEpochDaysResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpochDuration(ctx context.Context, in *QueryCurrentEpochDurationRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDurationResponse, error) {
+	out := new(QueryCurrentEpochDurationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the farming module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
+	// Stakings returns all stakings by a farmer.
+	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
+	// StakingsByDenom returns all farmers staking a staking coin denom.
+	StakingsByDenom(context.Context, *QueryStakingsByDenomRequest) (*QueryStakingsByDenomResponse, error)
+	// QueuedStakings returns queued stakings by a farmer and the time the next epoch is expected to end.
+	QueuedStakings(context.Context, *QueryQueuedStakingsRequest) (*QueryQueuedStakingsResponse, error)
+	// TotalStakings returns total staking amount for a staking coin denom
+	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
+	// Rewards returns rewards for a farmer
+	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
+	// Locks returns all locks by a farmer.
+	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// Unbondings returns all unbondings by a farmer.
+	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
+	// VestedRewards returns the vesting rewards of a farmer.
+	VestedRewards(context.Context, *QueryVestedRewardsRequest) (*QueryVestedRewardsResponse, error)
+	// AutoCompound returns the auto-compounding setting of a farmer.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
+	// RewardsWithdrawAddress returns the rewards withdraw address of a farmer.
+	RewardsWithdrawAddress(context.Context, *QueryRewardsWithdrawAddressRequest) (*QueryRewardsWithdrawAddressResponse, error)
+	// HistoricalRewards returns historical rewards for a staking coin denom.
+	HistoricalRewards(context.Context, *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error)
+	// OutstandingRewards returns outstanding rewards for a staking coin denom.
+	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
+	// CurrentEpoch returns the current epoch for a staking coin denom.
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// ExpectedRewards returns rewards expected to be allocated for a staking coin denom at the end of the current epoch.
+	ExpectedRewards(context.Context, *QueryExpectedRewardsRequest) (*QueryExpectedRewardsResponse, error)
+	// SimulateAllocation returns how rewards would be allocated at the end of the current epoch.
+	SimulateAllocation(context.Context, *QuerySimulateAllocationRequest) (*QuerySimulateAllocationResponse, error)
+	// CurrentEpochDays returns current epoch duration rounded to days.
+	// Deprecated: use CurrentEpochDuration instead.
+	CurrentEpochDays(context.Context, *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error)
+	// CurrentEpochDuration returns current epoch duration.
+	CurrentEpochDuration(context.Context, *QueryCurrentEpochDurationRequest) (*QueryCurrentEpochDurationResponse, error)
+	// EpochInfo returns information about the current epoch.
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+}
+func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+}
+func (*UnimplementedQueryServer) StakingsByDenom(ctx context.Context, req *QueryStakingsByDenomRequest) (*QueryStakingsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) QueuedStakings(ctx context.Context, req *QueryQueuedStakingsRequest) (*QueryQueuedStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedStakings not implemented")
+}
+func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+}
+func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
+func (*UnimplementedQueryServer) Locks(ctx context.Context, req *QueryLocksRequest) (*QueryLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+}
+func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+}
+func (*UnimplementedQueryServer) VestedRewards(ctx context.Context, req *QueryVestedRewardsRequest) (*QueryVestedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestedRewards not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompound(ctx context.Context, req *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) RewardsWithdrawAddress(ctx context.Context, req *QueryRewardsWithdrawAddressRequest) (*QueryRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalRewards(ctx context.Context, req *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRewards not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (*UnimplementedQueryServer) ExpectedRewards(ctx context.Context, req *QueryExpectedRewardsRequest) (*QueryExpectedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpectedRewards not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAllocation(ctx context.Context, req *QuerySimulateAllocationRequest) (*QuerySimulateAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAllocation not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpochDays(ctx context.Context, req *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDays not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpochDuration(ctx context.Context, req *QueryCurrentEpochDurationRequest) (*QueryCurrentEpochDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDuration not implemented")
+}
+func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/StakingsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingsByDenom(ctx, req.(*QueryStakingsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/QueuedStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedStakings(ctx, req.(*QueryQueuedStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Locks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Locks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Unbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/VestedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestedRewards(ctx, req.(*QueryVestedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/RewardsWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsWithdrawAddress(ctx, req.(*QueryRewardsWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/HistoricalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalRewards(ctx, req.(*QueryHistoricalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/OutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpectedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpectedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpectedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/ExpectedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpectedRewards(ctx, req.(*QueryExpectedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/SimulateAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAllocation(ctx, req.(*QuerySimulateAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpochDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpochDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpochDays(ctx, req.(*QueryCurrentEpochDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpochDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpochDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpochDuration(ctx, req.(*QueryCurrentEpochDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.farming.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Plans",
+			Handler:    _Query_Plans_Handler,
+		},
+		{
+			MethodName: "Plan",
+			Handler:    _Query_Plan_Handler,
+		},
+		{
+			MethodName: "Stakings",
+			Handler:    _Query_Stakings_Handler,
+		},
+		{
+			MethodName: "StakingsByDenom",
+			Handler:    _Query_StakingsByDenom_Handler,
+		},
+		{
+			MethodName: "QueuedStakings",
+			Handler:    _Query_QueuedStakings_Handler,
+		},
+		{
+			MethodName: "TotalStakings",
+			Handler:    _Query_TotalStakings_Handler,
+		},
+		{
+			MethodName: "Rewards",
+			Handler:    _Query_Rewards_Handler,
+		},
+		{
+			MethodName: "Locks",
+			Handler:    _Query_Locks_Handler,
+		},
+		{
+			MethodName: "Unbondings",
+			Handler:    _Query_Unbondings_Handler,
+		},
+		{
+			MethodName: "VestedRewards",
+			Handler:    _Query_VestedRewards_Handler,
+		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
+		{
+			MethodName: "RewardsWithdrawAddress",
+			Handler:    _Query_RewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "HistoricalRewards",
+			Handler:    _Query_HistoricalRewards_Handler,
+		},
+		{
+			MethodName: "OutstandingRewards",
+			Handler:    _Query_OutstandingRewards_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "ExpectedRewards",
+			Handler:    _Query_ExpectedRewards_Handler,
+		},
+		{
+			MethodName: "SimulateAllocation",
+			Handler:    _Query_SimulateAllocation_Handler,
+		},
+		{
+			MethodName: "CurrentEpochDays",
+			Handler:    _Query_CurrentEpochDays_Handler,
+		},
+		{
+			MethodName: "CurrentEpochDuration",
+			Handler:    _Query_CurrentEpochDuration_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/farming/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Terminated) > 0 {
+		i -= len(m.Terminated)
+		copy(dAtA[i:], m.Terminated)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QueuedCoins) > 0 {
+		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakedCoins) > 0 {
+		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakings) > 0 {
+		for iNdEx := len(m.Stakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FarmerStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmerStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmerStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QueuedAmount.Size()
+		i -= size
+		if _, err := m.QueuedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakedAmount.Size()
+		i -= size
+		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEpochTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedCoins) > 0 {
+		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanRewards) > 0 {
+		for iNdEx := len(m.PlanRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricalRewards) > 0 {
+		for iNdEx := len(m.HistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingAmount) > 0 {
+		i -= len(m.StakingAmount)
+		copy(dAtA[i:], m.StakingAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanRewards) > 0 {
+		for iNdEx := len(m.PlanRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.StakedAmount.Size()
+		i -= size
+		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalStakings.Size()
+		i -= size
+		if _, err := m.TotalStakings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpectedPlanRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpectedPlanRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpectedPlanRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SufficientBalance {
+		i--
+		if m.SufficientBalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllocationAmount) > 0 {
+		for iNdEx := len(m.AllocationAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAllocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAllocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAllocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PlanAllocations) > 0 {
+		for iNdEx := len(m.PlanAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FarmingPoolAllocations) > 0 {
+		for iNdEx := len(m.FarmingPoolAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FarmingPoolAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FarmingPoolAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmingPoolAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmingPoolAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllocatedAmount) > 0 {
+		for iNdEx := len(m.AllocatedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocatedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomUnitRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomUnitRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomUnitRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalStakings.Size()
+		i -= size
+		if _, err := m.TotalStakings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDaysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDaysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpochDays != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochDays))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentEpochs) > 0 {
+		for iNdEx := len(m.CurrentEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextEpochTime != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintQuery(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x12
+	if m.LastEpochTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintQuery(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomCurrentEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCurrentEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCurrentEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Terminated)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakedCoins) > 0 {
+		for _, e := range m.StakedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedCoins) > 0 {
+		for _, e := range m.QueuedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakings) > 0 {
+		for _, e := range m.Stakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FarmerStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QueuedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueuedStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedCoins) > 0 {
+		for _, e := range m.QueuedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PlanRewards) > 0 {
+		for _, e := range m.PlanRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricalRewards) > 0 {
+		for _, e := range m.HistoricalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryExpectedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalStakings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PlanRewards) > 0 {
+		for _, e := range m.PlanRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExpectedPlanRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllocationAmount) > 0 {
+		for _, e := range m.AllocationAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SufficientBalance {
+		n += 2
+	}
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateAllocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySimulateAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FarmingPoolAllocations) > 0 {
+		for _, e := range m.FarmingPoolAllocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PlanAllocations) > 0 {
+		for _, e := range m.PlanAllocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FarmingPoolAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PlanAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AllocatedAmount) > 0 {
+		for _, e := range m.AllocatedAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomUnitRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalStakings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochDaysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochDaysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpochDays != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochDays))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochDurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochDurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CurrentEpochs) > 0 {
+		for _, e := range m.CurrentEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomCurrentEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terminated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &types.Any{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &types.Any{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
+			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
+			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakings = append(m.Stakings, FarmerStaking{})
+			if err := m.Stakings[len(m.Stakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FarmerStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmerStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmerStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
+			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanRewards = append(m.PlanRewards, PlanRewards{})
+			if err := m.PlanRewards[len(m.PlanRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vestings = append(m.Vestings, RewardVesting{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types1.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types1.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRewards = append(m.HistoricalRewards, HistoricalRewardsResponse{})
+			if err := m.HistoricalRewards[len(m.HistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types1.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStakings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitRewards = append(m.UnitRewards, types1.DecCoin{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanRewards = append(m.PlanRewards, ExpectedPlanRewards{})
+			if err := m.PlanRewards[len(m.PlanRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpectedPlanRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpectedPlanRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpectedPlanRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationAmount = append(m.AllocationAmount, types1.Coin{})
+			if err := m.AllocationAmount[len(m.AllocationAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SufficientBalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SufficientBalance = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitRewards = append(m.UnitRewards, types1.DecCoin{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAllocationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,10 +11377,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAllocationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1944,7 +11404,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,15 +11427,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAllocations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2002,7 +11462,76 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FarmingPoolAllocations = append(m.FarmingPoolAllocations, FarmingPoolAllocation{})
+			if err := m.FarmingPoolAllocations[len(m.FarmingPoolAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanAllocations = append(m.PlanAllocations, PlanAllocation{})
+			if err := m.PlanAllocations[len(m.PlanAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitRewards = append(m.UnitRewards, DenomUnitRewards{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2027,7 +11556,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *FarmingPoolAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,15 +11579,15 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FarmingPoolAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FarmingPoolAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,13 +11615,13 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2102,29 +11631,31 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2134,29 +11665,31 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			m.TotalAmount = append(m.TotalAmount, types1.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2166,27 +11699,15 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Skipped = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2214,43 +11735,7 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Terminated = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2273,7 +11758,7 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+func (m *PlanAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,15 +11781,100 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2331,16 +11901,36 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plans = append(m.Plans, &types.Any{})
-			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllocatedAmount = append(m.AllocatedAmount, types1.Coin{})
+			if err := m.AllocatedAmount[len(m.AllocatedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2350,27 +11940,23 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2393,7 +11979,7 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomUnitRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,17 +12002,17 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomUnitRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomUnitRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			m.PlanId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2436,64 +12022,61 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlanId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakings", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalStakings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2520,10 +12103,8 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &types.Any{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnitRewards = append(m.UnitRewards, types1.DecCoin{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2548,7 +12129,7 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2571,76 +12152,12 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2662,7 +12179,7 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2685,51 +12202,17 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
-			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
 			}
-			var msglen int
+			m.CurrentEpochDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2739,26 +12222,61 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentEpochDays |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
-			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochDurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2780,7 +12298,7 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochDurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,17 +12321,17 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochDurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochDurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2823,23 +12341,24 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2862,7 +12381,7 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2885,15 +12404,15 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2921,9 +12440,7 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2946,7 +12463,7 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2969,17 +12486,17 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2989,29 +12506,33 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			if m.LastEpochTime == nil {
+				m.LastEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3021,77 +12542,64 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3118,8 +12626,8 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types1.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrentEpochs = append(m.CurrentEpochs, DenomCurrentEpoch{})
+			if err := m.CurrentEpochs[len(m.CurrentEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3144,7 +12652,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomCurrentEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3167,67 +12675,49 @@ func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomCurrentEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomCurrentEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
 			}
-			m.CurrentEpochDays = 0
+			m.CurrentEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3237,7 +12727,7 @@ func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochDays |= uint32(b&0x7F) << shift
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16,6 +16,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -51,6 +52,15 @@ type MsgCreateFixedAmountPlan struct {
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// epoch_amount specifies the distributing amount for each epoch
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// vesting_duration specifies the duration over which the rewards unlock
+	// linearly; zero means the rewards are paid out immediately
+	VestingDuration time.Duration `protobuf:"bytes,7,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// max_stake_per_farmer specifies the maximum amount of a farmer's stake
+	// counted toward the plan for each staking coin denom; zero means no limit
+	MaxStakePerFarmer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_stake_per_farmer,json=maxStakePerFarmer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake_per_farmer" yaml:"max_stake_per_farmer"`
+	// max_total_stake specifies the maximum total amount of stakes counted
+	// toward the plan for each staking coin denom; zero means no limit
+	MaxTotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_total_stake,json=maxTotalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_stake" yaml:"max_total_stake"`
 }
 
 func (m *MsgCreateFixedAmountPlan) Reset()         { *m = MsgCreateFixedAmountPlan{} }
@@ -139,6 +149,15 @@ type MsgCreateRatioPlan struct {
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// vesting_duration specifies the duration over which the rewards unlock
+	// linearly; zero means the rewards are paid out immediately
+	VestingDuration time.Duration `protobuf:"bytes,7,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// max_stake_per_farmer specifies the maximum amount of a farmer's stake
+	// counted toward the plan for each staking coin denom; zero means no limit
+	MaxStakePerFarmer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_stake_per_farmer,json=maxStakePerFarmer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake_per_farmer" yaml:"max_stake_per_farmer"`
+	// max_total_stake specifies the maximum total amount of stakes counted
+	// toward the plan for each staking coin denom; zero means no limit
+	MaxTotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_total_stake,json=maxTotalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_stake" yaml:"max_total_stake"`
 }
 
 func (m *MsgCreateRatioPlan) Reset()         { *m = MsgCreateRatioPlan{} }
@@ -212,19 +231,125 @@ func (m *MsgCreateRatioPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateRatioPlanResponse proto.InternalMessageInfo
 
+// MsgCreateDecayingAmountPlan defines a SDK message for creating a new decaying
+// amount farming plan.
+type MsgCreateDecayingAmountPlan struct {
+	// name specifies the name for the plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// creator defines the bech32-encoded address of the creator for the private plan, termination address is also set to
+	// this creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staking_coin_weights specifies coins weight for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// epoch_amount specifies the distributing amount for the first decay period
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_factor specifies the factor that the epoch amount is multiplied by
+	// for every decay period
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period specifies the number of epochs between decays
+	DecayPeriod uint32 `protobuf:"varint,8,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
+	// vesting_duration specifies the duration over which the rewards unlock
+	// linearly; zero means the rewards are paid out immediately
+	VestingDuration time.Duration `protobuf:"bytes,9,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	// max_stake_per_farmer specifies the maximum amount of a farmer's stake
+	// counted toward the plan for each staking coin denom; zero means no limit
+	MaxStakePerFarmer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_stake_per_farmer,json=maxStakePerFarmer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake_per_farmer" yaml:"max_stake_per_farmer"`
+	// max_total_stake specifies the maximum total amount of stakes counted
+	// toward the plan for each staking coin denom; zero means no limit
+	MaxTotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_total_stake,json=maxTotalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_stake" yaml:"max_total_stake"`
+}
+
+func (m *MsgCreateDecayingAmountPlan) Reset()         { *m = MsgCreateDecayingAmountPlan{} }
+func (m *MsgCreateDecayingAmountPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingAmountPlan) ProtoMessage()    {}
+func (*MsgCreateDecayingAmountPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+}
+func (m *MsgCreateDecayingAmountPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingAmountPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingAmountPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingAmountPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingAmountPlan.Merge(m, src)
+}
+func (m *MsgCreateDecayingAmountPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingAmountPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingAmountPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingAmountPlan proto.InternalMessageInfo
+
+// MsgCreateDecayingAmountPlanResponse defines the Msg/MsgCreateDecayingAmountPlanResponse
+// response type.
+type MsgCreateDecayingAmountPlanResponse struct {
+}
+
+func (m *MsgCreateDecayingAmountPlanResponse) Reset()         { *m = MsgCreateDecayingAmountPlanResponse{} }
+func (m *MsgCreateDecayingAmountPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingAmountPlanResponse) ProtoMessage()    {}
+func (*MsgCreateDecayingAmountPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+}
+func (m *MsgCreateDecayingAmountPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingAmountPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingAmountPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingAmountPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingAmountPlanResponse.Merge(m, src)
+}
+func (m *MsgCreateDecayingAmountPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingAmountPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingAmountPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingAmountPlanResponse proto.InternalMessageInfo
+
 // MsgStake defines a SDK message for staking coins into the farming plan.
 type MsgStake struct {
 	// farmer defines the bech32-encoded address of the farmer
 	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	// staking_coins specifies coins to stake
 	StakingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=staking_coins,json=stakingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_coins" yaml:"staking_coins"`
+	// lock_duration specifies the duration to lock the staking coins for;
+	// zero means the coins are staked without a lock
+	LockDuration time.Duration `protobuf:"bytes,3,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
 }
 
 func (m *MsgStake) Reset()         { *m = MsgStake{} }
 func (m *MsgStake) String() string { return proto.CompactTextString(m) }
 func (*MsgStake) ProtoMessage()    {}
 func (*MsgStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+	return fileDescriptor_a33d9a3ff13f514a, []int{6}
 }
 func (m *MsgStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +386,7 @@ func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
 func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeResponse) ProtoMessage()    {}
 func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+	return fileDescriptor_a33d9a3ff13f514a, []int{7}
 }
 func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +415,88 @@ func (m *MsgStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStakeResponse proto.InternalMessageInfo
 
+// MsgStakeFor defines a SDK message for staking coins on behalf of another
+// farmer. The payer pays the staking coins and the farmer owns the staking
+// and its rewards.
+type MsgStakeFor struct {
+	// payer defines the bech32-encoded address of the account paying the staking coins
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// farmer defines the bech32-encoded address of the farmer who owns the staking
+	Farmer string `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// staking_coins specifies coins to stake
+	StakingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=staking_coins,json=stakingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_coins" yaml:"staking_coins"`
+}
+
+func (m *MsgStakeFor) Reset()         { *m = MsgStakeFor{} }
+func (m *MsgStakeFor) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeFor) ProtoMessage()    {}
+func (*MsgStakeFor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{8}
+}
+func (m *MsgStakeFor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeFor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeFor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStakeFor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeFor.Merge(m, src)
+}
+func (m *MsgStakeFor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeFor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeFor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStakeFor proto.InternalMessageInfo
+
+// MsgStakeForResponse defines the Msg/StakeFor response type.
+type MsgStakeForResponse struct {
+}
+
+func (m *MsgStakeForResponse) Reset()         { *m = MsgStakeForResponse{} }
+func (m *MsgStakeForResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeForResponse) ProtoMessage()    {}
+func (*MsgStakeForResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{9}
+}
+func (m *MsgStakeForResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeForResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeForResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStakeForResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeForResponse.Merge(m, src)
+}
+func (m *MsgStakeForResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeForResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeForResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStakeForResponse proto.InternalMessageInfo
+
 // MsgUnstake defines a SDK message for performing unstaking of coins from the
 // farming plan.
 type MsgUnstake struct {
@@ -303,7 +510,7 @@ func (m *MsgUnstake) Reset()         { *m = MsgUnstake{} }
 func (m *MsgUnstake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstake) ProtoMessage()    {}
 func (*MsgUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{6}
+	return fileDescriptor_a33d9a3ff13f514a, []int{10}
 }
 func (m *MsgUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +547,7 @@ func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
 func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeResponse) ProtoMessage()    {}
 func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{7}
+	return fileDescriptor_a33d9a3ff13f514a, []int{11}
 }
 func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,6 +576,87 @@ func (m *MsgUnstakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakeResponse proto.InternalMessageInfo
 
+// MsgTransferStaking defines a SDK message for transferring staked and queued
+// coins of a farmer to another farmer.
+type MsgTransferStaking struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// recipient defines the bech32-encoded address of the farmer receiving the staking
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// staking_coins specifies coins to transfer
+	StakingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=staking_coins,json=stakingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_coins" yaml:"staking_coins"`
+}
+
+func (m *MsgTransferStaking) Reset()         { *m = MsgTransferStaking{} }
+func (m *MsgTransferStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStaking) ProtoMessage()    {}
+func (*MsgTransferStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{12}
+}
+func (m *MsgTransferStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStaking.Merge(m, src)
+}
+func (m *MsgTransferStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferStaking proto.InternalMessageInfo
+
+// MsgTransferStakingResponse defines the Msg/TransferStaking response type.
+type MsgTransferStakingResponse struct {
+}
+
+func (m *MsgTransferStakingResponse) Reset()         { *m = MsgTransferStakingResponse{} }
+func (m *MsgTransferStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStakingResponse) ProtoMessage()    {}
+func (*MsgTransferStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{13}
+}
+func (m *MsgTransferStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStakingResponse.Merge(m, src)
+}
+func (m *MsgTransferStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStakingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferStakingResponse proto.InternalMessageInfo
+
 // MsgHarvest defines a SDK message for claiming rewards from the farming plan.
 type MsgHarvest struct {
 	// farmer defines the bech32-encoded address of the farmer
@@ -382,7 +670,7 @@ func (m *MsgHarvest) Reset()         { *m = MsgHarvest{} }
 func (m *MsgHarvest) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvest) ProtoMessage()    {}
 func (*MsgHarvest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{8}
+	return fileDescriptor_a33d9a3ff13f514a, []int{14}
 }
 func (m *MsgHarvest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +707,7 @@ func (m *MsgHarvestResponse) Reset()         { *m = MsgHarvestResponse{} }
 func (m *MsgHarvestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvestResponse) ProtoMessage()    {}
 func (*MsgHarvestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{9}
+	return fileDescriptor_a33d9a3ff13f514a, []int{15}
 }
 func (m *MsgHarvestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +746,7 @@ func (m *MsgRemovePlan) Reset()         { *m = MsgRemovePlan{} }
 func (m *MsgRemovePlan) String() string { return proto.CompactTextString(m) }
 func (*MsgRemovePlan) ProtoMessage()    {}
 func (*MsgRemovePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{10}
+	return fileDescriptor_a33d9a3ff13f514a, []int{16}
 }
 func (m *MsgRemovePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +783,7 @@ func (m *MsgRemovePlanResponse) Reset()         { *m = MsgRemovePlanResponse{} }
 func (m *MsgRemovePlanResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemovePlanResponse) ProtoMessage()    {}
 func (*MsgRemovePlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{11}
+	return fileDescriptor_a33d9a3ff13f514a, []int{17}
 }
 func (m *MsgRemovePlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,24 +812,42 @@ func (m *MsgRemovePlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemovePlanResponse proto.InternalMessageInfo
 
-// MsgAdvanceEpoch defines a message to advance epoch by one.
-type MsgAdvanceEpoch struct {
-	// requester defines the bech32-encoded address of the requester
-	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+// MsgModifyPrivatePlan defines a message for modifying a live private plan.
+// Only the fields that are provided are changed.
+type MsgModifyPrivatePlan struct {
+	// creator defines the bech32-encoded address of the creator of the plan,
+	// which is the termination address of the plan
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" yaml:"plan_id"`
+	// staking_coin_weights specifies new coin weights for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// end_time specifies the new end time of the plan, which must be after
+	// the current end time
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// epoch_amount specifies the new distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// epoch_ratio specifies the new distributing amount by ratio
+	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor that the epoch amount is multiplied by
+	// for every decay period
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period specifies the number of epochs between decays
+	DecayPeriod uint32 `protobuf:"varint,8,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
 }
 
-func (m *MsgAdvanceEpoch) Reset()         { *m = MsgAdvanceEpoch{} }
-func (m *MsgAdvanceEpoch) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceEpoch) ProtoMessage()    {}
-func (*MsgAdvanceEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{12}
+func (m *MsgModifyPrivatePlan) Reset()         { *m = MsgModifyPrivatePlan{} }
+func (m *MsgModifyPrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyPrivatePlan) ProtoMessage()    {}
+func (*MsgModifyPrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{18}
 }
-func (m *MsgAdvanceEpoch) XXX_Unmarshal(b []byte) error {
+func (m *MsgModifyPrivatePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgModifyPrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceEpoch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgModifyPrivatePlan.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,34 +857,34 @@ func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceEpoch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceEpoch.Merge(m, src)
+func (m *MsgModifyPrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyPrivatePlan.Merge(m, src)
 }
-func (m *MsgAdvanceEpoch) XXX_Size() int {
+func (m *MsgModifyPrivatePlan) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceEpoch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceEpoch.DiscardUnknown(m)
+func (m *MsgModifyPrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyPrivatePlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceEpoch proto.InternalMessageInfo
+var xxx_messageInfo_MsgModifyPrivatePlan proto.InternalMessageInfo
 
-// MsgAdvanceEpochResponse defines the Msg/AdvanceEpoch response type.
-type MsgAdvanceEpochResponse struct {
+// MsgModifyPrivatePlanResponse defines the Msg/ModifyPrivatePlan response type.
+type MsgModifyPrivatePlanResponse struct {
 }
 
-func (m *MsgAdvanceEpochResponse) Reset()         { *m = MsgAdvanceEpochResponse{} }
-func (m *MsgAdvanceEpochResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceEpochResponse) ProtoMessage()    {}
-func (*MsgAdvanceEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{13}
+func (m *MsgModifyPrivatePlanResponse) Reset()         { *m = MsgModifyPrivatePlanResponse{} }
+func (m *MsgModifyPrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyPrivatePlanResponse) ProtoMessage()    {}
+func (*MsgModifyPrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{19}
 }
-func (m *MsgAdvanceEpochResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgModifyPrivatePlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgModifyPrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceEpochResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgModifyPrivatePlanResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,107 +894,473 @@ func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceEpochResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceEpochResponse.Merge(m, src)
+func (m *MsgModifyPrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyPrivatePlanResponse.Merge(m, src)
 }
-func (m *MsgAdvanceEpochResponse) XXX_Size() int {
+func (m *MsgModifyPrivatePlanResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceEpochResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceEpochResponse.DiscardUnknown(m)
+func (m *MsgModifyPrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyPrivatePlanResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceEpochResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgModifyPrivatePlanResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
-	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
-	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
-	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
-	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
-	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
-	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
-	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
-	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
-	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
-	proto.RegisterType((*MsgRemovePlan)(nil), "cosmos.farming.v1beta1.MsgRemovePlan")
-	proto.RegisterType((*MsgRemovePlanResponse)(nil), "cosmos.farming.v1beta1.MsgRemovePlanResponse")
-	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
-	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
+// MsgSetAutoCompound defines a message for enabling or disabling
+// auto-compounding of rewards.
+// When enabled, rewards in the denoms that can be staked are withdrawn and
+// staked again as queued coins at the end of every epoch.
+type MsgSetAutoCompound struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// enabled specifies whether auto-compounding is enabled
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{20}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
 }
 
-var fileDescriptor_a33d9a3ff13f514a = []byte{
-	// 912 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0x36, 0x8e, 0xd3, 0xbc, 0x49, 0x1b, 0x3a, 0x4d, 0xd3, 0xcd, 0x36, 0xec, 0x5a, 0x8b,
-	0x00, 0xab, 0x55, 0x76, 0x69, 0x10, 0x12, 0xea, 0xad, 0x6e, 0xa0, 0x05, 0xc9, 0x08, 0x6d, 0x41,
-	0x7c, 0x08, 0xc9, 0x1a, 0x7b, 0xa7, 0x9b, 0x55, 0xb2, 0x33, 0xee, 0xce, 0x38, 0xa4, 0x1c, 0x41,
-	0x48, 0x3d, 0xa1, 0xfe, 0x04, 0xc4, 0x0d, 0xae, 0x1c, 0xf9, 0x03, 0x3d, 0xf6, 0x06, 0xe2, 0xe0,
-	0xa2, 0xe4, 0x1f, 0xe4, 0x17, 0xa0, 0x9d, 0x99, 0xdd, 0xae, 0x1d, 0xc7, 0x1f, 0x37, 0x0e, 0x9c,
-	0x3c, 0x1f, 0xcf, 0xfb, 0xcc, 0xfb, 0x3e, 0xf3, 0xcc, 0xeb, 0x85, 0x37, 0x04, 0xa1, 0x21, 0x49,
-	0x93, 0x98, 0x0a, 0xff, 0x11, 0xce, 0x7e, 0x23, 0xff, 0xf0, 0x76, 0x87, 0x08, 0x7c, 0xdb, 0x17,
-	0x47, 0x5e, 0x2f, 0x65, 0x82, 0xa1, 0x8d, 0x2e, 0xe3, 0x09, 0xe3, 0x9e, 0x06, 0x78, 0x1a, 0x60,
-	0xad, 0x47, 0x2c, 0x62, 0x12, 0xe2, 0x67, 0x23, 0x85, 0xb6, 0x36, 0x15, 0xba, 0xad, 0x36, 0x74,
-	0xa8, 0xda, 0xb2, 0xd5, 0xcc, 0xef, 0x60, 0x4e, 0x8a, 0x63, 0xba, 0x2c, 0xa6, 0x7a, 0xdf, 0x89,
-	0x18, 0x8b, 0x0e, 0x88, 0x2f, 0x67, 0x9d, 0xfe, 0x23, 0x5f, 0xc4, 0x09, 0xe1, 0x02, 0x27, 0x3d,
-	0x05, 0x70, 0x7f, 0xad, 0x82, 0xd9, 0xe2, 0xd1, 0xbd, 0x94, 0x60, 0x41, 0x3e, 0x8c, 0x8f, 0x48,
-	0x78, 0x37, 0x61, 0x7d, 0x2a, 0x3e, 0x3d, 0xc0, 0x14, 0x21, 0xa8, 0x52, 0x9c, 0x10, 0xd3, 0xa8,
-	0x1b, 0x8d, 0xe5, 0x40, 0x8e, 0x91, 0x09, 0x4b, 0xdd, 0x0c, 0xcc, 0x52, 0xf3, 0x82, 0x5c, 0xce,
-	0xa7, 0xe8, 0x17, 0x03, 0xd6, 0xb9, 0xc0, 0xfb, 0x31, 0x8d, 0xda, 0x59, 0x0a, 0xed, 0x6f, 0x49,
-	0x1c, 0xed, 0x09, 0x6e, 0x2e, 0xd4, 0x17, 0x1a, 0x2b, 0x3b, 0x5b, 0x9e, 0xce, 0x3c, 0xcb, 0x35,
-	0xaf, 0xd8, 0xdb, 0x25, 0xdd, 0x7b, 0x2c, 0xa6, 0xcd, 0xe0, 0xf9, 0xc0, 0xa9, 0x9c, 0x0e, 0x9c,
-	0x1b, 0x4f, 0x70, 0x72, 0x70, 0xc7, 0x1d, 0xc7, 0xe3, 0xfe, 0xf6, 0xd2, 0xb9, 0x15, 0xc5, 0x62,
-	0xaf, 0xdf, 0xf1, 0xba, 0x2c, 0xd1, 0x42, 0xe8, 0x9f, 0x6d, 0x1e, 0xee, 0xfb, 0xe2, 0x49, 0x8f,
-	0xf0, 0x9c, 0x92, 0x07, 0x48, 0xb3, 0x64, 0xb3, 0x2f, 0x14, 0x07, 0xfa, 0x12, 0x80, 0x0b, 0x9c,
-	0x8a, 0x76, 0x26, 0x84, 0x59, 0xad, 0x1b, 0x8d, 0x95, 0x1d, 0xcb, 0x53, 0x2a, 0x79, 0xb9, 0x4a,
-	0xde, 0x67, 0xb9, 0x4a, 0xcd, 0xd7, 0x75, 0x5e, 0x57, 0x8a, 0xbc, 0x74, 0xac, 0xfb, 0xec, 0xa5,
-	0x63, 0x04, 0xcb, 0x72, 0x21, 0x83, 0xa3, 0x00, 0x2e, 0x12, 0x1a, 0x2a, 0xde, 0xc5, 0xa9, 0xbc,
-	0x37, 0x34, 0xef, 0x9a, 0xe2, 0xcd, 0x23, 0x15, 0xeb, 0x12, 0xa1, 0xa1, 0xe4, 0xfc, 0xd1, 0x80,
-	0x55, 0xd2, 0x63, 0xdd, 0xbd, 0x36, 0x96, 0xb7, 0x62, 0xd6, 0xa4, 0x94, 0x9b, 0x63, 0xa5, 0x94,
-	0x3a, 0xde, 0xd7, 0xbc, 0x57, 0x35, 0x6f, 0x29, 0x38, 0xd3, 0xaf, 0x31, 0x83, 0x7e, 0x4a, 0xbc,
-	0x15, 0x19, 0xaa, 0xcc, 0x70, 0xa7, 0xfa, 0xf4, 0x67, 0xa7, 0xe2, 0xba, 0x50, 0x3f, 0xcf, 0x2a,
-	0x01, 0xe1, 0x3d, 0x46, 0x39, 0x71, 0xbf, 0xaf, 0x02, 0x2a, 0x40, 0x01, 0x16, 0x31, 0xfb, 0xdf,
-	0x49, 0xff, 0x05, 0x27, 0x11, 0x50, 0x17, 0xda, 0x4e, 0xb3, 0x3b, 0x31, 0x6b, 0x99, 0xe0, 0xcd,
-	0xdd, 0x2c, 0xf4, 0xef, 0x81, 0xf3, 0xd6, 0x6c, 0x5a, 0x9c, 0x0e, 0x1c, 0x54, 0xb6, 0x95, 0xa4,
-	0x72, 0x03, 0x90, 0x33, 0x79, 0xd7, 0xda, 0x28, 0x5b, 0x60, 0x9d, 0xf5, 0x40, 0x61, 0x91, 0xdf,
-	0x0d, 0xb8, 0xd8, 0xe2, 0xd1, 0x43, 0x81, 0xf7, 0x09, 0xda, 0x80, 0x5a, 0xd6, 0x04, 0x49, 0xaa,
-	0xad, 0xa1, 0x67, 0xe8, 0xa9, 0x01, 0x97, 0xca, 0x57, 0xc7, 0xcd, 0x0b, 0xd3, 0xac, 0xff, 0x40,
-	0x0b, 0xb1, 0x7e, 0xf6, 0xe2, 0xf9, 0x7c, 0xde, 0x5f, 0x2d, 0x5d, 0x37, 0xd7, 0x35, 0x21, 0x78,
-	0x2d, 0x4f, 0xba, 0xa8, 0xe4, 0x0f, 0x03, 0xa0, 0xc5, 0xa3, 0xcf, 0x29, 0x9f, 0x58, 0xcb, 0x4f,
-	0x06, 0xac, 0xf5, 0xe9, 0x9c, 0xd5, 0x7c, 0xac, 0xab, 0xd9, 0x50, 0xd5, 0x8c, 0xc4, 0xcf, 0x57,
-	0xcf, 0xe5, 0x22, 0xba, 0x5c, 0xd1, 0xba, 0x7c, 0xa9, 0x3a, 0xf9, 0xa2, 0xa6, 0xef, 0x64, 0x49,
-	0x0f, 0x70, 0x7a, 0x48, 0xb8, 0x38, 0xb7, 0xa4, 0x4f, 0xe0, 0xea, 0xd0, 0xc3, 0x0a, 0x09, 0x65,
-	0x89, 0xaa, 0x6a, 0xb9, 0x69, 0x9f, 0x0e, 0x1c, 0x6b, 0xcc, 0xeb, 0x53, 0x20, 0x37, 0xb8, 0x52,
-	0x4a, 0x66, 0x57, 0xae, 0x0d, 0x65, 0xa4, 0xcf, 0x2e, 0x32, 0xfa, 0x06, 0x2e, 0xb5, 0x78, 0x14,
-	0x90, 0x84, 0x1d, 0x12, 0xd9, 0x4c, 0x4a, 0x8d, 0xc3, 0x18, 0x6e, 0x1c, 0xb7, 0x60, 0xa9, 0x77,
-	0x80, 0x69, 0x3b, 0x0e, 0x65, 0x4b, 0xa9, 0x36, 0xd1, 0xe9, 0xc0, 0xb9, 0xac, 0x52, 0xd1, 0x1b,
-	0x6e, 0x50, 0xcb, 0x46, 0x1f, 0x85, 0xfa, 0xcc, 0xeb, 0x70, 0x6d, 0x88, 0xbd, 0x38, 0xf6, 0x3d,
-	0x58, 0x6b, 0xf1, 0xe8, 0x6e, 0x78, 0x88, 0x69, 0x97, 0x7c, 0x90, 0x59, 0x1c, 0x6d, 0xc1, 0x72,
-	0x4a, 0x1e, 0xf7, 0x09, 0x17, 0x85, 0x20, 0xaf, 0x16, 0x34, 0xdf, 0x26, 0x5c, 0x1f, 0x09, 0xcb,
-	0x19, 0x77, 0xfe, 0x5c, 0x84, 0x85, 0x16, 0x8f, 0xd0, 0x0f, 0x06, 0x5c, 0x1b, 0xff, 0x87, 0xfb,
-	0x8e, 0x37, 0xfe, 0xc3, 0xc0, 0x3b, 0xaf, 0xef, 0x5a, 0xef, 0xcf, 0x1b, 0x91, 0x67, 0x83, 0x1e,
-	0xc3, 0xda, 0x68, 0x97, 0xbe, 0x39, 0x95, 0xac, 0xc0, 0x5a, 0x3b, 0xb3, 0x63, 0x8b, 0x23, 0x1f,
-	0xc2, 0xa2, 0x7a, 0xf5, 0xf5, 0x09, 0xc1, 0x12, 0x61, 0x35, 0xa6, 0x21, 0x0a, 0xd2, 0xaf, 0x60,
-	0x29, 0x7f, 0x80, 0xee, 0x84, 0x20, 0x8d, 0xb1, 0x6e, 0x4e, 0xc7, 0x94, 0xa9, 0xf3, 0x87, 0x30,
-	0x89, 0x5a, 0x63, 0x26, 0x52, 0x8f, 0x98, 0x1a, 0x75, 0x00, 0x4a, 0x8e, 0x7e, 0x73, 0x42, 0xe4,
-	0x2b, 0x98, 0xb5, 0x3d, 0x13, 0xac, 0x38, 0x63, 0x0f, 0x56, 0x87, 0xec, 0xfb, 0xf6, 0x84, 0xf0,
-	0x32, 0xd0, 0xf2, 0x67, 0x04, 0xe6, 0x27, 0x35, 0xef, 0x3f, 0x3f, 0xb6, 0x8d, 0x17, 0xc7, 0xb6,
-	0xf1, 0xcf, 0xb1, 0x6d, 0x3c, 0x3b, 0xb1, 0x2b, 0x2f, 0x4e, 0xec, 0xca, 0x5f, 0x27, 0x76, 0xe5,
-	0xeb, 0xed, 0x52, 0x93, 0x1a, 0xf3, 0x65, 0x7c, 0x54, 0x8c, 0x64, 0xbf, 0xea, 0xd4, 0xe4, 0x1f,
-	0xdc, 0xbb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x35, 0x96, 0xfb, 0xbb, 0x46, 0x0b, 0x00, 0x00,
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{21}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgSetRewardsWithdrawAddress defines a message for setting the address to
+// which the rewards of a farmer are sent.
+type MsgSetRewardsWithdrawAddress struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// withdraw_address defines the bech32-encoded address to which the rewards are sent
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *MsgSetRewardsWithdrawAddress) Reset()         { *m = MsgSetRewardsWithdrawAddress{} }
+func (m *MsgSetRewardsWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardsWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{22}
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardsWithdrawAddress proto.InternalMessageInfo
+
+// MsgSetRewardsWithdrawAddressResponse defines the Msg/SetRewardsWithdrawAddress response type.
+type MsgSetRewardsWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) Reset()         { *m = MsgSetRewardsWithdrawAddressResponse{} }
+func (m *MsgSetRewardsWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardsWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{23}
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgClaimVested defines a SDK message for claiming the vested rewards of a
+// farmer.
+type MsgClaimVested struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+}
+
+func (m *MsgClaimVested) Reset()         { *m = MsgClaimVested{} }
+func (m *MsgClaimVested) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVested) ProtoMessage()    {}
+func (*MsgClaimVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{24}
+}
+func (m *MsgClaimVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVested.Merge(m, src)
+}
+func (m *MsgClaimVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVested proto.InternalMessageInfo
+
+// MsgClaimVestedResponse defines the Msg/ClaimVested response type.
+type MsgClaimVestedResponse struct {
+}
+
+func (m *MsgClaimVestedResponse) Reset()         { *m = MsgClaimVestedResponse{} }
+func (m *MsgClaimVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedResponse) ProtoMessage()    {}
+func (*MsgClaimVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{25}
+}
+func (m *MsgClaimVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
+
+// MsgAdvanceEpoch defines a message to advance epoch by one.
+type MsgAdvanceEpoch struct {
+	// requester defines the bech32-encoded address of the requester
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (m *MsgAdvanceEpoch) Reset()         { *m = MsgAdvanceEpoch{} }
+func (m *MsgAdvanceEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceEpoch) ProtoMessage()    {}
+func (*MsgAdvanceEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{26}
+}
+func (m *MsgAdvanceEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdvanceEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceEpoch.Merge(m, src)
+}
+func (m *MsgAdvanceEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdvanceEpoch proto.InternalMessageInfo
+
+// MsgAdvanceEpochResponse defines the Msg/AdvanceEpoch response type.
+type MsgAdvanceEpochResponse struct {
+}
+
+func (m *MsgAdvanceEpochResponse) Reset()         { *m = MsgAdvanceEpochResponse{} }
+func (m *MsgAdvanceEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceEpochResponse) ProtoMessage()    {}
+func (*MsgAdvanceEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{27}
+}
+func (m *MsgAdvanceEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdvanceEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceEpochResponse.Merge(m, src)
+}
+func (m *MsgAdvanceEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdvanceEpochResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
+	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
+	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
+	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
+	proto.RegisterType((*MsgCreateDecayingAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingAmountPlan")
+	proto.RegisterType((*MsgCreateDecayingAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingAmountPlanResponse")
+	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
+	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
+	proto.RegisterType((*MsgStakeFor)(nil), "cosmos.farming.v1beta1.MsgStakeFor")
+	proto.RegisterType((*MsgStakeForResponse)(nil), "cosmos.farming.v1beta1.MsgStakeForResponse")
+	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
+	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
+	proto.RegisterType((*MsgTransferStaking)(nil), "cosmos.farming.v1beta1.MsgTransferStaking")
+	proto.RegisterType((*MsgTransferStakingResponse)(nil), "cosmos.farming.v1beta1.MsgTransferStakingResponse")
+	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
+	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
+	proto.RegisterType((*MsgRemovePlan)(nil), "cosmos.farming.v1beta1.MsgRemovePlan")
+	proto.RegisterType((*MsgRemovePlanResponse)(nil), "cosmos.farming.v1beta1.MsgRemovePlanResponse")
+	proto.RegisterType((*MsgModifyPrivatePlan)(nil), "cosmos.farming.v1beta1.MsgModifyPrivatePlan")
+	proto.RegisterType((*MsgModifyPrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgModifyPrivatePlanResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.farming.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.farming.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgSetRewardsWithdrawAddress)(nil), "cosmos.farming.v1beta1.MsgSetRewardsWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardsWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.MsgSetRewardsWithdrawAddressResponse")
+	proto.RegisterType((*MsgClaimVested)(nil), "cosmos.farming.v1beta1.MsgClaimVested")
+	proto.RegisterType((*MsgClaimVestedResponse)(nil), "cosmos.farming.v1beta1.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
+	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
+}
+
+var fileDescriptor_a33d9a3ff13f514a = []byte{
+	// 1523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0xfd, 0x92, 0x7d, 0x6c, 0x47, 0x31, 0xa3, 0xd8, 0x34, 0xed, 0x2b, 0x19, 0xf4, 0x4d,
+	0xae, 0x91, 0xdc, 0x48, 0x37, 0x4e, 0x2e, 0x50, 0xa4, 0xdd, 0x58, 0x71, 0x9c, 0xa4, 0xa8, 0x0a,
+	0x83, 0x4e, 0x9b, 0xb6, 0x08, 0xa0, 0x8e, 0xc4, 0x31, 0x4d, 0x44, 0x24, 0x15, 0x0e, 0xe5, 0x47,
+	0x17, 0xdd, 0xf4, 0x81, 0x2c, 0x8a, 0x36, 0x9b, 0x02, 0x5d, 0x74, 0x51, 0x74, 0xd9, 0x4d, 0x7f,
+	0x40, 0xff, 0x40, 0x16, 0x5d, 0x64, 0x59, 0xb4, 0x80, 0x53, 0x24, 0xbf, 0xa0, 0xfe, 0x05, 0xc5,
+	0x3c, 0x38, 0xa2, 0x64, 0x3d, 0xd3, 0x36, 0x0f, 0xc0, 0x2b, 0x73, 0x86, 0xdf, 0xf9, 0xce, 0x63,
+	0xce, 0x39, 0x73, 0x44, 0xc3, 0x52, 0x88, 0x3d, 0x0b, 0x07, 0xae, 0xe3, 0x85, 0xb9, 0x2d, 0x44,
+	0xff, 0xda, 0xb9, 0x9d, 0x8b, 0x25, 0x1c, 0xa2, 0x8b, 0xb9, 0x70, 0x2f, 0x5b, 0x0d, 0xfc, 0xd0,
+	0x57, 0x67, 0xca, 0x3e, 0x71, 0x7d, 0x92, 0x15, 0x80, 0xac, 0x00, 0xe8, 0x29, 0xdb, 0xb7, 0x7d,
+	0x06, 0xc9, 0xd1, 0x27, 0x8e, 0xd6, 0xe7, 0x38, 0xba, 0xc8, 0x5f, 0x08, 0x51, 0xfe, 0x2a, 0xcd,
+	0x57, 0xb9, 0x12, 0x22, 0x58, 0xaa, 0x29, 0xfb, 0x8e, 0x27, 0xde, 0x67, 0x6c, 0xdf, 0xb7, 0x2b,
+	0x38, 0xc7, 0x56, 0xa5, 0xda, 0x56, 0x2e, 0x74, 0x5c, 0x4c, 0x42, 0xe4, 0x56, 0x23, 0x82, 0x66,
+	0x80, 0x55, 0x0b, 0x50, 0xe8, 0xf8, 0x82, 0xc0, 0xf8, 0x3c, 0x01, 0x5a, 0x81, 0xd8, 0x57, 0x03,
+	0x8c, 0x42, 0xbc, 0xee, 0xec, 0x61, 0x6b, 0xd5, 0xf5, 0x6b, 0x5e, 0xb8, 0x51, 0x41, 0x9e, 0xaa,
+	0xc2, 0xb0, 0x87, 0x5c, 0xac, 0x29, 0x8b, 0xca, 0xf2, 0xb8, 0xc9, 0x9e, 0x55, 0x0d, 0x12, 0x65,
+	0x0a, 0xf6, 0x03, 0x6d, 0x90, 0x6d, 0x47, 0x4b, 0xf5, 0x7b, 0x05, 0x52, 0x24, 0x44, 0x77, 0x1d,
+	0xcf, 0x2e, 0x52, 0x13, 0x8b, 0xbb, 0xd8, 0xb1, 0xb7, 0x43, 0xa2, 0x0d, 0x2d, 0x0e, 0x2d, 0x4f,
+	0xac, 0x2c, 0x64, 0x85, 0x67, 0xd4, 0x97, 0x28, 0x22, 0xd9, 0x35, 0x5c, 0xbe, 0xea, 0x3b, 0x5e,
+	0xde, 0x7c, 0x78, 0x90, 0x19, 0x38, 0x3c, 0xc8, 0xcc, 0xef, 0x23, 0xb7, 0x72, 0xc5, 0x68, 0xc5,
+	0x63, 0xfc, 0xf0, 0x38, 0x73, 0xde, 0x76, 0xc2, 0xed, 0x5a, 0x29, 0x5b, 0xf6, 0x5d, 0x11, 0x28,
+	0xf1, 0xe7, 0x02, 0xb1, 0xee, 0xe6, 0xc2, 0xfd, 0x2a, 0x26, 0x11, 0x25, 0x31, 0x55, 0xc1, 0x42,
+	0x57, 0xb7, 0x39, 0x87, 0xfa, 0x1e, 0x00, 0x09, 0x51, 0x10, 0x16, 0x69, 0xa0, 0xb4, 0xe1, 0x45,
+	0x65, 0x79, 0x62, 0x45, 0xcf, 0xf2, 0x20, 0x65, 0xa3, 0x20, 0x65, 0x6f, 0x45, 0x51, 0xcc, 0xff,
+	0x4b, 0xd8, 0x35, 0x2d, 0xed, 0x12, 0xb2, 0xc6, 0x83, 0xc7, 0x19, 0xc5, 0x1c, 0x67, 0x1b, 0x14,
+	0xae, 0x9a, 0x30, 0x86, 0x3d, 0x8b, 0xf3, 0x8e, 0x74, 0xe5, 0x9d, 0x17, 0xbc, 0x49, 0xce, 0x1b,
+	0x49, 0x72, 0xd6, 0x04, 0xf6, 0x2c, 0xc6, 0xf9, 0x99, 0x02, 0x93, 0xb8, 0xea, 0x97, 0xb7, 0x8b,
+	0x88, 0x9d, 0x8a, 0x36, 0xca, 0x42, 0x39, 0xd7, 0x32, 0x94, 0x2c, 0x8e, 0xd7, 0x05, 0xef, 0x29,
+	0xc1, 0x1b, 0x13, 0xa6, 0xf1, 0x5b, 0xee, 0x21, 0x7e, 0x3c, 0x78, 0x13, 0x4c, 0x94, 0x27, 0x83,
+	0xea, 0xc0, 0xc9, 0x1d, 0x4c, 0x42, 0x7a, 0x22, 0x51, 0xfe, 0x68, 0x09, 0xe6, 0xe3, 0xdc, 0x11,
+	0x1f, 0xd7, 0x04, 0x20, 0xbf, 0x24, 0x4c, 0x99, 0xe5, 0xa6, 0x34, 0x13, 0x18, 0xdf, 0x50, 0x57,
+	0x93, 0x62, 0x3b, 0x92, 0x52, 0x3f, 0x86, 0x94, 0x8b, 0xf6, 0x8a, 0xf4, 0xe8, 0x70, 0xb1, 0x8a,
+	0x83, 0x22, 0xad, 0x21, 0x1c, 0x68, 0x63, 0x34, 0xd9, 0xf2, 0x05, 0xca, 0xf9, 0xeb, 0x41, 0xe6,
+	0x6c, 0x0f, 0x7e, 0xdc, 0xf4, 0xc2, 0x7a, 0x42, 0xb5, 0xe2, 0x34, 0xcc, 0x69, 0x17, 0xed, 0x6d,
+	0xd2, 0xdd, 0x0d, 0x1c, 0xac, 0xb3, 0x3d, 0xb5, 0x0a, 0x49, 0x8a, 0x0d, 0xfd, 0x10, 0x55, 0xb8,
+	0x84, 0x36, 0xce, 0x54, 0xdf, 0xe8, 0x5b, 0xf5, 0x4c, 0x5d, 0x75, 0x8c, 0xce, 0x30, 0xa7, 0x5c,
+	0xb4, 0x77, 0x8b, 0x6e, 0x30, 0xd5, 0x57, 0x86, 0xef, 0x7f, 0x97, 0x19, 0x30, 0x0c, 0x58, 0x6c,
+	0x57, 0x87, 0x26, 0x26, 0x55, 0xdf, 0x23, 0xd8, 0x78, 0x3c, 0x0a, 0xaa, 0x04, 0x99, 0x34, 0x5e,
+	0xc7, 0x65, 0xfa, 0x32, 0x94, 0x29, 0x06, 0x5e, 0x2d, 0x45, 0x96, 0xc3, 0xda, 0x28, 0xcb, 0x97,
+	0xb5, 0x3e, 0xf2, 0x65, 0x0d, 0x97, 0x0f, 0x0f, 0x32, 0x6a, 0xbc, 0x66, 0x19, 0x95, 0x61, 0x02,
+	0x5b, 0xb1, 0xb3, 0x3e, 0xae, 0xc2, 0x7f, 0xbe, 0x0a, 0x17, 0x40, 0x3f, 0x5a, 0x60, 0xb2, 0xfe,
+	0x7e, 0x1c, 0x83, 0x79, 0xf9, 0x7a, 0x0d, 0x97, 0xd1, 0xbe, 0xe3, 0xd9, 0xc7, 0xf7, 0xe5, 0xf1,
+	0x7d, 0xd9, 0x7c, 0x5f, 0x6e, 0xc3, 0xa4, 0x45, 0xd3, 0xa3, 0xb8, 0x85, 0xca, 0xf4, 0xe4, 0x13,
+	0x2c, 0x77, 0xaf, 0xf5, 0xdd, 0x11, 0x84, 0x55, 0x71, 0x2e, 0xc3, 0x9c, 0x60, 0xcb, 0x75, 0xb6,
+	0x52, 0xaf, 0x44, 0x9a, 0xaa, 0x38, 0x70, 0x7c, 0x8b, 0x15, 0xe8, 0x54, 0x7e, 0xb6, 0x59, 0x96,
+	0xbf, 0x8d, 0x64, 0x37, 0xd8, 0xaa, 0x65, 0x3f, 0x19, 0x7f, 0xbe, 0xfd, 0x04, 0x5e, 0x5c, 0x3f,
+	0x99, 0x78, 0x1e, 0xfd, 0xe4, 0x0c, 0x2c, 0x75, 0x68, 0x18, 0xb2, 0xb1, 0x7c, 0x3d, 0x08, 0x63,
+	0x05, 0x62, 0x33, 0x49, 0x75, 0x06, 0x46, 0x45, 0x74, 0x78, 0x1f, 0x11, 0x2b, 0xf5, 0xbe, 0x02,
+	0x53, 0xf1, 0x3a, 0x27, 0xda, 0x60, 0xb7, 0xec, 0xbe, 0x21, 0x0e, 0x2b, 0x75, 0xb4, 0x4b, 0x90,
+	0xfe, 0xd2, 0x7b, 0x32, 0xd6, 0x1b, 0x88, 0xfa, 0x21, 0x4c, 0x55, 0xfc, 0xf2, 0xdd, 0x7a, 0xda,
+	0x0c, 0x75, 0x4b, 0x9b, 0xc5, 0x46, 0x4b, 0x1a, 0xa4, 0x79, 0xce, 0x4c, 0xd2, 0xbd, 0x08, 0x2f,
+	0xc2, 0xa7, 0xc2, 0xc9, 0x28, 0x2c, 0x32, 0x56, 0x3f, 0x2b, 0x30, 0x11, 0x6d, 0xae, 0xfb, 0x81,
+	0x9a, 0x82, 0x91, 0x2a, 0xda, 0x97, 0xd1, 0xe2, 0x8b, 0x58, 0x10, 0x07, 0xbb, 0x04, 0x71, 0xe8,
+	0x05, 0x05, 0x51, 0xb8, 0x78, 0x1a, 0x4e, 0xc5, 0xbc, 0x91, 0x5e, 0xfe, 0xa4, 0x00, 0x14, 0x88,
+	0xfd, 0x8e, 0x47, 0x3a, 0xe6, 0xc4, 0x97, 0x0a, 0x24, 0x6b, 0x5e, 0x9f, 0x59, 0xf1, 0xa6, 0x70,
+	0x48, 0x64, 0x72, 0xcd, 0xfb, 0x0b, 0x2e, 0x9d, 0x90, 0xd2, 0x71, 0xa7, 0x52, 0xa0, 0xd6, 0x8d,
+	0x97, 0x3e, 0xfd, 0xa6, 0xb0, 0xed, 0x5b, 0x01, 0xf2, 0xc8, 0x16, 0x0e, 0x36, 0xb9, 0x5c, 0x5b,
+	0xdf, 0x16, 0x60, 0x3c, 0xc0, 0x65, 0xa7, 0xea, 0x60, 0x2f, 0x14, 0xa7, 0x58, 0xdf, 0x78, 0xf9,
+	0x0e, 0x92, 0x8f, 0x0e, 0x4d, 0xce, 0x49, 0xdf, 0x3f, 0x62, 0xc7, 0x79, 0x03, 0x05, 0xb4, 0x33,
+	0xb6, 0x75, 0xf9, 0x6d, 0x38, 0xd5, 0x70, 0x93, 0x5b, 0xd8, 0xf3, 0x5d, 0x7e, 0xa2, 0xe3, 0xf9,
+	0xf4, 0xe1, 0x41, 0x46, 0x6f, 0x71, 0xdd, 0x73, 0x90, 0x61, 0x4e, 0xc7, 0x8c, 0x5a, 0x63, 0x7b,
+	0x0d, 0xa7, 0x21, 0x74, 0x4b, 0x8b, 0xee, 0xc0, 0x54, 0x81, 0xd8, 0x26, 0x76, 0xfd, 0x1d, 0xcc,
+	0xa6, 0x97, 0xd8, 0xa4, 0xa2, 0x34, 0x4e, 0x2a, 0xe7, 0x21, 0x51, 0xad, 0x20, 0xaf, 0xe8, 0x58,
+	0xec, 0x1c, 0x86, 0xf3, 0xea, 0xe1, 0x41, 0xe6, 0x04, 0x37, 0x45, 0xbc, 0x30, 0xcc, 0x51, 0xfa,
+	0x74, 0xd3, 0x12, 0x3a, 0x67, 0xe1, 0x74, 0x03, 0xbb, 0x54, 0xfb, 0xc7, 0x08, 0xa4, 0x0a, 0xc4,
+	0x2e, 0xf8, 0x96, 0xb3, 0xb5, 0xbf, 0x11, 0x38, 0x3b, 0x28, 0xfc, 0x3b, 0xd5, 0xbf, 0x1a, 0x53,
+	0x55, 0x7c, 0xf6, 0x19, 0xee, 0x69, 0xf6, 0x51, 0xfa, 0x9f, 0x7d, 0x46, 0x5e, 0xcc, 0xec, 0xf3,
+	0x9c, 0x7e, 0x0c, 0xbd, 0x12, 0x23, 0x96, 0x28, 0x86, 0x34, 0x2c, 0xb4, 0x4a, 0x79, 0x59, 0x13,
+	0x6f, 0xb1, 0x02, 0xdd, 0xc4, 0xe1, 0x6a, 0x2d, 0xf4, 0xaf, 0xfa, 0x6e, 0xd5, 0xaf, 0x79, 0x56,
+	0xdb, 0x26, 0xa1, 0x41, 0x02, 0x7b, 0xa8, 0x54, 0xc1, 0xbc, 0x1c, 0xc6, 0xcc, 0x68, 0xd9, 0xd0,
+	0x88, 0x9a, 0xd8, 0xa4, 0xae, 0x4f, 0x15, 0x66, 0xcc, 0x26, 0x0e, 0x4d, 0xbc, 0x8b, 0x02, 0x8b,
+	0xdc, 0x76, 0xc2, 0x6d, 0x2b, 0x40, 0xbb, 0xab, 0x96, 0x15, 0x60, 0x42, 0xda, 0xaa, 0x5d, 0x87,
+	0x93, 0xbb, 0x02, 0x5a, 0x44, 0x1c, 0xcb, 0xbb, 0x72, 0x7e, 0xbe, 0x3e, 0x0e, 0x36, 0x23, 0x0c,
+	0x33, 0xb9, 0xdb, 0xc8, 0x2f, 0x8c, 0x3c, 0x0b, 0xff, 0xee, 0x64, 0x85, 0x34, 0x37, 0x0b, 0x27,
+	0xe8, 0x00, 0x55, 0x41, 0x8e, 0xfb, 0x2e, 0x26, 0x21, 0x6e, 0x1b, 0x16, 0xc1, 0xab, 0xc1, 0x4c,
+	0x23, 0x5e, 0x32, 0xfd, 0x1f, 0x92, 0x05, 0x62, 0xaf, 0x5a, 0x3b, 0xc8, 0x2b, 0xe3, 0x6b, 0x34,
+	0x91, 0xf8, 0x0d, 0x73, 0xaf, 0x46, 0x71, 0x11, 0x5b, 0x7d, 0x43, 0x10, 0xce, 0xc1, 0x6c, 0x93,
+	0x58, 0xc4, 0xb8, 0xf2, 0xed, 0x24, 0x0c, 0x15, 0x88, 0xad, 0x7e, 0xa2, 0xc0, 0xe9, 0xd6, 0x1f,
+	0x50, 0xff, 0x97, 0x6d, 0xfd, 0x21, 0x38, 0xdb, 0xee, 0x53, 0x8f, 0xfe, 0x5a, 0xbf, 0x12, 0x91,
+	0x35, 0xea, 0x3d, 0x48, 0x36, 0x7f, 0x18, 0x3a, 0xd7, 0x95, 0x4c, 0x62, 0xf5, 0x95, 0xde, 0xb1,
+	0x52, 0xe5, 0x17, 0x0a, 0x68, 0x6d, 0x7f, 0x0c, 0x5f, 0xea, 0x4a, 0x78, 0x54, 0x48, 0x7f, 0xfd,
+	0x19, 0x84, 0xa4, 0x39, 0x9b, 0x30, 0xc2, 0x27, 0xe8, 0xc5, 0x0e, 0x2c, 0x0c, 0xa1, 0x2f, 0x77,
+	0x43, 0x48, 0xd2, 0x3b, 0x30, 0x26, 0x47, 0xcd, 0xa5, 0x6e, 0x52, 0xeb, 0x7e, 0xa0, 0x9f, 0xef,
+	0x01, 0x24, 0xd9, 0xdf, 0x87, 0x44, 0x34, 0xe2, 0x19, 0x1d, 0xe4, 0x04, 0x46, 0x3f, 0xd7, 0x1d,
+	0x13, 0xcf, 0x87, 0xe6, 0x49, 0xab, 0x93, 0x78, 0x13, 0x56, 0x5f, 0xe9, 0x1d, 0x1b, 0xf7, 0x26,
+	0x9a, 0x70, 0x3a, 0x79, 0x23, 0x30, 0xfa, 0xb9, 0xee, 0x18, 0x49, 0x5d, 0x02, 0x88, 0x8d, 0x2a,
+	0x67, 0x3a, 0x48, 0xd6, 0x61, 0xfa, 0x85, 0x9e, 0x60, 0x52, 0xc7, 0x2e, 0x4c, 0x1f, 0x1d, 0x4b,
+	0xfe, 0xdb, 0x81, 0xe3, 0x08, 0x5a, 0xbf, 0xdc, 0x0f, 0x3a, 0x7e, 0x54, 0xcd, 0xcd, 0xbf, 0x53,
+	0x6c, 0x9a, 0xb0, 0xfa, 0x4a, 0xef, 0x58, 0xa9, 0xf2, 0x2b, 0x05, 0xe6, 0xda, 0xdf, 0x01, 0x97,
+	0x3b, 0x33, 0xb6, 0x96, 0xd2, 0xdf, 0x78, 0x16, 0x29, 0x69, 0x11, 0x86, 0x89, 0x78, 0x9b, 0x3f,
+	0xdb, 0xa9, 0x13, 0xd4, 0x71, 0x7a, 0xb6, 0x37, 0x9c, 0x54, 0xb3, 0x0d, 0x93, 0x0d, 0x77, 0xc0,
+	0x7f, 0x3a, 0xc8, 0xc7, 0x81, 0x7a, 0xae, 0x47, 0x60, 0xa4, 0x29, 0x7f, 0xfd, 0xe1, 0x93, 0xb4,
+	0xf2, 0xe8, 0x49, 0x5a, 0xf9, 0xfd, 0x49, 0x5a, 0x79, 0xf0, 0x34, 0x3d, 0xf0, 0xe8, 0x69, 0x7a,
+	0xe0, 0x97, 0xa7, 0xe9, 0x81, 0x0f, 0x2e, 0xc4, 0xa6, 0x93, 0x16, 0xff, 0x4e, 0xdc, 0x93, 0x4f,
+	0x6c, 0x50, 0x29, 0x8d, 0xb2, 0x99, 0xf0, 0xd2, 0x9f, 0x03, 0x00, 0x56, 0x54, 0x87, 0x31, 0x7b,
+	0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
 // MsgClient is the client API for Msg service.
 //
@@ -699,14 +1371,33 @@ type MsgClient interface {
 	CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error)
 	// CreateRatioPlan defines a method for creating a new ratio farming plan
 	CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingAmountPlan defines a method for creating a new decaying amount
+	// farming plan
+	CreateDecayingAmountPlan(ctx context.Context, in *MsgCreateDecayingAmountPlan, opts ...grpc.CallOption) (*MsgCreateDecayingAmountPlanResponse, error)
 	// Stake defines a method for staking coins into the farming plan
 	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
+	// StakeFor defines a method for staking coins into the farming plan on behalf
+	// of another farmer
+	StakeFor(ctx context.Context, in *MsgStakeFor, opts ...grpc.CallOption) (*MsgStakeForResponse, error)
 	// Unstake defines a method for unstaking coins from the farming plan
 	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
+	// TransferStaking defines a method for transferring staked and queued coins
+	// to another farmer
+	TransferStaking(ctx context.Context, in *MsgTransferStaking, opts ...grpc.CallOption) (*MsgTransferStakingResponse, error)
 	// Harvest defines a method for claiming farming rewards
 	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
 	// RemovePlan defines a method for removing a terminated plan.
 	RemovePlan(ctx context.Context, in *MsgRemovePlan, opts ...grpc.CallOption) (*MsgRemovePlanResponse, error)
+	// ModifyPrivatePlan defines a method for modifying a live private plan.
+	ModifyPrivatePlan(ctx context.Context, in *MsgModifyPrivatePlan, opts ...grpc.CallOption) (*MsgModifyPrivatePlanResponse, error)
+	// SetAutoCompound defines a method for enabling or disabling auto-compounding
+	// of rewards
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// SetRewardsWithdrawAddress defines a method for setting the address to
+	// which the rewards of a farmer are sent
+	SetRewardsWithdrawAddress(ctx context.Context, in *MsgSetRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardsWithdrawAddressResponse, error)
+	// ClaimVested defines a method for claiming vested rewards
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
 	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
 	// and shouldn't be used in real world
 	AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error)
@@ -738,6 +1429,15 @@ func (c *msgClient) CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan,
 	return out, nil
 }
 
+func (c *msgClient) CreateDecayingAmountPlan(ctx context.Context, in *MsgCreateDecayingAmountPlan, opts ...grpc.CallOption) (*MsgCreateDecayingAmountPlanResponse, error) {
+	out := new(MsgCreateDecayingAmountPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateDecayingAmountPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
 	out := new(MsgStakeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Stake", in, out, opts...)
@@ -747,6 +1447,15 @@ func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) StakeFor(ctx context.Context, in *MsgStakeFor, opts ...grpc.CallOption) (*MsgStakeForResponse, error) {
+	out := new(MsgStakeForResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/StakeFor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
 	out := new(MsgUnstakeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Unstake", in, out, opts...)
@@ -756,6 +1465,15 @@ func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) TransferStaking(ctx context.Context, in *MsgTransferStaking, opts ...grpc.CallOption) (*MsgTransferStakingResponse, error) {
+	out := new(MsgTransferStakingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/TransferStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
 	out := new(MsgHarvestResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Harvest", in, out, opts...)
@@ -774,6 +1492,42 @@ func (c *msgClient) RemovePlan(ctx context.Context, in *MsgRemovePlan, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ModifyPrivatePlan(ctx context.Context, in *MsgModifyPrivatePlan, opts ...grpc.CallOption) (*MsgModifyPrivatePlanResponse, error) {
+	out := new(MsgModifyPrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/ModifyPrivatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardsWithdrawAddress(ctx context.Context, in *MsgSetRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardsWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SetRewardsWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/ClaimVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error) {
 	out := new(MsgAdvanceEpochResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AdvanceEpoch", in, out, opts...)
@@ -790,14 +1544,33 @@ type MsgServer interface {
 	CreateFixedAmountPlan(context.Context, *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error)
 	// CreateRatioPlan defines a method for creating a new ratio farming plan
 	CreateRatioPlan(context.Context, *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingAmountPlan defines a method for creating a new decaying amount
+	// farming plan
+	CreateDecayingAmountPlan(context.Context, *MsgCreateDecayingAmountPlan) (*MsgCreateDecayingAmountPlanResponse, error)
 	// Stake defines a method for staking coins into the farming plan
 	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
+	// StakeFor defines a method for staking coins into the farming plan on behalf
+	// of another farmer
+	StakeFor(context.Context, *MsgStakeFor) (*MsgStakeForResponse, error)
 	// Unstake defines a method for unstaking coins from the farming plan
 	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
+	// TransferStaking defines a method for transferring staked and queued coins
+	// to another farmer
+	TransferStaking(context.Context, *MsgTransferStaking) (*MsgTransferStakingResponse, error)
 	// Harvest defines a method for claiming farming rewards
 	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
 	// RemovePlan defines a method for removing a terminated plan.
 	RemovePlan(context.Context, *MsgRemovePlan) (*MsgRemovePlanResponse, error)
+	// ModifyPrivatePlan defines a method for modifying a live private plan.
+	ModifyPrivatePlan(context.Context, *MsgModifyPrivatePlan) (*MsgModifyPrivatePlanResponse, error)
+	// SetAutoCompound defines a method for enabling or disabling auto-compounding
+	// of rewards
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// SetRewardsWithdrawAddress defines a method for setting the address to
+	// which the rewards of a farmer are sent
+	SetRewardsWithdrawAddress(context.Context, *MsgSetRewardsWithdrawAddress) (*MsgSetRewardsWithdrawAddressResponse, error)
+	// ClaimVested defines a method for claiming vested rewards
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
 	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
 	// and shouldn't be used in real world
 	AdvanceEpoch(context.Context, *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error)
@@ -813,18 +1586,39 @@ func (*UnimplementedMsgServer) CreateFixedAmountPlan(ctx context.Context, req *M
 func (*UnimplementedMsgServer) CreateRatioPlan(ctx context.Context, req *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRatioPlan not implemented")
 }
+func (*UnimplementedMsgServer) CreateDecayingAmountPlan(ctx context.Context, req *MsgCreateDecayingAmountPlan) (*MsgCreateDecayingAmountPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDecayingAmountPlan not implemented")
+}
 func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
 }
+func (*UnimplementedMsgServer) StakeFor(ctx context.Context, req *MsgStakeFor) (*MsgStakeForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeFor not implemented")
+}
 func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
 }
+func (*UnimplementedMsgServer) TransferStaking(ctx context.Context, req *MsgTransferStaking) (*MsgTransferStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStaking not implemented")
+}
 func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
 }
 func (*UnimplementedMsgServer) RemovePlan(ctx context.Context, req *MsgRemovePlan) (*MsgRemovePlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePlan not implemented")
 }
+func (*UnimplementedMsgServer) ModifyPrivatePlan(ctx context.Context, req *MsgModifyPrivatePlan) (*MsgModifyPrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyPrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsWithdrawAddress(ctx context.Context, req *MsgSetRewardsWithdrawAddress) (*MsgSetRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
 func (*UnimplementedMsgServer) AdvanceEpoch(ctx context.Context, req *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdvanceEpoch not implemented")
 }
@@ -869,31 +1663,67 @@ func _Msg_CreateRatioPlan_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStake)
+func _Msg_CreateDecayingAmountPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDecayingAmountPlan)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Stake(ctx, in)
+		return srv.(MsgServer).CreateDecayingAmountPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateDecayingAmountPlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+		return srv.(MsgServer).CreateDecayingAmountPlan(ctx, req.(*MsgCreateDecayingAmountPlan))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstake)
+func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStake)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Unstake(ctx, in)
+		return srv.(MsgServer).Stake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StakeFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeFor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/StakeFor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeFor(ctx, req.(*MsgStakeFor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unstake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -905,6 +1735,24 @@ func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/TransferStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferStaking(ctx, req.(*MsgTransferStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgHarvest)
 	if err := dec(in); err != nil {
@@ -941,6 +1789,78 @@ func _Msg_RemovePlan_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyPrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyPrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyPrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/ModifyPrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyPrivatePlan(ctx, req.(*MsgModifyPrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SetRewardsWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsWithdrawAddress(ctx, req.(*MsgSetRewardsWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/ClaimVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AdvanceEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAdvanceEpoch)
 	if err := dec(in); err != nil {
@@ -971,14 +1891,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRatioPlan",
 			Handler:    _Msg_CreateRatioPlan_Handler,
 		},
+		{
+			MethodName: "CreateDecayingAmountPlan",
+			Handler:    _Msg_CreateDecayingAmountPlan_Handler,
+		},
 		{
 			MethodName: "Stake",
 			Handler:    _Msg_Stake_Handler,
 		},
+		{
+			MethodName: "StakeFor",
+			Handler:    _Msg_StakeFor_Handler,
+		},
 		{
 			MethodName: "Unstake",
 			Handler:    _Msg_Unstake_Handler,
 		},
+		{
+			MethodName: "TransferStaking",
+			Handler:    _Msg_TransferStaking_Handler,
+		},
 		{
 			MethodName: "Harvest",
 			Handler:    _Msg_Harvest_Handler,
@@ -987,6 +1919,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePlan",
 			Handler:    _Msg_RemovePlan_Handler,
 		},
+		{
+			MethodName: "ModifyPrivatePlan",
+			Handler:    _Msg_ModifyPrivatePlan_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetRewardsWithdrawAddress",
+			Handler:    _Msg_SetRewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
 		{
 			MethodName: "AdvanceEpoch",
 			Handler:    _Msg_AdvanceEpoch_Handler,
@@ -1016,6 +1964,34 @@ func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTotalStake.Size()
+		i -= size
+		if _, err := m.MaxTotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxStakePerFarmer.Size()
+		i -= size
+		if _, err := m.MaxStakePerFarmer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.EpochAmount) > 0 {
 		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1030,21 +2006,21 @@ func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error
 			dAtA[i] = 0x32
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x22
 	if len(m.StakingCoinWeights) > 0 {
 		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
@@ -1121,30 +2097,58 @@ func (m *MsgCreateRatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.EpochRatio.Size()
+		size := m.MaxTotalStake.Size()
 		i -= size
-		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxTotalStake.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxStakePerFarmer.Size()
+		i -= size
+		if _, err := m.MaxStakePerFarmer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTx(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x22
 	if len(m.StakingCoinWeights) > 0 {
 		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
@@ -1200,7 +2204,7 @@ func (m *MsgCreateRatioPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStake) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDecayingAmountPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1210,20 +2214,63 @@ func (m *MsgStake) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDecayingAmountPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDecayingAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StakingCoins) > 0 {
-		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.MaxTotalStake.Size()
+		i -= size
+		if _, err := m.MaxTotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxStakePerFarmer.Size()
+		i -= size
+		if _, err := m.MaxStakePerFarmer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x4a
+	if m.DecayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1231,20 +2278,57 @@ func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDecayingAmountPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1254,12 +2338,12 @@ func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDecayingAmountPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDecayingAmountPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1267,7 +2351,7 @@ func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
+func (m *MsgStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1277,20 +2361,28 @@ func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnstakingCoins) > 0 {
-		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingCoins) > 0 {
+		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1311,7 +2403,7 @@ func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1321,12 +2413,12 @@ func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1334,7 +2426,7 @@ func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakeFor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1344,23 +2436,28 @@ func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakeFor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakeFor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StakingCoinDenoms) > 0 {
-		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StakingCoinDenoms[iNdEx])
-			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+	if len(m.StakingCoins) > 0 {
+		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.Farmer) > 0 {
@@ -1368,12 +2465,19 @@ func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Farmer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakeForResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1383,12 +2487,12 @@ func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakeForResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakeForResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1396,7 +2500,7 @@ func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemovePlan) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1406,32 +2510,41 @@ func (m *MsgRemovePlan) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemovePlan) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemovePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PlanId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.UnstakingCoins) > 0 {
+		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemovePlanResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1441,12 +2554,12 @@ func (m *MsgRemovePlanResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemovePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemovePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1454,7 +2567,7 @@ func (m *MsgRemovePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferStaking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1464,27 +2577,48 @@ func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferStaking) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+	if len(m.StakingCoins) > 0 {
+		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferStakingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1494,12 +2628,12 @@ func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferStakingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1507,235 +2641,2968 @@ func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateFixedAmountPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.EpochAmount) > 0 {
-		for _, e := range m.EpochAmount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRatioPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemovePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = m.EpochRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRatioPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemovePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemovePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyPrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DecayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.StakingCoins) > 0 {
-		for _, e := range m.StakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EndTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyPrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.UnstakingCoins) > 0 {
-		for _, e := range m.UnstakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgHarvest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetRewardsWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakingCoinDenoms) > 0 {
-		for _, s := range m.StakingCoinDenoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgHarvestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetRewardsWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemovePlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.PlanId != 0 {
-		n += 1 + sovTx(uint64(m.PlanId))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFixedAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxStakePerFarmer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxTotalStake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRatioPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxStakePerFarmer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxTotalStake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateRatioPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDecayingAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DecayPeriod))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxStakePerFarmer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxTotalStake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDecayingAmountPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoins) > 0 {
+		for _, e := range m.StakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeFor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoins) > 0 {
+		for _, e := range m.StakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStakeForResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UnstakingCoins) > 0 {
+		for _, e := range m.UnstakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoins) > 0 {
+		for _, e := range m.StakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHarvest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgHarvestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *MsgRemovePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyPrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DecayPeriod))
+	}
+	return n
+}
+
+func (m *MsgModifyPrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardsWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdvanceEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdvanceEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakePerFarmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakePerFarmer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakePerFarmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakePerFarmer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			m.DecayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakePerFarmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakePerFarmer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingAmountPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingAmountPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoins = append(m.StakingCoins, types.Coin{})
+			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeFor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeFor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeFor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoins = append(m.StakingCoins, types.Coin{})
+			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeForResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeForResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeForResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
+			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoins = append(m.StakingCoins, types.Coin{})
+			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemovePlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgAdvanceEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAdvanceEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1758,15 +5625,15 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1794,11 +5661,11 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1826,80 +5693,113 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1909,30 +5809,29 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1942,26 +5841,11 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochAmount = append(m.EpochAmount, types.Coin{})
-			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1983,7 +5867,7 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2006,10 +5890,10 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2033,7 +5917,7 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyPrivatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2056,15 +5940,15 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyPrivatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyPrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,13 +5976,13 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var stringLen uint64
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2108,24 +5992,11 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
@@ -2162,7 +6033,7 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2189,13 +6060,16 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2222,7 +6096,8 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2260,109 +6135,9 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgStake) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2390,13 +6165,15 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
 			}
-			var msglen int
+			m.DecayPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2406,26 +6183,11 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DecayPeriod |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoins = append(m.StakingCoins, types.Coin{})
-			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2447,7 +6209,7 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyPrivatePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,10 +6232,10 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyPrivatePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyPrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2497,7 +6259,7 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,10 +6282,10 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2559,10 +6321,10 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2572,26 +6334,12 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
-			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2613,7 +6361,7 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2636,10 +6384,10 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2663,7 +6411,7 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2686,10 +6434,10 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2726,7 +6474,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2754,7 +6502,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2777,7 +6525,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2800,10 +6548,10 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2827,7 +6575,7 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemovePlan) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2850,15 +6598,15 @@ func (m *MsgRemovePlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemovePlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemovePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2886,27 +6634,8 @@ func (m *MsgRemovePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
-			}
-			m.PlanId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PlanId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2928,7 +6657,7 @@ func (m *MsgRemovePlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemovePlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2951,10 +6680,10 @@ func (m *MsgRemovePlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemovePlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemovePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
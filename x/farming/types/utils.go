@@ -36,3 +36,47 @@ func DeriveAddress(addressType AddressType, moduleName, name string) sdk.AccAddr
 func DateRangeIncludes(startTime, endTime, targetTime time.Time) bool {
 	return endTime.After(targetTime) && !startTime.After(targetTime)
 }
+
+// NextEpochTime returns the time when the epoch that started at the last
+// epoch time ends.
+// Epochs of whole days end at midnight UTC, and other epochs end at a multiple
+// of the epoch duration since the zero time, so the first epoch after the
+// module is activated may be shorter than the epoch duration.
+func NextEpochTime(lastEpochTime time.Time, epochDuration time.Duration) time.Time {
+	day := 24 * time.Hour
+	alignment := epochDuration
+	if epochDuration%day == 0 {
+		alignment = day
+	}
+	return lastEpochTime.Add(epochDuration).Truncate(alignment)
+}
+
+// EpochPortion returns the portion of an epoch that has elapsed at the target
+// time, where the epoch starts at the start time and ends at the end time.
+// The end time should be the one returned by NextEpochTime, since the epoch
+// may be shorter than the epoch duration.
+// The result is capped at 1.
+func EpochPortion(startTime, endTime, targetTime time.Time) sdk.Dec {
+	if !targetTime.After(startTime) {
+		return sdk.ZeroDec()
+	}
+	if !targetTime.Before(endTime) {
+		return sdk.OneDec()
+	}
+	return sdk.NewDec(int64(targetTime.Sub(startTime))).Quo(sdk.NewDec(int64(endTime.Sub(startTime))))
+}
+
+// CoinsPortion returns the part of the coins between the from and to
+// portions. Each amount is truncated at both ends, so that the parts of
+// consecutive portions add up to the truncated amount of the whole portion.
+func CoinsPortion(coins sdk.Coins, from, to sdk.Dec) sdk.Coins {
+	portion := sdk.NewCoins()
+	for _, coin := range coins {
+		amt := coin.Amount.ToDec()
+		diff := amt.MulTruncate(to).TruncateInt().Sub(amt.MulTruncate(from).TruncateInt())
+		if diff.IsPositive() {
+			portion = portion.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return portion
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/tendermint/farming/x/farming/types"
 )
 
@@ -162,3 +164,74 @@ func TestDeriveAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestNextEpochTime(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		lastEpochTime time.Time
+		epochDuration time.Duration
+		expected      time.Time
+	}{
+		{"a day", types.ParseTime("2022-01-01T00:00:05Z"), 24 * time.Hour, types.ParseTime("2022-01-02T00:00:00Z")},
+		{"a day, late", types.ParseTime("2022-01-01T23:59:59Z"), 24 * time.Hour, types.ParseTime("2022-01-02T00:00:00Z")},
+		{"a week", types.ParseTime("2022-01-01T12:00:00Z"), 7 * 24 * time.Hour, types.ParseTime("2022-01-08T00:00:00Z")},
+		{"an hour", types.ParseTime("2022-01-01T12:30:00Z"), time.Hour, types.ParseTime("2022-01-01T13:00:00Z")},
+		{"ten seconds", types.ParseTime("2022-01-01T12:30:05Z"), 10 * time.Second, types.ParseTime("2022-01-01T12:30:10Z")},
+		{"on schedule", types.ParseTime("2022-01-01T12:00:00Z"), time.Hour, types.ParseTime("2022-01-01T13:00:00Z")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.NextEpochTime(tc.lastEpochTime, tc.epochDuration))
+		})
+	}
+}
+
+func TestEpochPortion(t *testing.T) {
+	startTime := types.ParseTime("2022-01-01T00:00:00Z")
+	for _, tc := range []struct {
+		name       string
+		endTime    time.Time
+		targetTime time.Time
+		expected   sdk.Dec
+	}{
+		{"before start time", types.ParseTime("2022-01-02T00:00:00Z"), types.ParseTime("2021-12-31T00:00:00Z"), sdk.ZeroDec()},
+		{"on start time", types.ParseTime("2022-01-02T00:00:00Z"), startTime, sdk.ZeroDec()},
+		{"quarter", types.ParseTime("2022-01-02T00:00:00Z"), types.ParseTime("2022-01-01T06:00:00Z"), sdk.NewDecWithPrec(25, 2)},
+		{"quarter of longer epoch", types.ParseTime("2022-01-05T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z"), sdk.NewDecWithPrec(25, 2)},
+		{"quarter of shorter epoch", types.ParseTime("2022-01-01T01:00:00Z"), types.ParseTime("2022-01-01T00:15:00Z"), sdk.NewDecWithPrec(25, 2)},
+		{"whole epoch", types.ParseTime("2022-01-02T00:00:00Z"), types.ParseTime("2022-01-02T00:00:00Z"), sdk.OneDec()},
+		{"after epoch", types.ParseTime("2022-01-02T00:00:00Z"), types.ParseTime("2022-01-03T00:00:00Z"), sdk.OneDec()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.expected.Equal(types.EpochPortion(startTime, tc.endTime, tc.targetTime)))
+		})
+	}
+
+	// The first epoch is shortened so that it ends at midnight.
+	startTime = types.ParseTime("2022-01-01T12:00:00Z")
+	endTime := types.NextEpochTime(startTime, 24*time.Hour)
+	require.True(t, sdk.NewDecWithPrec(5, 1).Equal(types.EpochPortion(startTime, endTime, types.ParseTime("2022-01-01T18:00:00Z"))))
+	require.True(t, sdk.OneDec().Equal(types.EpochPortion(startTime, endTime, endTime)))
+}
+
+func TestCoinsPortion(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("denom1", 10), sdk.NewInt64Coin("denom2", 1000))
+
+	require.True(t, coins.IsEqual(types.CoinsPortion(coins, sdk.ZeroDec(), sdk.OneDec())))
+	require.True(t, types.CoinsPortion(coins, sdk.ZeroDec(), sdk.ZeroDec()).IsZero())
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1)).IsEqual(
+		types.CoinsPortion(coins, sdk.ZeroDec(), sdk.NewDecWithPrec(1, 3))))
+
+	// Parts of consecutive portions add up to the whole.
+	total := sdk.NewCoins()
+	third := sdk.OneDec().QuoInt64(3)
+	from := sdk.ZeroDec()
+	for i := 0; i < 3; i++ {
+		to := from.Add(third)
+		if i == 2 {
+			to = sdk.OneDec()
+		}
+		total = total.Add(types.CoinsPortion(coins, from, to)...)
+		from = to
+	}
+	require.True(t, coins.IsEqual(total))
+}
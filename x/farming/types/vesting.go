@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRewardVesting returns a new RewardVesting.
+func NewRewardVesting(id uint64, farmerAcc sdk.AccAddress, planId uint64, totalAmount sdk.Coins, startTime, endTime time.Time) RewardVesting {
+	return RewardVesting{
+		Id:            id,
+		Farmer:        farmerAcc.String(),
+		PlanId:        planId,
+		TotalAmount:   totalAmount,
+		ClaimedAmount: sdk.NewCoins(),
+		StartTime:     startTime,
+		EndTime:       endTime,
+
+		InitialVestedAmount: sdk.NewCoins(),
+	}
+}
+
+// GetFarmer returns the farmer address of the reward vesting.
+func (vesting RewardVesting) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(vesting.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// VestedAmount returns the amount of rewards that have unlocked at given
+// time t, including the amount already claimed.
+// The initial vested amount is unlocked at the start time, and the rest of
+// the rewards unlock linearly from the start time to the end time.
+func (vesting RewardVesting) VestedAmount(t time.Time) sdk.Coins {
+	if !t.Before(vesting.EndTime) {
+		return vesting.TotalAmount
+	}
+	if !t.After(vesting.StartTime) {
+		return vesting.InitialVestedAmount
+	}
+	elapsed := sdk.NewInt(int64(t.Sub(vesting.StartTime)))
+	duration := sdk.NewInt(int64(vesting.EndTime.Sub(vesting.StartTime)))
+	vested := vesting.InitialVestedAmount
+	for _, coin := range vesting.TotalAmount.Sub(vesting.InitialVestedAmount) {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return vested
+}
+
+// ClaimableAmount returns the amount of vested rewards that have not been
+// claimed yet at given time t.
+func (vesting RewardVesting) ClaimableAmount(t time.Time) sdk.Coins {
+	return vesting.VestedAmount(t).Sub(vesting.ClaimedAmount)
+}
+
+// UnclaimedAmount returns the amount of rewards that have not been claimed,
+// regardless of whether they have vested or not.
+func (vesting RewardVesting) UnclaimedAmount() sdk.Coins {
+	return vesting.TotalAmount.Sub(vesting.ClaimedAmount)
+}
+
+// Rebase returns the reward vesting re-based at given start time to also vest
+// given amount of rewards until given end time.
+// The rewards that have unlocked but have not been claimed yet stay
+// claimable, and the rewards that are still locked unlock along with given
+// amount from the new start time to the new end time.
+func (vesting RewardVesting) Rebase(amt sdk.Coins, startTime, endTime time.Time) RewardVesting {
+	vesting.InitialVestedAmount = vesting.ClaimableAmount(startTime)
+	vesting.TotalAmount = vesting.UnclaimedAmount().Add(amt...)
+	vesting.ClaimedAmount = sdk.NewCoins()
+	vesting.StartTime = startTime
+	vesting.EndTime = endTime
+	return vesting
+}
+
+// Validate validates RewardVesting.
+func (vesting RewardVesting) Validate() error {
+	if vesting.Id == 0 {
+		return fmt.Errorf("reward vesting id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(vesting.Farmer); err != nil {
+		return err
+	}
+	if vesting.PlanId == 0 {
+		return fmt.Errorf("plan id must not be 0")
+	}
+	if err := vesting.TotalAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid total amount: %w", err)
+	}
+	if vesting.TotalAmount.IsZero() {
+		return fmt.Errorf("total amount must not be zero")
+	}
+	if err := vesting.ClaimedAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid claimed amount: %w", err)
+	}
+	if !vesting.TotalAmount.IsAllGTE(vesting.ClaimedAmount) {
+		return fmt.Errorf("claimed amount %s must not exceed total amount %s", vesting.ClaimedAmount, vesting.TotalAmount)
+	}
+	if err := vesting.InitialVestedAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid initial vested amount: %w", err)
+	}
+	if !vesting.TotalAmount.IsAllGTE(vesting.InitialVestedAmount) {
+		return fmt.Errorf("initial vested amount %s must not exceed total amount %s", vesting.InitialVestedAmount, vesting.TotalAmount)
+	}
+	if !vesting.EndTime.After(vesting.StartTime) {
+		return fmt.Errorf("end time %s must be after start time %s", vesting.EndTime, vesting.StartTime)
+	}
+	return nil
+}
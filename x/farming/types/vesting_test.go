@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func TestRewardVesting_VestedAmount(t *testing.T) {
+	vesting := types.NewRewardVesting(
+		1, sdk.AccAddress(crypto.AddressHash([]byte("farmer"))), 1,
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 10)),
+		types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-04T00:00:00Z"))
+
+	for _, tc := range []struct {
+		t      time.Time
+		vested sdk.Coins
+	}{
+		{types.ParseTime("2021-12-31T00:00:00Z"), sdk.Coins{}},
+		{types.ParseTime("2022-01-01T00:00:00Z"), sdk.Coins{}},
+		{types.ParseTime("2022-01-02T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 333_333), sdk.NewInt64Coin("denom2", 3))},
+		{types.ParseTime("2022-01-04T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 10))},
+		{types.ParseTime("2022-02-01T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 10))},
+	} {
+		t.Run(tc.t.String(), func(t *testing.T) {
+			require.True(t, tc.vested.IsEqual(vesting.VestedAmount(tc.t)))
+		})
+	}
+}
+
+func TestRewardVesting_ClaimableAmount(t *testing.T) {
+	vesting := types.NewRewardVesting(
+		1, sdk.AccAddress(crypto.AddressHash([]byte("farmer"))), 1,
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 10)),
+		types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-04T00:00:00Z"))
+	vesting.ClaimedAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 333_333), sdk.NewInt64Coin("denom2", 3))
+
+	require.True(t, vesting.ClaimableAmount(types.ParseTime("2022-01-02T00:00:00Z")).IsZero())
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 333_333), sdk.NewInt64Coin("denom2", 3)).IsEqual(
+		vesting.ClaimableAmount(types.ParseTime("2022-01-03T00:00:00Z"))))
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 666_667), sdk.NewInt64Coin("denom2", 7)).IsEqual(
+		vesting.UnclaimedAmount()))
+}
+
+func TestRewardVesting_Rebase(t *testing.T) {
+	vesting := types.NewRewardVesting(
+		1, sdk.AccAddress(crypto.AddressHash([]byte("farmer"))), 1,
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 10)),
+		types.ParseTime("2022-01-01T00:00:00Z"), types.ParseTime("2022-01-04T00:00:00Z"))
+	vesting.ClaimedAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 100_000))
+
+	vesting = vesting.Rebase(
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 500_000), sdk.NewInt64Coin("denom3", 30)),
+		types.ParseTime("2022-01-02T00:00:00Z"), types.ParseTime("2022-01-05T00:00:00Z"))
+	require.NoError(t, vesting.Validate())
+	require.True(t, vesting.ClaimedAmount.IsZero())
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_400_000), sdk.NewInt64Coin("denom2", 10), sdk.NewInt64Coin("denom3", 30)).IsEqual(
+		vesting.TotalAmount))
+
+	// The rewards that had unlocked before being re-based stay claimable.
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 233_333), sdk.NewInt64Coin("denom2", 3)).IsEqual(
+		vesting.ClaimableAmount(types.ParseTime("2022-01-02T00:00:00Z"))))
+	// The rest unlocks linearly until the new end time.
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 622_222), sdk.NewInt64Coin("denom2", 5), sdk.NewInt64Coin("denom3", 10)).IsEqual(
+		vesting.ClaimableAmount(types.ParseTime("2022-01-03T00:00:00Z"))))
+	require.True(t, vesting.TotalAmount.IsEqual(vesting.ClaimableAmount(types.ParseTime("2022-01-05T00:00:00Z"))))
+}